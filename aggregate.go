@@ -0,0 +1,144 @@
+package appdash
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// aggregateSlowest is the number of slowest trace IDs that are kept in each
+// AggregatedResult.
+const aggregateSlowest = 5
+
+// Aggregate implements the Aggregator interface by grouping the traces in the
+// store by their root span name.
+func (ms *MemoryStore) Aggregate(start, end time.Duration) ([]*AggregatedResult, error) {
+	traces, err := ms.Traces(TracesOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return aggregateTraces(traces, start, end)
+}
+
+// aggregateTraces groups the given traces by root span name and calculates
+// the AggregatedResult for each group. Only traces that started within the
+// [now+start, now+end] time range are considered, see Aggregator for details.
+//
+// Traces without any timespan events are ignored, as there is no time
+// information to aggregate.
+func aggregateTraces(traces []*Trace, start, end time.Duration) ([]*AggregatedResult, error) {
+	startTime, endTime := aggregateWindow(start, end)
+
+	groups := make(map[string][]traceSample)
+	for _, t := range traces {
+		s, e, ok, err := traceTimes(t)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || s.Before(startTime) || s.After(endTime) {
+			continue
+		}
+		name := t.Span.Name()
+		groups[name] = append(groups[name], traceSample{id: t.Span.ID.Trace, duration: e.Sub(s)})
+	}
+
+	return aggregateGroups(groups), nil
+}
+
+// aggregateGroups calculates the AggregatedResult for each group of trace
+// samples, keyed by root span name. The results are sorted by name.
+func aggregateGroups(groups map[string][]traceSample) []*AggregatedResult {
+	results := make([]*AggregatedResult, 0, len(groups))
+	for name, samples := range groups {
+		r := &AggregatedResult{
+			RootSpanName: name,
+			Min:          samples[0].duration,
+			Max:          samples[0].duration,
+			Samples:      int64(len(samples)),
+		}
+
+		var sum time.Duration
+		for _, s := range samples {
+			sum += s.duration
+			if s.duration < r.Min {
+				r.Min = s.duration
+			}
+			if s.duration > r.Max {
+				r.Max = s.duration
+			}
+		}
+		r.Average = sum / time.Duration(len(samples))
+
+		var variance float64
+		for _, s := range samples {
+			d := float64(s.duration - r.Average)
+			variance += d * d
+		}
+		r.StdDev = time.Duration(math.Sqrt(variance / float64(len(samples))))
+
+		// Find the N-slowest traces.
+		sort.Sort(sort.Reverse(traceSamplesByDuration(samples)))
+		for i, s := range samples {
+			if i == aggregateSlowest {
+				break
+			}
+			r.Slowest = append(r.Slowest, s.id)
+		}
+		results = append(results, r)
+	}
+	sort.Sort(aggregatedResultsByName(results))
+	return results
+}
+
+// aggregateWindow returns the absolute time range that corresponds to the
+// relative [start, end] range passed to Aggregate.
+func aggregateWindow(start, end time.Duration) (time.Time, time.Time) {
+	now := time.Now()
+	return now.Add(start), now.Add(end)
+}
+
+// traceTimes returns the earliest start time and latest end time of all the
+// timespan events found in t and its descendants. If there are no such events,
+// ok == false is returned.
+func traceTimes(t *Trace) (start, end time.Time, ok bool, err error) {
+	var events []Event
+	if err := UnmarshalEvents(t.Span.Annotations, &events); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	start, end, ok = findTraceTimes(events)
+	for _, sub := range t.Sub {
+		s, e, subOK, err := traceTimes(sub)
+		if err != nil {
+			return time.Time{}, time.Time{}, false, err
+		}
+		if !subOK {
+			continue
+		}
+		if !ok || s.Before(start) {
+			start = s
+		}
+		if !ok || e.After(end) {
+			end = e
+		}
+		ok = true
+	}
+	return start, end, ok, nil
+}
+
+// traceSample is the duration of a single trace that is being aggregated.
+type traceSample struct {
+	id       ID
+	duration time.Duration
+}
+
+type traceSamplesByDuration []traceSample
+
+func (t traceSamplesByDuration) Len() int           { return len(t) }
+func (t traceSamplesByDuration) Less(i, j int) bool { return t[i].duration < t[j].duration }
+func (t traceSamplesByDuration) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+type aggregatedResultsByName []*AggregatedResult
+
+func (a aggregatedResultsByName) Len() int           { return len(a) }
+func (a aggregatedResultsByName) Less(i, j int) bool { return a[i].RootSpanName < a[j].RootSpanName }
+func (a aggregatedResultsByName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
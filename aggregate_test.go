@@ -0,0 +1,60 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Aggregate(t *testing.T) {
+	ms := NewMemoryStore()
+	now := time.Now()
+
+	// collect records a trace named name starting at the given offset from now
+	// and lasting d.
+	collect := func(id ID, name string, offset, d time.Duration) {
+		rec := NewRecorder(SpanID{Trace: id, Span: id}, ms)
+		rec.Name(name)
+		rec.Event(Timespan{S: now.Add(offset), E: now.Add(offset + d)})
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+	collect(1, "a", -time.Hour, 10*time.Millisecond)
+	collect(2, "a", -time.Hour, 30*time.Millisecond)
+	collect(3, "b", -time.Hour, 50*time.Millisecond)
+	collect(4, "b", -100*time.Hour, 50*time.Millisecond) // outside of range
+
+	// Child spans extend the total trace time.
+	child := NewRecorder(SpanID{Trace: 3, Span: 30, Parent: 3}, ms)
+	child.Event(Timespan{S: now.Add(-time.Hour), E: now.Add(-time.Hour + 70*time.Millisecond)})
+	child.Finish()
+
+	results, err := ms.Aggregate(-72*time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*AggregatedResult{
+		{
+			RootSpanName: "a",
+			Average:      20 * time.Millisecond,
+			Min:          10 * time.Millisecond,
+			Max:          30 * time.Millisecond,
+			StdDev:       10 * time.Millisecond,
+			Samples:      2,
+			Slowest:      []ID{2, 1},
+		},
+		{
+			RootSpanName: "b",
+			Average:      70 * time.Millisecond,
+			Min:          70 * time.Millisecond,
+			Max:          70 * time.Millisecond,
+			Samples:      1,
+			Slowest:      []ID{3},
+		},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("got results %+v, want %+v", results, want)
+	}
+}
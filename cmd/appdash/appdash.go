@@ -37,6 +37,28 @@
 //
 //  http://localhost:7700
 //
+// The web UI also accepts spans from services that are instrumented with a
+// Zipkin tracer, at the same URL as a Zipkin server (see the zipkin package):
+//
+//  http://localhost:7700/api/v2/spans
+//
+// If the web UI requires HTTP Basic auth (see --basic-auth), so does that URL.
+// As Zipkin reporters can't authenticate, set the listen address of a
+// separate Zipkin receiver that doesn't require it:
+//
+//  appdash serve --basic-auth=user:passwd --zipkin=:9411
+//
+// Zipkin tracers can then report their spans to
+// http://localhost:9411/api/v2/spans.
+//
+// To accept OpenTelemetry traces over OTLP/HTTP (in the protobuf or JSON
+// encoding) as well, set the listen address of the OTLP receiver:
+//
+//  appdash serve --otlp=:4318
+//
+// OpenTelemetry SDKs can then export their spans to
+// http://localhost:4318/v1/traces (see the otlp package).
+//
 // Optionally, you do not need to use this command at all and can embed the web
 // UI into your application directly on a separate HTTP port (see the traceapp
 // package or examples/cmd/webapp for more details).
@@ -48,6 +70,14 @@
 //
 //  appdash send -c="localhost:7701"
 //
+// Export and import
+//
+// Traces can be exported from a server (or store) as JSON Lines, e.g. to
+// attach them to a bug report, and imported into another one:
+//
+//  appdash export --server=http://localhost:7700 -q 'name:"GET /"' -n 10 -o traces.jsonl
+//  appdash import -c="otherhost:7701" traces.jsonl
+//
 package main
 
 import (
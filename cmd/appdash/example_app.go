@@ -72,6 +72,7 @@ func (c *DemoCmd) Execute(args []string) error {
 	}
 	tapp.Store = store
 	tapp.Queryer = store
+	tapp.Aggregator = store
 	go func() {
 		log.Fatal(http.ListenAndServe(c.AppdashHTTPAddr, tapp))
 	}()
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/traceapp"
+)
+
+func init() {
+	_, err := CLI.AddCommand("export",
+		"export traces as JSON Lines",
+		"The export command writes traces from an appdash server or store to a file (or stdout) as JSON Lines, i.e. one JSON-encoded trace per line. The traces can be read back with the import command.",
+		&exportCmd,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ExportCmd is the command for exporting traces from a server or store as
+// JSON Lines.
+type ExportCmd struct {
+	Server    string `long:"server" description:"URL of the appdash server to export traces from (e.g. http://localhost:7700)"`
+	StoreFile string `short:"f" long:"store-file" description:"store file to export traces from (see serve --store-file; opened read-only, so it may be in use by a server)"`
+	StoreDir  string `long:"store-dir" description:"store directory to export traces from (see serve --store-dir; opened read-only, so it may be in use by a server)"`
+
+	Output string `short:"o" long:"output" description:"file to write the traces to (default: stdout)"`
+
+	Traces []string `long:"trace" description:"ID of a trace to export (may be repeated)"`
+	Start  string   `long:"start" description:"only export traces that end after this time (RFC 3339)"`
+	End    string   `long:"end" description:"only export traces that start before this time (RFC 3339)"`
+	Query  string   `short:"q" long:"query" description:"only export traces matching this query (see the traces page)"`
+	Limit  int      `short:"n" long:"limit" description:"maximum number of traces to export, most recent first (0 to export all traces)"`
+}
+
+var exportCmd ExportCmd
+
+// Execute execudes the commands with the given arguments and returns an error,
+// if any.
+func (c *ExportCmd) Execute(args []string) error {
+	opts := appdash.TracesOpts{Query: c.Query, Limit: c.Limit, Desc: c.Limit > 0}
+	for _, s := range c.Traces {
+		id, err := appdash.ParseID(s)
+		if err != nil {
+			return fmt.Errorf("invalid trace ID %q: %s", s, err)
+		}
+		opts.TraceIDs = append(opts.TraceIDs, id)
+	}
+	for _, p := range []struct {
+		name, value string
+		t           *time.Time
+	}{{"start", c.Start, &opts.Timespan.S}, {"end", c.End, &opts.Timespan.E}} {
+		if p.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, p.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s time: %s", p.name, err)
+		}
+		*p.t = t
+	}
+
+	var queryer appdash.Queryer
+	if c.Server == "" && c.StoreFile == "" && c.StoreDir == "" {
+		return errors.New("one of --server, --store-file or --store-dir must be given")
+	}
+	if c.Server != "" {
+		u, err := url.Parse(c.Server)
+		if err != nil {
+			return err
+		}
+		queryer = traceapp.NewClient(u)
+	} else {
+		store, closer, err := openStore(c.StoreFile, c.StoreDir, true)
+		if err != nil {
+			return err
+		}
+		defer closer.Close()
+		queryer = store
+	}
+
+	traces, err := queryer.Traces(opts)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if c.Output != "" {
+		f, err := os.Create(c.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w) // writes a newline after each trace
+	for _, t := range traces {
+		if err := enc.Encode(t); err != nil {
+			return err
+		}
+	}
+	log.Printf("Exported %d traces", len(traces))
+	return nil
+}
+
+// localStore is a store opened by openStore.
+type localStore interface {
+	appdash.Store
+	appdash.Queryer
+}
+
+// openStore opens the store in the given file (see serve --store-file) or
+// directory (see serve --store-dir). If readOnly is true, the store is opened
+// without modifying it, so that it may be in use by a server. The returned
+// closer must be closed when the store is no longer used.
+func openStore(file, dir string, readOnly bool) (localStore, io.Closer, error) {
+	switch {
+	case dir != "":
+		open := appdash.NewFileStore
+		if readOnly {
+			open = appdash.OpenFileStoreReadOnly
+		}
+		fs, err := open(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		return fs, fs, nil
+	case file != "":
+		open := appdash.NewWALStore
+		if readOnly {
+			open = appdash.OpenWALStoreReadOnly
+		}
+		ws, err := open(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ws, ws, nil
+	default:
+		return nil, nil, errors.New("no store specified")
+	}
+}
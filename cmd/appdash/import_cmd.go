@@ -0,0 +1,121 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+func init() {
+	_, err := CLI.AddCommand("import",
+		"import traces from JSON Lines",
+		"The import command reads traces written by the export command from the given files (or stdin), and collects them into an appdash server or store.",
+		&importCmd,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ImportCmd is the command for importing traces from JSON Lines into a server
+// or store.
+type ImportCmd struct {
+	CollectorAddr  string `short:"c" long:"collector" description:"address of the collector to send the traces to" default:":7701"`
+	CollectorProto string `short:"p" long:"proto" description:"collector protocol (tcp or tls)" default:"tcp"`
+	ServerName     string `short:"s" long:"server-name" description:"server name (required for TLS)"`
+
+	StoreFile string `short:"f" long:"store-file" description:"store file to import the traces into, instead of sending them to a collector (must not be in use by a server)"`
+	StoreDir  string `long:"store-dir" description:"store directory to import the traces into, instead of sending them to a collector (must not be in use by a server)"`
+}
+
+var importCmd ImportCmd
+
+// Execute execudes the commands with the given arguments and returns an error,
+// if any.
+func (c *ImportCmd) Execute(args []string) error {
+	var collector appdash.Collector
+	if c.StoreFile != "" || c.StoreDir != "" {
+		store, closer, err := openStore(c.StoreFile, c.StoreDir, false)
+		if err != nil {
+			return err
+		}
+		defer closer.Close()
+		if ws, ok := store.(*appdash.WALStore); ok {
+			defer func() {
+				if err := ws.Snapshot(); err != nil {
+					log.Printf("Snapshot failed: %s", err)
+				}
+			}()
+		}
+		collector = store
+	} else {
+		var rc *appdash.RemoteCollector
+		switch c.CollectorProto {
+		case "tcp":
+			rc = appdash.NewRemoteCollector(c.CollectorAddr)
+		case "tls":
+			rc = appdash.NewTLSRemoteCollector(c.CollectorAddr, &tls.Config{ServerName: c.ServerName})
+		default:
+			return fmt.Errorf("unknown proto: %q", c.CollectorProto)
+		}
+		defer rc.Close()
+		collector = rc
+	}
+
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+	var n int
+	for _, name := range args {
+		m, err := importFile(collector, name)
+		n += m
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+	}
+	log.Printf("Imported %d traces", n)
+	return nil
+}
+
+// importFile imports the traces in the named file (or stdin, if name is "-"),
+// and returns the number of traces imported. The file is closed before
+// importFile returns.
+func importFile(c appdash.Collector, name string) (int, error) {
+	if name == "-" {
+		return importTraces(c, os.Stdin)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return importTraces(c, f)
+}
+
+// importTraces collects all spans of the JSON-encoded traces read from r, and
+// returns the number of traces imported.
+func importTraces(c appdash.Collector, r io.Reader) (int, error) {
+	dec := json.NewDecoder(r)
+	for n := 0; ; n++ {
+		var t appdash.Trace
+		if err := dec.Decode(&t); err == io.EOF {
+			return n, nil
+		} else if err != nil {
+			return n, err
+		}
+		err := t.Walk(func(t *appdash.Trace, depth int, post bool) error {
+			if post {
+				return nil
+			}
+			return c.Collect(t.Span.ID, t.Span.Annotations...)
+		})
+		if err != nil {
+			return n, err
+		}
+	}
+}
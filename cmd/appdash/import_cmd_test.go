@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+func TestImportTraces(t *testing.T) {
+	src := appdash.NewMemoryStore()
+	for _, id := range []appdash.SpanID{
+		{Trace: 1, Span: 1},
+		{Trace: 1, Span: 2, Parent: 1},
+		{Trace: 1, Span: 3, Parent: 2},
+		{Trace: 4, Span: 4},
+	} {
+		if err := src.Collect(id, appdash.Annotation{Key: "Name", Value: []byte(id.String())}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	traces, err := src.Traces(appdash.TracesOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Export the traces as JSON Lines, like the export command.
+	var buf bytes.Buffer
+	for _, tr := range traces {
+		if err := json.NewEncoder(&buf).Encode(tr); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dst := appdash.NewMemoryStore()
+	n, err := importTraces(dst, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("got %d traces imported, want 2", n)
+	}
+	for _, want := range traces {
+		got, err := dst.Trace(want.ID.Trace)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got imported trace %v, want %v", got, want)
+		}
+	}
+}
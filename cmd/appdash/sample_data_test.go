@@ -0,0 +1,47 @@
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"runtime"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// remoteCollector is a Collector that copies the annotations it collects
+// before passing them on, like a CollectorServer does when it decodes them.
+type remoteCollector struct {
+	appdash.Collector
+}
+
+func (c remoteCollector) Collect(id appdash.SpanID, anns ...appdash.Annotation) error {
+	copies := make([]appdash.Annotation, len(anns))
+	for i, a := range anns {
+		copies[i] = appdash.Annotation{Key: string([]byte(a.Key)), Value: append([]byte(nil), a.Value...)}
+	}
+	return c.Collector.Collect(id, copies...)
+}
+
+// BenchmarkMemoryStoreSampleData reports the memory used by a MemoryStore to
+// hold the traces generated by sampleData, as if they had been sent to a
+// collector server.
+func BenchmarkMemoryStoreSampleData(b *testing.B) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	ms := appdash.NewMemoryStore()
+	for i := 0; i < b.N; i++ {
+		if err := sampleData(remoteCollector{ms}); err != nil {
+			b.Fatal(err)
+		}
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/float64(b.N), "heap-bytes/op")
+	runtime.KeepAlive(ms)
+}
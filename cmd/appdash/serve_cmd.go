@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/subtle"
 	"crypto/tls"
 	"encoding/base64"
@@ -10,13 +11,14 @@ import (
 	"net"
 	"net/http"
 	"net/url"
-	"os"
 	"time"
 
 	"strings"
 
 	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/otlp"
 	"sourcegraph.com/sourcegraph/appdash/traceapp"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
 )
 
 func init() {
@@ -36,20 +38,32 @@ type ServeCmd struct {
 	URL           string `long:"url" description:"URL which Appdash is being hosted at (e.g. http://localhost:7700)"`
 	CollectorAddr string `long:"collector" description:"collector listen address" default:":7701"`
 	HTTPAddr      string `long:"http" description:"HTTP listen address" default:":7700"`
+	OTLPAddr      string `long:"otlp" description:"OTLP/HTTP listen address for OpenTelemetry traces (e.g. :4318; empty to disable)"`
+	ZipkinAddr    string `long:"zipkin" description:"HTTP listen address for Zipkin v2 spans, without --basic-auth (e.g. :9411; empty to disable)"`
 	SampleData    bool   `long:"sample-data" description:"add sample data"`
 
-	StoreFile       string        `short:"f" long:"store-file" description:"persisted store file" default:"/tmp/appdash.gob"`
-	PersistInterval time.Duration `short:"p" long:"persist-interval" description:"interval between persisting store to file" default:"2s"`
+	StoreFile       string        `short:"f" long:"store-file" description:"persisted store file (changes are logged to FILE.wal between snapshots)" default:"/tmp/appdash.gob"`
+	PersistInterval time.Duration `short:"p" long:"persist-interval" description:"interval between snapshots of the store file" default:"1m"`
+	StoreDir        string        `long:"store-dir" description:"directory to store traces in on disk, instead of in memory (overrides --store-file)"`
+	StoreSync       bool          `long:"store-sync" description:"sync every span to disk before acknowledging it, so that it survives a power loss (slower; only with --store-dir)"`
 
 	Debug bool `short:"d" long:"debug" description:"debug log"`
 	Trace bool `long:"trace" description:"trace log"`
 
 	DeleteAfter time.Duration `long:"delete-after" description:"delete traces after a certain age (0 to disable)" default:"30m"`
+	MaxBytes    int64         `long:"max-bytes" description:"delete the oldest traces when the approximate size of the stored traces exceeds this many bytes (0 to disable)"`
+
+	MaxTraceSpans int   `long:"max-trace-spans" description:"drop the spans of a trace beyond this many (0 to disable; ignored with --store-dir)"`
+	MaxTraceBytes int64 `long:"max-trace-bytes" description:"drop the spans of a trace whose approximate size exceeds this many bytes (0 to disable; ignored with --store-dir)"`
 
 	TLSCert string `long:"tls-cert" description:"TLS certificate file (if set, enables TLS)"`
 	TLSKey  string `long:"tls-key" description:"TLS key file (if set, enables TLS)"`
 
-	BasicAuth string `long:"basic-auth" description:"if set to 'user:passwd', require HTTP Basic Auth for web app"`
+	BasicAuth string `long:"basic-auth" description:"if set to 'user:passwd', require HTTP Basic Auth for web app (including Zipkin spans posted to it; see --zipkin)"`
+
+	Remotes       []string      `long:"remote" description:"URL of another appdash server whose traces are also shown in the web app (may be repeated)"`
+	MergeRemotes  bool          `long:"merge-remotes" description:"merge the spans of a trace from all servers that have it, instead of showing the first server's copy"`
+	RemoteTimeout time.Duration `long:"remote-timeout" description:"timeout of requests to the remote servers, after which their traces are left out (0 to disable)" default:"10s"`
 }
 
 var serveCmd ServeCmd
@@ -58,44 +72,85 @@ var serveCmd ServeCmd
 // if any.
 func (c *ServeCmd) Execute(args []string) error {
 	var (
-		memStore = appdash.NewMemoryStore()
-		Store    = appdash.Store(memStore)
-		Queryer  = memStore
+		deleteStore appdash.DeleteStore
+		Queryer     appdash.Queryer
+		Aggregator  appdash.Aggregator
 	)
 
-	if c.StoreFile != "" {
-		f, err := os.Open(c.StoreFile)
-		if err != nil && !os.IsNotExist(err) {
+	if c.StoreDir != "" {
+		fileStore, err := appdash.NewFileStore(c.StoreDir)
+		if err != nil {
 			return err
 		}
-		if f != nil {
-			if n, err := memStore.ReadFrom(f); err == nil {
-				log.Printf("Read %d traces from file %s", n, c.StoreFile)
-			} else if err != nil {
-				f.Close()
-				return err
-			}
-			if err := f.Close(); err != nil {
-				return err
-			}
+		fileStore.SyncWrites = c.StoreSync
+		defer fileStore.Close()
+		log.Printf("Opened store in directory %s", c.StoreDir)
+		deleteStore, Queryer, Aggregator = fileStore, fileStore, fileStore
+	} else if c.StoreFile != "" {
+		memStore := appdash.NewMemoryStore()
+		memStore.MaxTraceSpans, memStore.MaxTraceBytes = c.MaxTraceSpans, c.MaxTraceBytes
+		walStore, err := appdash.OpenWALStore(c.StoreFile, memStore)
+		if err != nil {
+			return err
 		}
+		defer walStore.Close()
+		log.Printf("Read %d traces from file %s", walStore.NumTraces(), c.StoreFile)
+		deleteStore, Queryer, Aggregator = walStore, walStore, walStore
+
 		if c.PersistInterval != 0 {
 			go func() {
-				if err := appdash.PersistEvery(memStore, c.PersistInterval, c.StoreFile); err != nil {
+				if err := walStore.SnapshotEvery(c.PersistInterval); err != nil {
 					log.Fatal(err)
 				}
 			}()
 		}
+	} else {
+		memStore := appdash.NewMemoryStore()
+		memStore.MaxTraceSpans, memStore.MaxTraceBytes = c.MaxTraceSpans, c.MaxTraceBytes
+		deleteStore, Queryer, Aggregator = memStore, memStore, memStore
+	}
+
+	if c.MaxBytes > 0 {
+		deleteStore = &appdash.ByteLimitStore{
+			MaxBytes:    c.MaxBytes,
+			DeleteStore: deleteStore,
+		}
 	}
 
+	Store := appdash.Store(deleteStore)
 	if c.DeleteAfter > 0 {
 		Store = &appdash.RecentStore{
 			MinEvictAge: c.DeleteAfter,
-			DeleteStore: memStore,
+			DeleteStore: deleteStore,
 			Debug:       true,
 		}
 	}
 
+	// Show the traces of the remote servers alongside the local ones. Traces
+	// uploaded through the web app are only collected by the local store.
+	appStore, appQueryer := Store, Queryer
+	if len(c.Remotes) > 0 {
+		stores := []appdash.Store{Store}
+		queryers := []appdash.Queryer{Queryer}
+		for _, remote := range c.Remotes {
+			u, err := url.Parse(remote)
+			if err != nil {
+				log.Fatal(err)
+			}
+			client := traceapp.NewClient(u)
+			client.HTTPClient = &http.Client{Timeout: c.RemoteTimeout}
+			stores = append(stores, client)
+			queryers = append(queryers, client)
+			log.Printf("Showing traces from remote appdash server %s", u)
+		}
+		multiStore := appdash.MultiStore
+		if c.MergeRemotes {
+			multiStore = appdash.MergingMultiStore
+		}
+		appStore = &federatedStore{Collector: Store, stores: multiStore(stores...)}
+		appQueryer = appdash.MultiQueryer(queryers...)
+	}
+
 	url, err := c.urlOrDefault()
 	if err != nil {
 		log.Fatal(err)
@@ -104,10 +159,11 @@ func (c *ServeCmd) Execute(args []string) error {
 	if err != nil {
 		log.Fatal(err)
 	}
-	app.Store = Store
-	app.Queryer = Queryer
+	app.Store = appStore
+	app.Queryer = appQueryer
+	app.Aggregator = Aggregator
 
-	var h http.Handler
+	var appHandler http.Handler
 	if c.BasicAuth != "" {
 		parts := strings.SplitN(c.BasicAuth, ":", 2)
 		if len(parts) != 2 {
@@ -118,9 +174,9 @@ func (c *ServeCmd) Execute(args []string) error {
 			log.Fatalf("Basic auth user and passwd must both be nonempty.")
 		}
 		log.Printf("Requiring HTTP Basic auth")
-		h = newBasicAuthHandler(user, passwd, app)
+		appHandler = newBasicAuthHandler(user, passwd, app)
 	} else {
-		h = app
+		appHandler = app
 	}
 
 	if c.SampleData {
@@ -164,13 +220,47 @@ func (c *ServeCmd) Execute(args []string) error {
 	cs.Trace = c.Trace
 	go cs.Start()
 
+	if c.OTLPAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle(otlp.TracesPath, &otlp.Handler{Collector: Store})
+		go func() {
+			var err error
+			if c.TLSCert != "" || c.TLSKey != "" {
+				log.Printf("appdash OTLP/HTTPS receiver listening on %s (TLS cert %s, key %s)", c.OTLPAddr, c.TLSCert, c.TLSKey)
+				err = http.ListenAndServeTLS(c.OTLPAddr, c.TLSCert, c.TLSKey, mux)
+			} else {
+				log.Printf("appdash OTLP/HTTP receiver listening on %s", c.OTLPAddr)
+				err = http.ListenAndServe(c.OTLPAddr, mux)
+			}
+			log.Fatal(err)
+		}()
+	}
+
+	if c.ZipkinAddr != "" {
+		// Zipkin reporters can't authenticate, so (as with the collector
+		// and OTLP receiver) spans are accepted here without HTTP Basic auth.
+		mux := http.NewServeMux()
+		mux.Handle(zipkin.SpansPath, &zipkin.Handler{Collector: Store})
+		go func() {
+			var err error
+			if c.TLSCert != "" || c.TLSKey != "" {
+				log.Printf("appdash Zipkin HTTPS receiver listening on %s (TLS cert %s, key %s)", c.ZipkinAddr, c.TLSCert, c.TLSKey)
+				err = http.ListenAndServeTLS(c.ZipkinAddr, c.TLSCert, c.TLSKey, mux)
+			} else {
+				log.Printf("appdash Zipkin HTTP receiver listening on %s", c.ZipkinAddr)
+				err = http.ListenAndServe(c.ZipkinAddr, mux)
+			}
+			log.Fatal(err)
+		}()
+	}
+
 	if c.TLSCert != "" || c.TLSKey != "" {
 		log.Printf("appdash HTTPS server listening on %s (TLS cert %s, key %s)", c.HTTPAddr, c.TLSCert, c.TLSKey)
-		return http.ListenAndServeTLS(c.HTTPAddr, c.TLSCert, c.TLSKey, h)
+		return http.ListenAndServeTLS(c.HTTPAddr, c.TLSCert, c.TLSKey, appHandler)
 	}
 
 	log.Printf("appdash HTTP server listening on %s", c.HTTPAddr)
-	return http.ListenAndServe(c.HTTPAddr, h)
+	return http.ListenAndServe(c.HTTPAddr, appHandler)
 }
 
 // urlOrDefault returns c.URL if non-empty, otherwise it returns c.HTTPAddr
@@ -200,6 +290,37 @@ func (c *ServeCmd) urlOrDefault() (*url.URL, error) {
 	return addr, nil
 }
 
+// federatedStore collects spans into a local store, but retrieves traces from
+// both the local store and remote appdash servers.
+type federatedStore struct {
+	appdash.Collector
+	stores appdash.Store // MultiStore of the local and remote stores
+}
+
+func (s *federatedStore) Trace(id appdash.ID) (*appdash.Trace, error) {
+	return s.stores.Trace(id)
+}
+
+// Stats implements the appdash.StatsStore interface by returning the
+// statistics of the local store.
+func (s *federatedStore) Stats() (*appdash.StoreStats, error) {
+	if ss, ok := s.Collector.(appdash.StatsStore); ok {
+		return ss.Stats()
+	}
+	return nil, appdash.ErrNoStats
+}
+
+// Watch implements the appdash.Watcher interface by watching the local store,
+// if it is a Watcher.
+func (s *federatedStore) Watch(ctx context.Context, opts appdash.TracesOpts) <-chan *appdash.Trace {
+	if w, ok := s.Collector.(appdash.Watcher); ok {
+		return w.Watch(ctx, opts)
+	}
+	ch := make(chan *appdash.Trace)
+	close(ch)
+	return ch
+}
+
 func newBasicAuthHandler(user, passwd string, h http.Handler) http.Handler {
 	want := "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", user, passwd)))
 	return &basicAuthHandler{h, []byte(want)}
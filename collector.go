@@ -163,26 +163,20 @@ func (cc *ChunkedCollector) Collect(span SpanID, anns ...Annotation) error {
 		cc.start()
 	}
 
-	// Increase queue size by approximately the size of the entry. This doesn't
-	// account for map entry or slice header overhead, but close enough for our
-	// purposes here.
-	var collectionSize uint64 = 3 * 8 // SpanID is 3 * uint64 ID's.
-	for _, ann := range anns {
-		collectionSize += uint64(len(ann.Key))
-		collectionSize += uint64(len(ann.Value))
-	}
+	// Increase queue size by approximately the size of the entry.
+	size := uint64(collectionSize(anns))
 
 	// If the queue would become too large, drop it.
-	if cc.MaxQueueSize != 0 && cc.queueSizeBytes+collectionSize > cc.MaxQueueSize {
+	if cc.MaxQueueSize != 0 && cc.queueSizeBytes+size > cc.MaxQueueSize {
 		if cc.Log != nil {
 			cc.Log.Println("ChunkedCollector: queue entirely dropped (trace data will be missing)")
-			cc.Log.Printf("ChunkedCollector: queueSize:%v queueSizeBytes:%v + collectionSize:%v\n", len(cc.pendingBySpanID), cc.queueSizeBytes, collectionSize)
+			cc.Log.Printf("ChunkedCollector: queueSize:%v queueSizeBytes:%v + collectionSize:%v\n", len(cc.pendingBySpanID), cc.queueSizeBytes, size)
 		}
 		cc.pendingBySpanID = nil
 		cc.queueSizeBytes = 0
 		return ErrQueueDropped
 	}
-	cc.queueSizeBytes += collectionSize
+	cc.queueSizeBytes += size
 
 	if cc.pendingBySpanID == nil {
 		cc.pendingBySpanID = make(map[SpanID]Annotations)
@@ -202,6 +196,18 @@ func (cc *ChunkedCollector) Collect(span SpanID, anns ...Annotation) error {
 	return nil
 }
 
+// collectionSize returns the approximate size in bytes of a collection of the
+// given annotations for a span. This doesn't account for map entry or slice
+// header overhead, but it is close enough for our purposes.
+func collectionSize(anns []Annotation) int64 {
+	var size int64 = 3 * 8 // SpanID is 3 * uint64 ID's.
+	for _, ann := range anns {
+		size += int64(len(ann.Key))
+		size += int64(len(ann.Value))
+	}
+	return size
+}
+
 // Flush immediately sends all pending spans to the underlying
 // collector.
 func (cc *ChunkedCollector) Flush() error {
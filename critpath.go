@@ -0,0 +1,107 @@
+package appdash
+
+import (
+	"sort"
+	"time"
+)
+
+// A CriticalPathSegment is a time interval on the critical path of a trace,
+// during which the given span was doing its own work (i.e. none of its
+// children were on the critical path).
+type CriticalPathSegment struct {
+	Span       *Trace
+	Start, End time.Time
+}
+
+// Duration returns the length of the segment.
+func (s CriticalPathSegment) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// CriticalPath returns the critical path of the trace: the chain of spans and
+// time intervals that determined its end-to-end latency, in chronological
+// order. Making any span faster during one of its segments makes the trace
+// faster, while making other spans faster does not (unless they become part of
+// the critical path).
+//
+// The critical path is found by walking backwards from the end of each span:
+// the child that finished last is on the critical path until it started, then
+// the child that finished last before that, and so on. Whenever no child is
+// running, the span itself is on the critical path. Children's times are
+// clipped to their parent's timespan, and spans without timespan events are
+// ignored. If t itself has no timespan events, nil is returned.
+func (t *Trace) CriticalPath() []CriticalPathSegment {
+	start, end, ok := t.times()
+	if !ok {
+		return nil
+	}
+	path := criticalPath(nil, t, start, end)
+
+	// The path was built backwards; reverse it and merge adjacent segments of
+	// the same span.
+	var merged []CriticalPathSegment
+	for i := len(path) - 1; i >= 0; i-- {
+		s := path[i]
+		if n := len(merged); n > 0 && merged[n-1].Span == s.Span && merged[n-1].End.Equal(s.Start) {
+			merged[n-1].End = s.End
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+// criticalPath appends the critical path of t within the interval [start,
+// end] to path, in reverse chronological order.
+func criticalPath(path []CriticalPathSegment, t *Trace, start, end time.Time) []CriticalPathSegment {
+	// Gather the children that overlap the interval, latest end first.
+	var children []timedTrace
+	for _, sub := range t.Sub {
+		s, e, ok := sub.times()
+		if !ok || !e.After(start) || !s.Before(end) {
+			continue
+		}
+		children = append(children, timedTrace{sub, s, e})
+	}
+	sort.Sort(timedTracesByEnd(children))
+
+	cursor := end
+	for _, c := range children {
+		if !c.start.Before(cursor) {
+			continue // started after the cursor, so it didn't block anything
+		}
+		childEnd := c.end
+		if childEnd.After(cursor) {
+			childEnd = cursor
+		}
+		if childEnd.Before(cursor) {
+			path = append(path, CriticalPathSegment{Span: t, Start: childEnd, End: cursor})
+		}
+		childStart := c.start
+		if childStart.Before(start) {
+			childStart = start
+		}
+		path = criticalPath(path, c.trace, childStart, childEnd)
+		cursor = childStart
+		if !cursor.After(start) {
+			return path
+		}
+	}
+	if cursor.After(start) {
+		path = append(path, CriticalPathSegment{Span: t, Start: start, End: cursor})
+	}
+	return path
+}
+
+// timedTrace is a trace along with the times of its timespan events.
+type timedTrace struct {
+	trace      *Trace
+	start, end time.Time
+}
+
+// timedTracesByEnd sorts traces by decreasing end time.
+type timedTracesByEnd []timedTrace
+
+func (t timedTracesByEnd) Len() int           { return len(t) }
+func (t timedTracesByEnd) Less(i, j int) bool { return t[i].end.After(t[j].end) }
+func (t timedTracesByEnd) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
@@ -0,0 +1,63 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTrace_CriticalPath(t *testing.T) {
+	ms := NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	ms10 := func(n int) time.Duration { return time.Duration(n) * 10 * time.Millisecond }
+
+	// record records a span named name in rec, lasting from base+start to
+	// base+end (in units of 10ms).
+	record := func(rec *Recorder, name string, start, end int) *Recorder {
+		rec.Name(name)
+		rec.Event(Timespan{S: base.Add(ms10(start)), E: base.Add(ms10(end))})
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		return rec
+	}
+
+	// root: 0-10
+	//   a:  1-5       (concurrent with b, which finishes later)
+	//     a1: 1-2     (before b started, so on the path)
+	//   b:    2-8
+	//   c:          8.5-9.5
+	root := record(NewRecorder(SpanID{Trace: 1, Span: 1}, ms), "root", 0, 10)
+	a := record(root.Child(), "a", 1, 5)
+	record(a.Child(), "a1", 1, 2)
+	record(root.Child(), "b", 2, 8)
+	c := root.Child()
+	c.Name("c")
+	c.Event(Timespan{S: base.Add(85 * time.Millisecond), E: base.Add(95 * time.Millisecond)})
+	c.Finish()
+
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type segment struct {
+		Name       string
+		Start, End time.Duration
+	}
+	var got []segment
+	for _, s := range trace.CriticalPath() {
+		got = append(got, segment{s.Span.Span.Name(), s.Start.Sub(base), s.End.Sub(base)})
+	}
+	want := []segment{
+		{"root", 0, ms10(1)},
+		{"a1", ms10(1), ms10(2)},
+		{"b", ms10(2), ms10(8)},
+		{"root", ms10(8), 85 * time.Millisecond},
+		{"c", 85 * time.Millisecond, 95 * time.Millisecond},
+		{"root", 95 * time.Millisecond, ms10(10)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got critical path %+v, want %+v", got, want)
+	}
+}
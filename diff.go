@@ -0,0 +1,114 @@
+package appdash
+
+import "time"
+
+// A TraceDiff is the difference between a span in one trace (A) and the
+// matching span in another trace (B), as computed by DiffTraces.
+type TraceDiff struct {
+	// A and B are the matching spans (and their subtrees) in the two traces.
+	// A is nil if the span was added in B, and B is nil if the span was
+	// removed in B.
+	A, B *Trace
+
+	// DurationA and DurationB are the durations of the spans' timespan
+	// events, or zero if the span is missing or has no timespan events.
+	DurationA, DurationB time.Duration
+
+	// Sub is the differences between the children of the spans.
+	Sub []*TraceDiff
+}
+
+// Added reports whether the span is only present in trace B.
+func (d *TraceDiff) Added() bool { return d.A == nil }
+
+// Removed reports whether the span is only present in trace A.
+func (d *TraceDiff) Removed() bool { return d.B == nil }
+
+// Delta returns how much longer the span took in trace B than in trace A, or
+// zero if the span is not present in both traces.
+func (d *TraceDiff) Delta() time.Duration {
+	if d.A == nil || d.B == nil {
+		return 0
+	}
+	return d.DurationB - d.DurationA
+}
+
+// Name returns the name of the span, or "" if both A and B are nil (as in
+// DiffTraces(nil, nil)).
+func (d *TraceDiff) Name() string {
+	switch {
+	case d.A != nil:
+		return d.A.Span.Name()
+	case d.B != nil:
+		return d.B.Span.Name()
+	}
+	return ""
+}
+
+// Changes returns the differences in d and its descendants, in depth-first
+// order, for spans that were added, removed, or got slower by more than
+// minDelta. The descendants of an added or removed span are not returned, as
+// they were added or removed along with it.
+func (d *TraceDiff) Changes(minDelta time.Duration) []*TraceDiff {
+	return d.changes(nil, minDelta)
+}
+
+func (d *TraceDiff) changes(changes []*TraceDiff, minDelta time.Duration) []*TraceDiff {
+	if d.Added() || d.Removed() {
+		return append(changes, d)
+	}
+	if d.Delta() > minDelta {
+		changes = append(changes, d)
+	}
+	for _, sub := range d.Sub {
+		changes = sub.changes(changes, minDelta)
+	}
+	return changes
+}
+
+// DiffTraces compares two traces, such as a fast and a slow trace for the same
+// root span, and returns the difference between them. The root spans are
+// always matched with each other. The children of two matching spans are
+// matched by name and position: the n-th child (in order of start time) with
+// a given name in A matches the n-th child with that name in B.
+func DiffTraces(a, b *Trace) *TraceDiff {
+	d := &TraceDiff{A: a, B: b}
+	if a != nil {
+		d.DurationA = a.Duration()
+	}
+	if b != nil {
+		d.DurationB = b.Duration()
+	}
+
+	var subA, subB []*Trace
+	if a != nil {
+		subA = a.ChildrenByStart()
+	}
+	if b != nil {
+		subB = b.ChildrenByStart()
+	}
+
+	// Index the children of B by name, in order, so that each child of A can
+	// take the next unmatched child of B with the same name.
+	byName := map[string][]*Trace{}
+	for _, t := range subB {
+		byName[t.Span.Name()] = append(byName[t.Span.Name()], t)
+	}
+	matched := map[*Trace]struct{}{}
+	for _, t := range subA {
+		name := t.Span.Name()
+		var match *Trace
+		if candidates := byName[name]; len(candidates) > 0 {
+			match = candidates[0]
+			byName[name] = candidates[1:]
+			matched[match] = struct{}{}
+		}
+		d.Sub = append(d.Sub, DiffTraces(t, match))
+	}
+	for _, t := range subB {
+		if _, ok := matched[t]; !ok {
+			d.Sub = append(d.Sub, DiffTraces(nil, t))
+		}
+	}
+	return d
+}
@@ -0,0 +1,78 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffTraces(t *testing.T) {
+	ms := NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// record records a span named name in rec, starting at base+offset and
+	// lasting d.
+	record := func(rec *Recorder, name string, offset, d time.Duration) *Recorder {
+		rec.Name(name)
+		rec.Event(Timespan{S: base.Add(offset), E: base.Add(offset + d)})
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		return rec
+	}
+
+	// Trace A: GET /x -> [db, cache, db]
+	a := record(NewRecorder(SpanID{Trace: 1, Span: 1}, ms), "GET /x", 0, 100*time.Millisecond)
+	record(a.Child(), "db", 10*time.Millisecond, 10*time.Millisecond)
+	record(a.Child(), "cache", 20*time.Millisecond, 5*time.Millisecond)
+	record(a.Child(), "db", 30*time.Millisecond, 10*time.Millisecond)
+
+	// Trace B: GET /x -> [db, db (slower), rpc]
+	b := record(NewRecorder(SpanID{Trace: 2, Span: 2}, ms), "GET /x", 0, 300*time.Millisecond)
+	record(b.Child(), "db", 10*time.Millisecond, 10*time.Millisecond)
+	record(b.Child(), "db", 30*time.Millisecond, 200*time.Millisecond)
+	record(b.Child(), "rpc", 40*time.Millisecond, 50*time.Millisecond)
+
+	ta, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tb, err := ms.Trace(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := DiffTraces(ta, tb)
+
+	if got, want := d.Delta(), 200*time.Millisecond; got != want {
+		t.Errorf("root: got delta %s, want %s", got, want)
+	}
+	var got []string
+	for _, c := range d.Changes(time.Millisecond) {
+		switch {
+		case c.Added():
+			got = append(got, "+"+c.Name())
+		case c.Removed():
+			got = append(got, "-"+c.Name())
+		default:
+			got = append(got, c.Name()+" "+c.Delta().String())
+		}
+	}
+	want := []string{"GET /x 200ms", "-cache", "db 190ms", "+rpc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got changes %q, want %q", got, want)
+	}
+}
+
+func TestDiffTraces_nil(t *testing.T) {
+	d := DiffTraces(nil, nil)
+	if got := d.Name(); got != "" {
+		t.Errorf("got name %q, want empty", got)
+	}
+	if got := d.Delta(); got != 0 {
+		t.Errorf("got delta %s, want 0", got)
+	}
+	if len(d.Sub) != 0 {
+		t.Errorf("got %d children, want none", len(d.Sub))
+	}
+}
@@ -55,6 +55,7 @@ func main() {
 	}
 	tapp.Store = store
 	tapp.Queryer = memStore
+	tapp.Aggregator = memStore
 	log.Println("Appdash web UI running on HTTP :8700")
 	go func() {
 		log.Fatal(http.ListenAndServe(":8700", tapp))
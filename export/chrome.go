@@ -0,0 +1,254 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// ChromeTracks determines how the spans of a trace are laid out in tracks
+// (threads) in the Chrome trace event format.
+type ChromeTracks int
+
+const (
+	// ChromeTracksByDepth puts the spans of each depth in the trace tree in
+	// their own track, so that the root span is in the first track, its
+	// children in the second, and so on. Overlapping spans of the same depth
+	// are put in additional tracks for that depth.
+	ChromeTracksByDepth ChromeTracks = iota
+
+	// ChromeTracksByService puts the spans of each service (see the
+	// "service.name" annotation) in their own process, with a track for each
+	// depth within the service.
+	ChromeTracksByService
+)
+
+// ChromeTrace is a trace in the Chrome trace event format, which can be
+// opened in chrome://tracing or Perfetto (https://ui.perfetto.dev).
+//
+// See https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+// for the format.
+type ChromeTrace struct {
+	TraceEvents     []ChromeEvent `json:"traceEvents"`
+	DisplayTimeUnit string        `json:"displayTimeUnit,omitempty"`
+}
+
+// A ChromeEvent is an event in a ChromeTrace. Times are in microseconds.
+type ChromeEvent struct {
+	Name  string                 `json:"name"`
+	Cat   string                 `json:"cat,omitempty"`
+	Phase string                 `json:"ph"`
+	Time  float64                `json:"ts"`
+	Dur   float64                `json:"dur,omitempty"`
+	PID   int                    `json:"pid"`
+	TID   int                    `json:"tid"`
+	Scope string                 `json:"s,omitempty"`
+	Args  map[string]interface{} `json:"args,omitempty"`
+}
+
+// Chrome trace event phases.
+const (
+	chromeComplete = "X"
+	chromeInstant  = "i"
+	chromeMetadata = "M"
+)
+
+// ChromeTraceEvents converts the trace into the Chrome trace event format.
+// Each span with a timespan becomes a complete ("X") event, whose arguments
+// are the span's IDs and the annotations that are not part of an event, and
+// each log event becomes an instant ("i") event in the track of its span.
+// It returns an error if the events of a span can't be unmarshaled.
+func ChromeTraceEvents(t *appdash.Trace, tracks ChromeTracks) (*ChromeTrace, error) {
+	ct := &ChromeTrace{DisplayTimeUnit: "ms"}
+
+	var (
+		pids      = map[string]int{} // service name -> pid
+		tids      = map[chromeTrack]bool{}
+		processes []string // names of the processes, by pid-1
+	)
+	pidOf := func(service string) int {
+		if tracks == ChromeTracksByDepth {
+			service = ""
+		}
+		pid, ok := pids[service]
+		if !ok {
+			processes = append(processes, service)
+			pid = len(processes)
+			pids[service] = pid
+		}
+		return pid
+	}
+
+	var spans []*chromeSpan
+	maxDepth := 0
+	for _, s := range t.Flatten() {
+		info, err := newSpanInfo(&s.Span)
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, &chromeSpan{info: info, track: chromeTrack{pid: pidOf(info.service), depth: s.Depth}})
+		if s.Depth > maxDepth {
+			maxDepth = s.Depth
+		}
+	}
+
+	// The complete events in a track must be properly nested, but sibling
+	// spans (e.g. concurrent requests) may overlap. Each span is therefore
+	// put in the first lane of its depth that is free at its start, and
+	// each lane is a separate track.
+	byStart := append([]*chromeSpan(nil), spans...)
+	sort.Stable(chromeSpansByStart(byStart))
+	laneEnds := map[chromeTrack][]time.Time{} // end of the last span in each lane of a depth
+	maxLane := 0
+	for _, s := range byStart {
+		if s.info.start.IsZero() {
+			continue // only log events, which don't need to be nested
+		}
+		ends := laneEnds[s.track]
+		lane := 0
+		for lane < len(ends) && ends[lane].After(s.info.start) {
+			lane++
+		}
+		if lane == len(ends) {
+			ends = append(ends, s.info.end)
+		} else {
+			ends[lane] = s.info.end
+		}
+		laneEnds[s.track] = ends
+		s.track.lane = lane
+		if lane > maxLane {
+			maxLane = lane
+		}
+	}
+	// The first lane of each depth has the depth as its thread ID.
+	tidOf := func(tr chromeTrack) int { return tr.depth + tr.lane*(maxDepth+1) }
+
+	var events []ChromeEvent
+	for _, s := range spans {
+		info, tr := s.info, s.track
+		tids[tr] = true
+		tid := tidOf(tr)
+
+		if !info.start.IsZero() {
+			args := map[string]interface{}{
+				"span_id": info.id.Span.String(),
+			}
+			if info.id.Parent != 0 {
+				args["parent_id"] = info.id.Parent.String()
+			}
+			for _, a := range info.tags {
+				args[a.Key] = string(a.Value)
+			}
+			switch {
+			case info.server != nil:
+				for k, v := range httpTags(info.server.Request, info.server.Response, info.server.Route) {
+					args[k] = v
+				}
+			case info.client != nil:
+				for k, v := range httpTags(info.client.Request, info.client.Response, "") {
+					args[k] = v
+				}
+			}
+			name := info.name
+			if name == "" {
+				name = info.id.Span.String()
+			}
+			events = append(events, ChromeEvent{
+				Name:  name,
+				Cat:   chromeCategory(info),
+				Phase: chromeComplete,
+				Time:  chromeTime(info.start),
+				Dur:   float64(info.end.Sub(info.start)) / float64(time.Microsecond),
+				PID:   tr.pid,
+				TID:   tid,
+				Args:  args,
+			})
+		}
+		for _, l := range info.logs {
+			events = append(events, ChromeEvent{
+				Name:  l.msg,
+				Cat:   "log",
+				Phase: chromeInstant,
+				Time:  chromeTime(l.time),
+				PID:   tr.pid,
+				TID:   tid,
+				Scope: "t",
+			})
+		}
+	}
+
+	// Name the processes and tracks, so that they are shown as e.g. "api"
+	// and "depth 1" instead of as numbers. The metadata events are sorted by
+	// track so that the output is deterministic.
+	for i, name := range processes {
+		if name == "" {
+			name = fmt.Sprintf("trace %s", t.ID.Trace)
+		}
+		ct.TraceEvents = append(ct.TraceEvents, chromeMetadataEvent("process_name", i+1, 0, name))
+	}
+	for tr := range tids {
+		name := fmt.Sprintf("depth %d", tr.depth)
+		if tr.lane > 0 {
+			name = fmt.Sprintf("depth %d (%d)", tr.depth, tr.lane+1)
+		}
+		tid := tidOf(tr)
+		ct.TraceEvents = append(ct.TraceEvents,
+			chromeMetadataEvent("thread_name", tr.pid, tid, name),
+			ChromeEvent{Name: "thread_sort_index", Phase: chromeMetadata, PID: tr.pid, TID: tid, Args: map[string]interface{}{"sort_index": tr.depth*(maxLane+1) + tr.lane}},
+		)
+	}
+	sort.Stable(chromeEventsByTrack(ct.TraceEvents))
+	ct.TraceEvents = append(ct.TraceEvents, events...)
+	return ct, nil
+}
+
+// A chromeTrack is a track (thread) of a process in a ChromeTrace. The spans
+// of a depth are in several lanes if they overlap.
+type chromeTrack struct{ pid, depth, lane int }
+
+type chromeSpan struct {
+	info  *spanInfo
+	track chromeTrack
+}
+
+type chromeSpansByStart []*chromeSpan
+
+func (s chromeSpansByStart) Len() int           { return len(s) }
+func (s chromeSpansByStart) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s chromeSpansByStart) Less(i, j int) bool { return s[i].info.start.Before(s[j].info.start) }
+
+// chromeCategory returns the category of a span's event, which can be used
+// to filter events in the trace viewers.
+func chromeCategory(info *spanInfo) string {
+	if info.kind != "" {
+		return info.kind
+	}
+	return "span"
+}
+
+// chromeTime returns t in microseconds since the Unix epoch. The whole
+// microseconds are converted separately so that they are exact.
+func chromeTime(t time.Time) float64 {
+	ns := t.UnixNano()
+	return float64(ns/int64(time.Microsecond)) + float64(ns%int64(time.Microsecond))/float64(time.Microsecond)
+}
+
+func chromeMetadataEvent(name string, pid, tid int, value string) ChromeEvent {
+	return ChromeEvent{Name: name, Phase: chromeMetadata, PID: pid, TID: tid, Args: map[string]interface{}{"name": value}}
+}
+
+type chromeEventsByTrack []ChromeEvent
+
+func (e chromeEventsByTrack) Len() int      { return len(e) }
+func (e chromeEventsByTrack) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+func (e chromeEventsByTrack) Less(i, j int) bool {
+	if e[i].PID != e[j].PID {
+		return e[i].PID < e[j].PID
+	}
+	if e[i].TID != e[j].TID {
+		return e[i].TID < e[j].TID
+	}
+	return e[i].Name < e[j].Name
+}
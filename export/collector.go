@@ -0,0 +1,279 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
+)
+
+// Format is the format that a Collector sends spans in.
+type Format int
+
+const (
+	// Zipkin is the Zipkin v2 JSON format, as accepted by the
+	// /api/v2/spans endpoint of a Zipkin server.
+	Zipkin Format = iota
+
+	// OTLP is the OTLP/HTTP protobuf encoding, as accepted by the /v1/traces
+	// endpoint of an OpenTelemetry collector.
+	OTLP
+)
+
+// Defaults for the Collector fields.
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = time.Second
+)
+
+// defaultClient is the HTTP client of a Collector without a Client. Unlike
+// http.DefaultClient, it has a timeout, so that an unresponsive server
+// doesn't block Flush (and Collect) indefinitely.
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// errCollectorStopped is returned by Collector.Collect after Stop.
+var errCollectorStopped = errors.New("export: Collector is stopped")
+
+// Collector is an appdash.Collector that converts the spans it collects into
+// the Zipkin or OTLP format and sends them to an HTTP endpoint, such as a
+// Zipkin server or an OpenTelemetry collector.
+//
+// The spans are buffered and sent in batches of up to BatchSize spans per
+// request, at least every FlushInterval (in a separate goroutine). The
+// annotations of a span that is collected several times before it is sent
+// are sent together. Call Stop (or Flush) before exiting, so that the
+// buffered spans are not lost.
+type Collector struct {
+	// URL is the URL that spans are sent to, e.g.
+	// "http://localhost:9411/api/v2/spans" for Zipkin or
+	// "http://localhost:4318/v1/traces" for OTLP.
+	URL string
+
+	// Format is the format that spans are sent in.
+	Format Format
+
+	// ServiceName, if non-empty, is the service name of the spans that
+	// don't have a "service.name" annotation.
+	ServiceName string
+
+	// Client is the HTTP client that sends the requests, or nil to use a
+	// client with a 10 second timeout.
+	Client *http.Client
+
+	// BatchSize is the maximum number of spans sent in one request. When
+	// this many spans are buffered, Collect sends them. If zero, 100 is used.
+	BatchSize int
+
+	// FlushInterval is how often the buffered spans are sent. If zero, 1
+	// second is used.
+	FlushInterval time.Duration
+
+	mu       sync.Mutex
+	pending  []*appdash.Span                  // spans to send, in the order they were first collected
+	bySpanID map[appdash.SpanID]*appdash.Span // the spans in pending, by ID
+
+	// lastErr is the error of the last background flush, if any, which is
+	// returned by the next call to Collect.
+	lastErr error
+
+	started, stopped bool
+	stopChan         chan struct{}
+}
+
+// NewZipkinCollector returns a Collector that sends spans to a Zipkin server
+// at the given URL.
+func NewZipkinCollector(url string) *Collector {
+	return &Collector{URL: url, Format: Zipkin}
+}
+
+// NewOTLPCollector returns a Collector that sends spans to an OTLP/HTTP
+// receiver at the given URL.
+func NewOTLPCollector(url string) *Collector {
+	return &Collector{URL: url, Format: OTLP}
+}
+
+// Collect implements the appdash.Collector interface by adding the span's
+// annotations to the buffer. If the buffer then holds BatchSize spans, they
+// are sent before Collect returns. It returns an error if that request (or
+// the previous background flush) fails or does not succeed with a 2xx status
+// code.
+func (c *Collector) Collect(id appdash.SpanID, anns ...appdash.Annotation) error {
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return errCollectorStopped
+	}
+	if !c.started {
+		c.start()
+	}
+	if s, present := c.bySpanID[id]; present {
+		s.Annotations = append(s.Annotations, anns...)
+	} else {
+		if c.bySpanID == nil {
+			c.bySpanID = map[appdash.SpanID]*appdash.Span{}
+		}
+		s := &appdash.Span{ID: id, Annotations: append(appdash.Annotations(nil), anns...)}
+		c.pending = append(c.pending, s)
+		c.bySpanID[id] = s
+	}
+	var batch []*appdash.Span
+	if len(c.pending) >= c.batchSize() {
+		batch = c.takeNoLock(c.batchSize())
+	}
+	lastErr := c.lastErr
+	c.lastErr = nil
+	c.mu.Unlock()
+
+	if batch != nil {
+		if err := c.send(batch); err != nil {
+			return err
+		}
+	}
+	return lastErr
+}
+
+// Flush immediately sends all buffered spans, and returns the first error
+// that occurs.
+func (c *Collector) Flush() error {
+	var batches [][]*appdash.Span
+	c.mu.Lock()
+	for len(c.pending) > 0 {
+		batches = append(batches, c.takeNoLock(c.batchSize()))
+	}
+	c.mu.Unlock()
+
+	var firstErr error
+	for _, batch := range batches {
+		if err := c.send(batch); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Stop stops sending spans periodically and sends the buffered spans (see
+// Flush). After stopping, calls to Collect fail.
+func (c *Collector) Stop() error {
+	c.mu.Lock()
+	if c.started && !c.stopped {
+		close(c.stopChan)
+	}
+	c.stopped = true
+	c.mu.Unlock()
+	return c.Flush()
+}
+
+func (c *Collector) start() {
+	c.stopChan = make(chan struct{})
+	c.started = true
+	interval := c.FlushInterval
+	if interval == 0 {
+		interval = defaultFlushInterval
+	}
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := c.Flush(); err != nil {
+					c.mu.Lock()
+					c.lastErr = err
+					c.mu.Unlock()
+				}
+			case <-c.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+func (c *Collector) batchSize() int {
+	if c.BatchSize > 0 {
+		return c.BatchSize
+	}
+	return defaultBatchSize
+}
+
+// takeNoLock removes up to n spans from the front of the buffer and returns
+// them. The caller must hold c.mu.
+func (c *Collector) takeNoLock(n int) []*appdash.Span {
+	if n > len(c.pending) {
+		n = len(c.pending)
+	}
+	batch := c.pending[:n:n]
+	c.pending = c.pending[n:]
+	for _, s := range batch {
+		delete(c.bySpanID, s.ID)
+	}
+	if len(c.pending) == 0 {
+		c.pending = nil
+	}
+	return batch
+}
+
+// send sends the spans to c.URL in one request.
+func (c *Collector) send(spans []*appdash.Span) error {
+	if c.ServiceName != "" {
+		for _, s := range spans {
+			if s.Annotations.StringMap()[zipkin.ServiceNameKey] == "" {
+				s.Annotations = append(s.Annotations, appdash.Annotation{Key: zipkin.ServiceNameKey, Value: []byte(c.ServiceName)})
+			}
+		}
+	}
+
+	body, contentType, err := c.encode(spans)
+	if err != nil {
+		return err
+	}
+
+	client := c.Client
+	if client == nil {
+		client = defaultClient
+	}
+	resp, err := client.Post(c.URL, contentType, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("export: sending %d spans to %s failed: %s: %s", len(spans), c.URL, resp.Status, bytes.TrimSpace(msg))
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+// encode returns the request body that sends the spans in c.Format, and its
+// content type.
+func (c *Collector) encode(spans []*appdash.Span) ([]byte, string, error) {
+	switch c.Format {
+	case Zipkin:
+		var zs []zipkin.Span
+		for _, s := range spans {
+			z, err := zipkinSpans(s)
+			if err != nil {
+				return nil, "", err
+			}
+			zs = append(zs, z...)
+		}
+		body, err := json.Marshal(zs)
+		return body, "application/json", err
+	case OTLP:
+		req, err := otlpRequest(spans)
+		if err != nil {
+			return nil, "", err
+		}
+		body, err := req.Marshal()
+		return body, "application/x-protobuf", err
+	}
+	return nil, "", fmt.Errorf("export: unknown format %d", c.Format)
+}
@@ -0,0 +1,200 @@
+// Package export converts appdash traces into the Zipkin v2 JSON, OTLP and
+// Chrome trace event formats, and provides a Collector that sends the spans that it collects to
+// a Zipkin or OpenTelemetry (OTLP/HTTP) server.
+//
+// This lets services that are instrumented with appdash (with a Recorder and
+// the httptrace package) report their spans to another tracing system:
+//
+//	collector := export.NewOTLPCollector("http://localhost:4318/v1/traces")
+//	defer collector.Stop() // send the buffered spans
+//	tracemw := httptrace.Middleware(collector, &httptrace.MiddlewareConfig{})
+//
+// The conversions are the reverse of those of the zipkin and otlp packages,
+// so spans that were collected from Zipkin or OpenTelemetry are exported
+// mostly as they were received. In particular:
+//
+//   - The span name is taken from the SpanNameEvent, and the start and end
+//     times from the span's timespan events (see Trace.StartTime).
+//   - httptrace.ClientEvent and httptrace.ServerEvent make a span a client or
+//     server span, and their request and response are added as http.*
+//     tags or attributes. A span with both (i.e. an HTTP request that was
+//     traced by both the client and the server) becomes a pair of client and
+//     shared server spans in Zipkin, and a server span in OTLP.
+//   - Log events become Zipkin annotations or OTLP span events.
+//   - The "service.name" annotation becomes the local endpoint's service name
+//     in Zipkin and the resource's service name in OTLP.
+//   - The annotations that are not part of an event become tags or
+//     attributes.
+//
+// Appdash trace IDs are 64 bits long, so unless the full 128-bit ID of a
+// trace was kept when it was collected (by the zipkin or otlp package), the
+// upper 64 bits of the exported trace ID are zero.
+package export
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+	"sourcegraph.com/sourcegraph/appdash/otlp"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
+)
+
+// spanInfo is the information about a span that is exported.
+type spanInfo struct {
+	id          appdash.SpanID
+	traceIDHigh appdash.ID // upper 64 bits of the trace ID, if known
+	name        string
+	start, end  time.Time
+	kind        string // "client", "server", etc. (as in the span.kind annotation)
+
+	client *httptrace.ClientEvent
+	server *httptrace.ServerEvent
+	logs   []logEntry
+
+	service, peerService string
+
+	// tags are the annotations that are not part of an event, and are not
+	// used for any of the fields above.
+	tags appdash.Annotations
+}
+
+type logEntry struct {
+	time time.Time
+	msg  string
+}
+
+// consumedKeys are the annotation keys that are used for the fields of a
+// spanInfo, and are not exported as tags.
+var consumedKeys = map[string]bool{
+	zipkin.ServiceNameKey: true,
+	zipkin.PeerServiceKey: true,
+	zipkin.TraceIDKey:     true,
+	otlp.TraceIDKey:       true,
+	otlp.SpanKindKey:      true,
+}
+
+func newSpanInfo(s *appdash.Span) (*spanInfo, error) {
+	t := &appdash.Trace{Span: *s}
+	info := &spanInfo{
+		id:    s.ID,
+		name:  s.Name(),
+		start: t.StartTime(),
+		end:   t.EndTime(),
+		logs:  logEntries(s.Annotations),
+	}
+
+	var events []appdash.Event
+	if err := appdash.UnmarshalEvents(s.Annotations, &events); err != nil {
+		return nil, err
+	}
+	eventKeys := map[string]bool{}
+	for _, e := range events {
+		switch e := e.(type) {
+		case httptrace.ClientEvent:
+			info.client = &e
+		case httptrace.ServerEvent:
+			info.server = &e
+		}
+		anns, err := appdash.MarshalEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range anns {
+			eventKeys[a.Key] = true
+		}
+	}
+
+	for _, a := range s.Annotations {
+		switch a.Key {
+		case zipkin.ServiceNameKey:
+			info.service = string(a.Value)
+		case zipkin.PeerServiceKey:
+			info.peerService = string(a.Value)
+		case otlp.SpanKindKey:
+			info.kind = string(a.Value)
+		case zipkin.TraceIDKey, otlp.TraceIDKey:
+			if hi, _, err := zipkin.ParseID(string(a.Value)); err == nil {
+				info.traceIDHigh = hi
+			}
+		}
+		if !eventKeys[a.Key] && !consumedKeys[a.Key] && !strings.HasPrefix(a.Key, appdash.SchemaPrefix) {
+			info.tags = append(info.tags, a)
+		}
+	}
+	switch {
+	case info.server != nil:
+		info.kind = "server"
+	case info.client != nil:
+		info.kind = "client"
+	}
+	return info, nil
+}
+
+// logEntries returns the log events in the annotations. They are found by
+// their schema annotations, since UnmarshalEvents only returns one event per
+// schema.
+func logEntries(anns appdash.Annotations) []logEntry {
+	var (
+		logs    []logEntry
+		msg, ts string
+	)
+	for _, a := range anns {
+		switch a.Key {
+		case "Msg":
+			msg = string(a.Value)
+		case "Time":
+			ts = string(a.Value)
+		case appdash.SchemaPrefix + "log":
+			t, _ := time.Parse(time.RFC3339Nano, ts)
+			logs = append(logs, logEntry{time: t, msg: msg})
+			msg, ts = "", ""
+		}
+	}
+	return logs
+}
+
+// traceIDHex returns the span's 128-bit (or 64-bit, if the upper 64 bits are
+// unknown) trace ID in hex.
+func (s *spanInfo) traceIDHex() string {
+	if s.traceIDHigh != 0 {
+		return s.traceIDHigh.String() + s.id.Trace.String()
+	}
+	return s.id.Trace.String()
+}
+
+// traceIDBytes returns the span's 128-bit trace ID.
+func (s *spanInfo) traceIDBytes() []byte {
+	b, _ := hex.DecodeString(s.traceIDHigh.String() + s.id.Trace.String())
+	return b
+}
+
+// httpTags returns the http.* tags of an HTTP request and response, in the
+// conventions used by Zipkin (which are also the older OpenTelemetry
+// conventions).
+func httpTags(req httptrace.RequestInfo, resp httptrace.ResponseInfo, route string) map[string]string {
+	tags := map[string]string{}
+	if req.Method != "" {
+		tags["http.method"] = req.Method
+	}
+	if req.URI != "" {
+		path := req.URI
+		if i := strings.Index(path, "?"); i >= 0 {
+			path = path[:i]
+		}
+		tags["http.path"] = path
+	}
+	if req.Host != "" {
+		tags["http.host"] = req.Host
+	}
+	if resp.StatusCode != 0 {
+		tags["http.status_code"] = strconv.Itoa(resp.StatusCode)
+	}
+	if route != "" {
+		tags["http.route"] = route
+	}
+	return tags
+}
@@ -0,0 +1,437 @@
+package export
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+	"sourcegraph.com/sourcegraph/appdash/otlp"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
+)
+
+var testStart = time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// testTrace returns a trace with a root span that handled an HTTP request
+// and a child span for an HTTP request that was traced by both its client and
+// its server.
+func testTrace(t *testing.T) *appdash.Trace {
+	ms := appdash.NewMemoryStore()
+	rec := func(id appdash.SpanID, events ...appdash.Event) *appdash.Recorder {
+		r := appdash.NewRecorder(id, ms)
+		for _, e := range events {
+			r.Event(e)
+		}
+		return r
+	}
+	at := func(msec int) time.Time { return testStart.Add(time.Duration(msec) * time.Millisecond) }
+
+	root := appdash.SpanID{Trace: 0x48485a3953bb6124, Span: 1}
+	r := rec(root,
+		appdash.SpanName("GET /users"),
+		httptrace.ServerEvent{
+			Request:    httptrace.RequestInfo{Method: "GET", URI: "/users?page=2", Host: "example.com"},
+			Response:   httptrace.ResponseInfo{StatusCode: 200},
+			ServerRecv: at(0),
+			ServerSend: at(10),
+		},
+		appdash.LogWithTimestamp("cache miss", at(1)),
+		appdash.LogWithTimestamp("done", at(9)),
+	)
+	r.Annotation(
+		appdash.Annotation{Key: zipkin.ServiceNameKey, Value: []byte("frontend")},
+		appdash.Annotation{Key: zipkin.TraceIDKey, Value: []byte("463ac35c9f6413ad48485a3953bb6124")},
+		appdash.Annotation{Key: "user", Value: []byte("alice")},
+	)
+	r.Finish()
+
+	child := appdash.SpanID{Trace: root.Trace, Span: 2, Parent: root.Span}
+	r = rec(child, appdash.SpanName("api"), httptrace.ClientEvent{
+		Request:    httptrace.RequestInfo{Method: "POST", URI: "/api"},
+		Response:   httptrace.ResponseInfo{StatusCode: 503},
+		ClientSend: at(2),
+		ClientRecv: at(8),
+	})
+	r.Finish()
+	r = rec(child, httptrace.ServerEvent{
+		Request:    httptrace.RequestInfo{Method: "POST", URI: "/api"},
+		Response:   httptrace.ResponseInfo{StatusCode: 503},
+		ServerRecv: at(3),
+		ServerSend: at(7),
+	})
+	r.Annotation(appdash.Annotation{Key: zipkin.ServiceNameKey, Value: []byte("api")})
+	r.Finish()
+
+	tr, err := ms.Trace(root.Trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tr
+}
+
+func TestZipkinSpans(t *testing.T) {
+	spans, err := ZipkinSpans(testTrace(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3: %+v", len(spans), spans)
+	}
+
+	root := spans[0]
+	wantRoot := zipkin.Span{
+		TraceID:       "463ac35c9f6413ad48485a3953bb6124",
+		ID:            "0000000000000001",
+		Name:          "GET /users",
+		Kind:          zipkin.Server,
+		Timestamp:     testStart.UnixNano() / 1000,
+		Duration:      10000,
+		LocalEndpoint: &zipkin.Endpoint{ServiceName: "frontend"},
+		Annotations: []zipkin.Annotation{
+			{Timestamp: testStart.UnixNano()/1000 + 1000, Value: "cache miss"},
+			{Timestamp: testStart.UnixNano()/1000 + 9000, Value: "done"},
+		},
+		Tags: map[string]string{
+			"user":             "alice",
+			"http.method":      "GET",
+			"http.path":        "/users",
+			"http.host":        "example.com",
+			"http.status_code": "200",
+		},
+	}
+	if !reflect.DeepEqual(root, wantRoot) {
+		t.Errorf("got root span\n%+v\nwant\n%+v", root, wantRoot)
+	}
+
+	client, server := spans[1], spans[2]
+	if client.Kind != zipkin.Client || client.ID != server.ID || client.ParentID != root.ID || client.Duration != 6000 {
+		t.Errorf("got client span %+v, want a 6ms client span with the ID of the server span", client)
+	}
+	if server.Kind != zipkin.Server || !server.Shared || server.Duration != 4000 || server.LocalEndpoint.ServiceName != "api" {
+		t.Errorf("got server span %+v, want a 4ms shared server span of the api service", server)
+	}
+
+	// Collecting the spans again must produce the same tree.
+	ms := appdash.NewMemoryStore()
+	if err := zipkin.Collect(ms, spans...); err != nil {
+		t.Fatal(err)
+	}
+	tr, err := ms.Trace(0x48485a3953bb6124)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Span.Name() != "GET /users" || len(tr.Sub) != 1 || tr.Sub[0].Span.Name() != "api" {
+		t.Errorf("got collected trace\n%s", tr.TreeString())
+	}
+	if d := tr.Duration(); d != 10*time.Millisecond {
+		t.Errorf("got collected trace duration %s, want 10ms", d)
+	}
+}
+
+func TestOTLPRequest(t *testing.T) {
+	req, err := OTLPRequest(testTrace(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := req.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got otlp.ExportTraceServiceRequest
+	if err := got.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, req) {
+		t.Errorf("got unmarshaled request\n%+v\nwant\n%+v", &got, req)
+	}
+
+	// The spans are grouped by service.
+	if len(req.ResourceSpans) != 2 {
+		t.Fatalf("got %d resources, want 2", len(req.ResourceSpans))
+	}
+	for i, service := range []string{"frontend", "api"} {
+		rs := req.ResourceSpans[i]
+		if got := rs.Resource.Attributes[0].Value.String(); got != service {
+			t.Errorf("got resource %d service %q, want %q", i, got, service)
+		}
+		if len(rs.ScopeSpans) != 1 || len(rs.ScopeSpans[0].Spans) != 1 {
+			t.Errorf("got resource %d scopes %+v, want one scope with one span", i, rs.ScopeSpans)
+		}
+	}
+	root := req.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if root.Kind != otlp.SpanKindServer || len(root.Events) != 2 || root.Status != nil {
+		t.Errorf("got root span %+v, want server span with 2 events and no status", root)
+	}
+	api := req.ResourceSpans[1].ScopeSpans[0].Spans[0]
+	if api.Status == nil || api.Status.Code != otlp.StatusCodeError {
+		t.Errorf("got api span status %+v, want error for HTTP status 503", api.Status)
+	}
+
+	ms := appdash.NewMemoryStore()
+	if err := otlp.Collect(ms, &got); err != nil {
+		t.Fatal(err)
+	}
+	tr, err := ms.Trace(0x48485a3953bb6124)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Span.Name() != "GET /users" || len(tr.Sub) != 1 || tr.Sub[0].Span.Name() != "api" {
+		t.Errorf("got collected trace\n%s", tr.TreeString())
+	}
+	m := tr.Annotations.StringMap()
+	if m[otlp.TraceIDKey] != "463ac35c9f6413ad48485a3953bb6124" || m["user"] != "alice" || m["http.method"] != "GET" {
+		t.Errorf("got collected root annotations\n%s", tr.Annotations)
+	}
+}
+
+func TestCollector(t *testing.T) {
+	tr := testTrace(t)
+	for _, format := range []Format{Zipkin, OTLP} {
+		ms := appdash.NewMemoryStore()
+		var h http.Handler = &zipkin.Handler{Collector: ms}
+		if format == OTLP {
+			h = &otlp.Handler{Collector: ms}
+		}
+		srv := httptest.NewServer(h)
+		c := &Collector{URL: srv.URL, Format: format, ServiceName: "default"}
+		for _, s := range tr.Flatten() {
+			if err := c.Collect(s.ID, s.Annotations...); err != nil {
+				t.Fatalf("format %d: %s", format, err)
+			}
+		}
+		if err := c.Stop(); err != nil {
+			t.Fatalf("format %d: %s", format, err)
+		}
+		srv.Close()
+
+		got, err := ms.Trace(tr.ID.Trace)
+		if err != nil {
+			t.Fatalf("format %d: %s", format, err)
+		}
+		if got.Span.Name() != "GET /users" || len(got.Sub) != 1 || got.Sub[0].Span.Name() != "api" {
+			t.Errorf("format %d: got collected trace\n%s", format, got.TreeString())
+		}
+		if service := got.Annotations.StringMap()[zipkin.ServiceNameKey]; service != "frontend" {
+			t.Errorf("format %d: got service %q, want %q", format, service, "frontend")
+		}
+	}
+
+	// Spans without a service get c.ServiceName, and requests that fail
+	// return an error.
+	var service string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var spans []zipkin.Span
+		if err := json.NewDecoder(r.Body).Decode(&spans); err != nil || len(spans) != 1 {
+			t.Errorf("got spans %+v (error %v), want one span", spans, err)
+		} else {
+			service = spans[0].LocalEndpoint.ServiceName
+		}
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	c := &Collector{URL: srv.URL, Format: Zipkin, ServiceName: "default"}
+	defer c.Stop()
+	if err := c.Collect(appdash.SpanID{Trace: 1, Span: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Flush(); err == nil {
+		t.Error("got no error for a failed request")
+	}
+	if service != "default" {
+		t.Errorf("got service %q, want %q", service, "default")
+	}
+}
+
+func TestCollector_batches(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests [][]string // names of the spans in each request
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var spans []zipkin.Span
+		if err := json.NewDecoder(r.Body).Decode(&spans); err != nil {
+			t.Error(err)
+		}
+		var names []string
+		for _, s := range spans {
+			names = append(names, s.Name)
+		}
+		mu.Lock()
+		requests = append(requests, names)
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+	gotRequests := func() [][]string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([][]string(nil), requests...)
+	}
+
+	c := &Collector{URL: srv.URL, Format: Zipkin, BatchSize: 2, FlushInterval: time.Hour}
+	collect := func(span appdash.ID, name string) {
+		if err := c.Collect(appdash.SpanID{Trace: 1, Span: span}, appdash.Annotation{Key: "Name", Value: []byte(name)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The annotations of span 1 are sent together, and a request is sent
+	// once BatchSize spans are buffered.
+	collect(1, "a")
+	if err := c.Collect(appdash.SpanID{Trace: 1, Span: 1}, appdash.Annotation{Key: "k"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := gotRequests(); len(got) != 0 {
+		t.Fatalf("got requests %v before the batch was full, want none", got)
+	}
+	collect(2, "b")
+	collect(3, "c")
+	if got, want := gotRequests(), [][]string{{"a", "b"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got requests %v, want %v", got, want)
+	}
+
+	// Stop sends the rest.
+	if err := c.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := gotRequests(), [][]string{{"a", "b"}, {"c"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got requests %v, want %v", got, want)
+	}
+	if err := c.Collect(appdash.SpanID{Trace: 2, Span: 4}); err == nil {
+		t.Error("got no error for Collect after Stop")
+	}
+
+	// Buffered spans are sent every FlushInterval.
+	c = &Collector{URL: srv.URL, Format: Zipkin, FlushInterval: 10 * time.Millisecond}
+	defer c.Stop()
+	collect(5, "d")
+	for start := time.Now(); len(gotRequests()) < 3; time.Sleep(time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("timed out waiting for the buffered span to be sent")
+		}
+	}
+	if got := gotRequests()[2]; !reflect.DeepEqual(got, []string{"d"}) {
+		t.Errorf("got request %v, want [d]", got)
+	}
+}
+
+func TestChromeTraceEvents(t *testing.T) {
+	tr := testTrace(t)
+	start := float64(testStart.UnixNano() / 1000)
+	tests := []struct {
+		tracks ChromeTracks
+		want   []ChromeEvent // the non-metadata events, without args
+		meta   int           // number of metadata events
+	}{
+		{
+			tracks: ChromeTracksByDepth,
+			want: []ChromeEvent{
+				{Name: "GET /users", Cat: "server", Phase: "X", Time: start, Dur: 10000, PID: 1, TID: 0},
+				{Name: "cache miss", Cat: "log", Phase: "i", Time: start + 1000, PID: 1, TID: 0, Scope: "t"},
+				{Name: "done", Cat: "log", Phase: "i", Time: start + 9000, PID: 1, TID: 0, Scope: "t"},
+				{Name: "api", Cat: "server", Phase: "X", Time: start + 2000, Dur: 6000, PID: 1, TID: 1},
+			},
+			meta: 1 + 2*2,
+		},
+		{
+			tracks: ChromeTracksByService,
+			want: []ChromeEvent{
+				{Name: "GET /users", Cat: "server", Phase: "X", Time: start, Dur: 10000, PID: 1, TID: 0},
+				{Name: "cache miss", Cat: "log", Phase: "i", Time: start + 1000, PID: 1, TID: 0, Scope: "t"},
+				{Name: "done", Cat: "log", Phase: "i", Time: start + 9000, PID: 1, TID: 0, Scope: "t"},
+				{Name: "api", Cat: "server", Phase: "X", Time: start + 2000, Dur: 6000, PID: 2, TID: 1},
+			},
+			meta: 2 + 2*2,
+		},
+	}
+	for _, test := range tests {
+		ct, err := ChromeTraceEvents(tr, test.tracks)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []ChromeEvent
+		for i, e := range ct.TraceEvents {
+			if e.Phase == chromeMetadata {
+				if i >= test.meta {
+					t.Errorf("tracks %d: got metadata event %+v after the other events", test.tracks, e)
+				}
+				continue
+			}
+			if e.Phase == chromeComplete && e.Args["span_id"] == nil {
+				t.Errorf("tracks %d: got event %+v without a span_id arg", test.tracks, e)
+			}
+			e.Args = nil
+			got = append(got, e)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("tracks %d: got events\n%+v\nwant\n%+v", test.tracks, got, test.want)
+		}
+		if n := len(ct.TraceEvents) - len(got); n != test.meta {
+			t.Errorf("tracks %d: got %d metadata events, want %d", test.tracks, n, test.meta)
+		}
+	}
+
+	ct, err := ChromeTraceEvents(tr, ChromeTracksByService)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := ct.TraceEvents[0].Args["name"]; name != "frontend" {
+		t.Errorf("got first process name %q, want %q", name, "frontend")
+	}
+	for _, e := range ct.TraceEvents {
+		if e.Name == "GET /users" && (e.Args["user"] != "alice" || e.Args["http.status_code"] != "200") {
+			t.Errorf("got root span args %+v", e.Args)
+		}
+	}
+}
+
+func TestChromeTraceEvents_overlapping(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	at := func(msec int) time.Time { return testStart.Add(time.Duration(msec) * time.Millisecond) }
+	span := func(id appdash.SpanID, name string, start, end int) {
+		r := appdash.NewRecorder(id, ms)
+		r.Name(name)
+		r.Event(appdash.Timespan{S: at(start), E: at(end)})
+		r.Finish()
+	}
+	root := appdash.SpanID{Trace: 1, Span: 1}
+	span(root, "root", 0, 10)
+	span(appdash.SpanID{Trace: 1, Span: 2, Parent: 1}, "a", 1, 5)
+	span(appdash.SpanID{Trace: 1, Span: 3, Parent: 1}, "b", 3, 7)
+	span(appdash.SpanID{Trace: 1, Span: 4, Parent: 1}, "c", 5, 9)
+	span(appdash.SpanID{Trace: 1, Span: 5, Parent: 3}, "d", 4, 6)
+	tr, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ct, err := ChromeTraceEvents(tr, ChromeTracksByDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// b overlaps a, so it is in a second track for depth 1, and c starts
+	// when a ends, so it is in the first track again.
+	wantTIDs := map[string]int{"root": 0, "a": 1, "b": 4, "c": 1, "d": 2}
+	gotTIDs := map[string]int{}
+	threads := map[int]string{}
+	for _, e := range ct.TraceEvents {
+		switch {
+		case e.Phase == chromeComplete:
+			gotTIDs[e.Name] = e.TID
+		case e.Name == "thread_name":
+			threads[e.TID] = e.Args["name"].(string)
+		}
+	}
+	if !reflect.DeepEqual(gotTIDs, wantTIDs) {
+		t.Errorf("got tids %v, want %v", gotTIDs, wantTIDs)
+	}
+	wantThreads := map[int]string{0: "depth 0", 1: "depth 1", 2: "depth 2", 4: "depth 1 (2)"}
+	if !reflect.DeepEqual(threads, wantThreads) {
+		t.Errorf("got thread names %v, want %v", threads, wantThreads)
+	}
+}
@@ -0,0 +1,190 @@
+package export
+
+import (
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/otlp"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
+)
+
+// DefaultServiceName is the OTLP service name of spans that don't have a
+// "service.name" annotation, as used by the OpenTelemetry SDKs.
+const DefaultServiceName = "unknown_service"
+
+// scopeName is the name of the instrumentation scope of spans that don't have
+// an "otel.scope.name" annotation.
+const scopeName = "sourcegraph.com/sourcegraph/appdash"
+
+// otlpConsumedKeys are the annotation keys that are used for the resource,
+// scope and status of an OTLP span, and are not exported as attributes.
+var otlpConsumedKeys = map[string]bool{
+	otlp.ScopeNameKey:         true,
+	otlp.ScopeVersionKey:      true,
+	otlp.StatusCodeKey:        true,
+	otlp.StatusDescriptionKey: true,
+	otlp.ErrorKey:             true,
+}
+
+// OTLPRequest converts all spans in the traces into an OTLP trace export
+// request, which can be encoded in the OTLP protobuf encoding with its Marshal
+// method. Spans are grouped by their service and instrumentation scope. It
+// returns an error if the events of a span can't be unmarshaled.
+func OTLPRequest(traces ...*appdash.Trace) (*otlp.ExportTraceServiceRequest, error) {
+	var spans []*appdash.Span
+	for _, t := range traces {
+		for _, s := range t.Flatten() {
+			s := s.Span
+			spans = append(spans, &s)
+		}
+	}
+	return otlpRequest(spans)
+}
+
+func otlpRequest(spans []*appdash.Span) (*otlp.ExportTraceServiceRequest, error) {
+	type scopeKey struct{ service, name, version string }
+	var (
+		req       otlp.ExportTraceServiceRequest
+		resources = map[string]int{}   // service name -> index in req.ResourceSpans
+		scopes    = map[scopeKey]int{} // -> index in ResourceSpans.ScopeSpans
+	)
+	for _, s := range spans {
+		info, err := newSpanInfo(s)
+		if err != nil {
+			return nil, err
+		}
+		service := info.service
+		if service == "" {
+			service = DefaultServiceName
+		}
+		ri, ok := resources[service]
+		if !ok {
+			ri = len(req.ResourceSpans)
+			resources[service] = ri
+			req.ResourceSpans = append(req.ResourceSpans, otlp.ResourceSpans{
+				Resource: &otlp.Resource{Attributes: []otlp.KeyValue{stringAttr(zipkin.ServiceNameKey, service)}},
+			})
+		}
+		rs := &req.ResourceSpans[ri]
+
+		m := s.Annotations.StringMap()
+		sk := scopeKey{service, m[otlp.ScopeNameKey], m[otlp.ScopeVersionKey]}
+		if sk.name == "" {
+			sk.name = scopeName
+		}
+		si, ok := scopes[sk]
+		if !ok {
+			si = len(rs.ScopeSpans)
+			scopes[sk] = si
+			rs.ScopeSpans = append(rs.ScopeSpans, otlp.ScopeSpans{
+				Scope: &otlp.InstrumentationScope{Name: sk.name, Version: sk.version},
+			})
+		}
+		ss := &rs.ScopeSpans[si]
+		ss.Spans = append(ss.Spans, otlpSpan(info, m))
+	}
+	return &req, nil
+}
+
+// otlpKinds maps the values of the span.kind annotation to OTLP span kinds.
+var otlpKinds = map[string]otlp.SpanKind{
+	"internal": otlp.SpanKindInternal,
+	"server":   otlp.SpanKindServer,
+	"client":   otlp.SpanKindClient,
+	"producer": otlp.SpanKindProducer,
+	"consumer": otlp.SpanKindConsumer,
+}
+
+// otlpSpan converts a span into an OTLP span. The map m contains the span's
+// annotations.
+func otlpSpan(info *spanInfo, m map[string]string) otlp.Span {
+	s := otlp.Span{
+		TraceID: info.traceIDBytes(),
+		SpanID:  idBytes(info.id.Span),
+		Name:    info.name,
+		Kind:    otlpKinds[info.kind],
+	}
+	if info.id.Parent != 0 {
+		s.ParentSpanID = idBytes(info.id.Parent)
+	}
+	if !info.start.IsZero() {
+		s.StartTimeUnixNano = nanos(info.start)
+		s.EndTimeUnixNano = nanos(info.end)
+	}
+
+	attrs := map[string]bool{}
+	for _, a := range info.tags {
+		if otlpConsumedKeys[a.Key] || attrs[a.Key] {
+			continue
+		}
+		attrs[a.Key] = true
+		s.Attributes = append(s.Attributes, stringAttr(a.Key, string(a.Value)))
+	}
+	var statusCode int
+	switch {
+	case info.server != nil:
+		statusCode = info.server.Response.StatusCode
+		for k, v := range httpTags(info.server.Request, info.server.Response, info.server.Route) {
+			if !attrs[k] {
+				s.Attributes = append(s.Attributes, stringAttr(k, v))
+			}
+		}
+	case info.client != nil:
+		statusCode = info.client.Response.StatusCode
+		for k, v := range httpTags(info.client.Request, info.client.Response, "") {
+			if !attrs[k] {
+				s.Attributes = append(s.Attributes, stringAttr(k, v))
+			}
+		}
+	}
+	sort.Stable(attributesByKey(s.Attributes))
+
+	for _, l := range info.logs {
+		s.Events = append(s.Events, otlp.Event{TimeUnixNano: nanos(l.time), Name: l.msg})
+	}
+
+	// The status is kept from the span's OTLP status, or otherwise derived
+	// from an error annotation or the HTTP status code (5xx for servers and
+	// 4xx or 5xx for clients are errors, as in the OpenTelemetry semantic
+	// conventions).
+	var status otlp.Status
+	errValue, isErr := m[otlp.ErrorKey]
+	switch {
+	case m[otlp.StatusCodeKey] == otlp.StatusCodeOK.String():
+		status.Code = otlp.StatusCodeOK
+	case m[otlp.StatusCodeKey] == otlp.StatusCodeError.String(), isErr && errValue != "false":
+		status.Code = otlp.StatusCodeError
+		status.Message = m[otlp.StatusDescriptionKey]
+		if status.Message == "" && errValue != "true" {
+			status.Message = errValue
+		}
+	case statusCode >= 500, info.kind == "client" && statusCode >= 400:
+		status.Code = otlp.StatusCodeError
+	}
+	if status.Code != otlp.StatusCodeUnset {
+		s.Status = &status
+	}
+	return s
+}
+
+func stringAttr(key, value string) otlp.KeyValue {
+	return otlp.KeyValue{Key: key, Value: otlp.AnyValue{StringValue: &value}}
+}
+
+type attributesByKey []otlp.KeyValue
+
+func (a attributesByKey) Len() int           { return len(a) }
+func (a attributesByKey) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a attributesByKey) Less(i, j int) bool { return a[i].Key < a[j].Key }
+
+func idBytes(id appdash.ID) otlp.ID {
+	b := make(otlp.ID, 8)
+	binary.BigEndian.PutUint64(b, uint64(id))
+	return b
+}
+
+func nanos(t time.Time) otlp.Uint64 {
+	return otlp.Uint64(t.UnixNano())
+}
@@ -0,0 +1,113 @@
+package export
+
+import (
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
+)
+
+// ZipkinSpans converts all spans in the trace into Zipkin v2 spans, in
+// pre-order. It returns an error if the events of a span can't be
+// unmarshaled.
+func ZipkinSpans(t *appdash.Trace) ([]zipkin.Span, error) {
+	var spans []zipkin.Span
+	for _, s := range t.Flatten() {
+		zs, err := zipkinSpans(&s.Span)
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, zs...)
+	}
+	return spans, nil
+}
+
+// zipkinSpans converts the span into Zipkin spans. Usually there is one span,
+// but an HTTP request that was traced by both the client and the server is
+// converted into a client span and a shared server span.
+func zipkinSpans(s *appdash.Span) ([]zipkin.Span, error) {
+	info, err := newSpanInfo(s)
+	if err != nil {
+		return nil, err
+	}
+
+	zs := zipkin.Span{
+		TraceID: info.traceIDHex(),
+		ID:      info.id.Span.String(),
+		Name:    info.name,
+		Tags:    map[string]string{},
+	}
+	if info.id.Parent != 0 {
+		zs.ParentID = info.id.Parent.String()
+	}
+	if info.service != "" {
+		zs.LocalEndpoint = &zipkin.Endpoint{ServiceName: info.service}
+	}
+	if info.peerService != "" {
+		zs.RemoteEndpoint = &zipkin.Endpoint{ServiceName: info.peerService}
+	}
+	for _, l := range info.logs {
+		zs.Annotations = append(zs.Annotations, zipkin.Annotation{Timestamp: micros(l.time), Value: l.msg})
+	}
+	for _, a := range info.tags {
+		zs.Tags[a.Key] = string(a.Value)
+	}
+
+	switch {
+	case info.client != nil && info.server != nil:
+		client := zs
+		client.Kind = zipkin.Client
+		client.Annotations = nil
+		client.Tags = httpTags(info.client.Request, info.client.Response, "")
+		setZipkinTimes(&client, info.client.ClientSend, info.client.ClientRecv)
+
+		server := zs
+		server.Kind = zipkin.Server
+		server.Shared = true
+		addTags(server.Tags, httpTags(info.server.Request, info.server.Response, info.server.Route))
+		setZipkinTimes(&server, info.server.ServerRecv, info.server.ServerSend)
+		return []zipkin.Span{client, server}, nil
+	case info.server != nil:
+		zs.Kind = zipkin.Server
+		addTags(zs.Tags, httpTags(info.server.Request, info.server.Response, info.server.Route))
+	case info.client != nil:
+		zs.Kind = zipkin.Client
+		addTags(zs.Tags, httpTags(info.client.Request, info.client.Response, ""))
+	default:
+		zs.Kind = zipkinKinds[info.kind]
+	}
+	setZipkinTimes(&zs, info.start, info.end)
+	return []zipkin.Span{zs}, nil
+}
+
+// zipkinKinds maps the values of the span.kind annotation to Zipkin span
+// kinds.
+var zipkinKinds = map[string]string{
+	"client":   zipkin.Client,
+	"server":   zipkin.Server,
+	"producer": zipkin.Producer,
+	"consumer": zipkin.Consumer,
+}
+
+func setZipkinTimes(s *zipkin.Span, start, end time.Time) {
+	if start.IsZero() {
+		return
+	}
+	s.Timestamp = micros(start)
+	if end.After(start) {
+		s.Duration = int64(end.Sub(start) / time.Microsecond)
+	}
+}
+
+// addTags adds the tags in src that are not already in dst to dst.
+func addTags(dst, src map[string]string) {
+	for k, v := range src {
+		if _, ok := dst[k]; !ok {
+			dst[k] = v
+		}
+	}
+}
+
+func micros(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Microsecond)
+}
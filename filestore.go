@@ -0,0 +1,752 @@
+package appdash
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+// defaultSegmentSize is the default FileStore.SegmentSize (64 MB).
+const defaultSegmentSize = 64 * 1024 * 1024
+
+// segmentExt is the file extension of FileStore segment files.
+const segmentExt = ".seg"
+
+// Kinds of FileStore records.
+const (
+	recordCollect    byte = iota + 1 // payload is a wire.CollectPacket
+	recordDelete                     // payload is a big-endian uint64 trace ID
+	recordDeleteSpan                 // payload is a big-endian uint64 trace ID and span ID (WALStore only)
+)
+
+// A FileStore is a Store that persists spans in append-only segment files in
+// a directory, so that it can hold many more traces than fit in memory.
+//
+// Only an index of where each trace's spans are stored (and a small summary
+// of each trace used for querying) is kept in memory; traces are read back
+// from the segment files when they are requested. Deleted traces are recorded
+// in the log and their space is reclaimed once every trace in a segment file
+// (and in the segment files before it) has been deleted. A FileStore doesn't
+// delete traces by itself, so it should be wrapped in a RecentStore,
+// LimitStore or ByteLimitStore to bound its size on disk. As a FileStore is a
+// TraceLister, these also delete the traces that were read back from the
+// segment files when it was opened.
+//
+// Each record in a segment file is written as:
+//
+//	uvarint  length of kind+payload
+//	uint32   CRC-32 (IEEE) of kind+payload, big-endian
+//	byte     kind
+//	[]byte   payload
+//
+// When a FileStore is opened, the index is rebuilt by replaying the segment
+// files in order. A torn or corrupt record (e.g., after a crash) ends the
+// segment: the file is truncated at that point and replay continues with the
+// next segment.
+//
+// By default, records are handed to the operating system as they are
+// appended, and a segment file is synced to disk when it is full. Collected
+// spans therefore survive a crash of the process, but the spans written to
+// the active segment since it was started may be lost if the machine loses
+// power. Set SyncWrites to sync every record instead.
+//
+// Traces are read from the segment files without blocking Collect and
+// Delete, so queries that read many traces don't stop ingestion.
+type FileStore struct {
+	// SegmentSize is the size in bytes after which the active segment file
+	// is closed and a new one is started. NewFileStore sets it to 64 MB.
+	SegmentSize int64
+
+	// SyncWrites is whether each record is synced to disk (with fsync) before
+	// Collect or Delete returns, so that it survives a power loss.
+	SyncWrites bool
+
+	dir      string
+	readOnly bool // opened with OpenFileStoreReadOnly
+
+	mu          sync.Mutex
+	index       map[ID]*fileTrace // trace ID -> location and summary of trace
+	segments    []*fileSegment    // segments ordered by sequence number; the last one is active
+	collections rateCounter       // Collect calls
+
+	watchers traceWatchers
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	DeleteStore
+	Queryer
+	Aggregator
+	Watcher
+	StatsStore
+	TraceLister
+} = (*FileStore)(nil)
+
+// fileSegment is a single segment file of a FileStore.
+type fileSegment struct {
+	seq  uint64
+	f    *os.File
+	size int64 // size of the file, i.e. the offset of the next record
+	live int   // number of collect records belonging to undeleted traces
+
+	// closeMu is held for reading while records are read from f without
+	// holding FileStore.mu, and for writing while f is closed.
+	closeMu sync.RWMutex
+	closed  bool
+}
+
+// errReadOnly is returned when a store that was opened read-only (with
+// OpenFileStoreReadOnly or OpenWALStoreReadOnly) would be modified.
+var errReadOnly = errors.New("store is opened read-only")
+
+// errSegmentClosed is returned by fileSegment.readAt if the segment file has
+// been closed, i.e. all of its traces have been deleted or the FileStore has
+// been closed.
+var errSegmentClosed = errors.New("segment file closed")
+
+// readAt reads len(p) bytes from the segment file at offset off.
+func (seg *fileSegment) readAt(p []byte, off int64) error {
+	seg.closeMu.RLock()
+	defer seg.closeMu.RUnlock()
+	if seg.closed {
+		return errSegmentClosed
+	}
+	_, err := seg.f.ReadAt(p, off)
+	return err
+}
+
+// close closes the segment file, waiting for any reads to finish.
+func (seg *fileSegment) close() error {
+	seg.closeMu.Lock()
+	defer seg.closeMu.Unlock()
+	if seg.closed {
+		return nil
+	}
+	seg.closed = true
+	return seg.f.Close()
+}
+
+// fileRecordRef is the location of a record's payload in a segment file.
+type fileRecordRef struct {
+	seg *fileSegment
+	off int64
+	n   int
+}
+
+// fileTrace is the in-memory index entry of a trace in a FileStore.
+type fileTrace struct {
+	recs []fileRecordRef // collect records, in the order they were written
+
+	// root is the ID of the trace's root span, or of its temporary root if
+	// the real root has not been collected yet (see MemoryStore.Collect).
+	root SpanID
+
+	// name, start and end summarize the root span (as used by TracesOpts).
+	name               string
+	rootStart, rootEnd time.Time
+	rootHasTime        bool
+
+	// start and end span all of the trace's timespan events (as used by
+	// Aggregate).
+	start, end time.Time
+	hasTime    bool
+
+	// spans, bytes and collected are used for the store's statistics.
+	spans     map[ID]struct{} // IDs of the trace's spans
+	bytes     int64           // approximate size of the spans (see collectionSize)
+	collected time.Time       // when the trace was first collected, if known
+}
+
+// NewFileStore opens the FileStore in the given directory, creating the
+// directory if it does not exist. The index is rebuilt from any existing
+// segment files.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return openFileStore(dir, false)
+}
+
+// OpenFileStoreReadOnly opens the FileStore in the given directory for
+// reading only, e.g. to export its traces while a server is using it. The
+// index is built from the segment files as they are when it is opened. Unlike
+// NewFileStore, it never modifies the directory: a torn or corrupt record
+// (such as one that the server is still writing) ends its segment without
+// truncating it, and no segment files are created or removed. Collect and
+// Delete return an error.
+func OpenFileStoreReadOnly(dir string) (*FileStore, error) {
+	return openFileStore(dir, true)
+}
+
+// openFileStore opens the FileStore in the given directory, which must exist.
+func openFileStore(dir string, readOnly bool) (*FileStore, error) {
+	fs := &FileStore{
+		SegmentSize: defaultSegmentSize,
+		dir:         dir,
+		readOnly:    readOnly,
+		index:       map[ID]*fileTrace{},
+	}
+
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var seqs []uint64
+	for _, fi := range infos {
+		name := fi.Name()
+		if fi.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 16, 64)
+		if err != nil {
+			continue // not a segment file
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Sort(uint64s(seqs))
+
+	flag := os.O_RDWR
+	if readOnly {
+		flag = os.O_RDONLY
+	}
+	for _, seq := range seqs {
+		f, err := os.OpenFile(fs.segmentPath(seq), flag, 0600)
+		if err != nil {
+			fs.Close()
+			return nil, err
+		}
+		seg := &fileSegment{seq: seq, f: f}
+		fs.segments = append(fs.segments, seg)
+		if err := fs.replay(seg); err != nil {
+			fs.Close()
+			return nil, err
+		}
+	}
+	if readOnly {
+		return fs, nil
+	}
+	if err := fs.removeDeadSegmentsNoLock(); err != nil {
+		fs.Close()
+		return nil, err
+	}
+	return fs, nil
+}
+
+// segmentPath returns the path of the segment file with the given sequence
+// number.
+func (fs *FileStore) segmentPath(seq uint64) string {
+	return filepath.Join(fs.dir, fmt.Sprintf("%016x%s", seq, segmentExt))
+}
+
+// errCorruptRecord is returned by readRecord when a record's checksum does not
+// match or its length is invalid.
+var errCorruptRecord = errors.New("corrupt record")
+
+// replay reads all of the records in seg and applies them to the index. If a
+// torn or corrupt record is found, seg is truncated to the last good record
+// (unless the store is read-only, in which case the rest of seg is ignored).
+func (fs *FileStore) replay(seg *fileSegment) error {
+	fi, err := seg.f.Stat()
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(io.NewSectionReader(seg.f, 0, fi.Size()))
+	for {
+		kind, payload, hdr, err := readRecord(r, fi.Size()-seg.size)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			if fs.readOnly {
+				return nil
+			}
+			log.Printf("FileStore: truncating %s at offset %d: %s", seg.f.Name(), seg.size, err)
+			return seg.f.Truncate(seg.size)
+		}
+		ref := fileRecordRef{seg: seg, off: seg.size + int64(hdr), n: len(payload)}
+		seg.size += int64(hdr + len(payload))
+		if err := fs.apply(kind, payload, ref); err != nil {
+			return fmt.Errorf("FileStore: %s at offset %d: %s", seg.f.Name(), ref.off, err)
+		}
+	}
+}
+
+// readRecord reads the next record from r, where remaining is the number of
+// bytes left in the file. It returns the record's kind and payload, along with
+// the size of the record header (including the kind byte). If r is at the end
+// of the file, io.EOF is returned.
+func readRecord(r *bufio.Reader, remaining int64) (kind byte, payload []byte, hdr int, err error) {
+	if remaining == 0 {
+		return 0, nil, 0, io.EOF
+	}
+	n, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return 0, nil, 0, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return 0, nil, 0, err
+	}
+	hdr = uvarintLen(n) + 4
+	if n == 0 || int64(n) > remaining-int64(hdr) {
+		return 0, nil, 0, errCorruptRecord
+	}
+	var sum [4]byte
+	if _, err := io.ReadFull(r, sum[:]); err != nil {
+		return 0, nil, 0, err
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, nil, 0, err
+	}
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(sum[:]) {
+		return 0, nil, 0, errCorruptRecord
+	}
+	return data[0], data[1:], hdr + 1, nil
+}
+
+// encodeRecord returns the encoded record with the given kind and payload (see
+// FileStore for the format), along with the size of the record header
+// (including the kind byte).
+func encodeRecord(kind byte, payload []byte) (buf []byte, hdr int) {
+	n := uint64(len(payload) + 1)
+	buf = make([]byte, binary.MaxVarintLen64+4, binary.MaxVarintLen64+4+int(n))
+	hdr = binary.PutUvarint(buf, n)
+	buf = buf[:hdr+4]
+	buf = append(buf, kind)
+	buf = append(buf, payload...)
+	binary.BigEndian.PutUint32(buf[hdr:], crc32.ChecksumIEEE(buf[hdr+4:]))
+	return buf, hdr + 5
+}
+
+// uvarintLen returns the number of bytes needed to encode x as a uvarint.
+func uvarintLen(x uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], x)
+}
+
+// apply applies a record (whose payload is stored at ref) to the index.
+func (fs *FileStore) apply(kind byte, payload []byte, ref fileRecordRef) error {
+	switch kind {
+	case recordCollect:
+		p := &wire.CollectPacket{}
+		if err := proto.Unmarshal(payload, p); err != nil {
+			return err
+		}
+		if p.Spanid == nil {
+			return errors.New("collect record has no span ID")
+		}
+		return fs.indexCollect(spanIDFromWire(p.Spanid), annotationsFromWire(p.Annotation), ref, time.Time{})
+	case recordDelete:
+		if len(payload) != 8 {
+			return errors.New("invalid delete record")
+		}
+		fs.indexDelete(ID(binary.BigEndian.Uint64(payload)))
+		return nil
+	default:
+		return fmt.Errorf("unknown record kind %d", kind)
+	}
+}
+
+// indexCollect adds a collect record for the span id (with the annotations
+// as) to the index. The time at which the record was collected is only known
+// (non-zero) when it is collected, not when it is replayed.
+func (fs *FileStore) indexCollect(id SpanID, as Annotations, ref fileRecordRef, collected time.Time) error {
+	var events []Event
+	if err := UnmarshalEvents(as, &events); err != nil {
+		return err
+	}
+	start, end, hasTime := findTraceTimes(events)
+
+	ft, present := fs.index[id.Trace]
+	if !present {
+		ft = &fileTrace{root: id, spans: map[ID]struct{}{}, collected: collected}
+		fs.index[id.Trace] = ft
+	} else if id.Span != ft.root.Span && (id.IsRoot() || ft.root.Parent == id.Span) {
+		// Same as MemoryStore: the real root (or the temporary root's
+		// parent) replaces the temporary root.
+		ft.root = id
+		ft.name = ""
+		ft.rootStart, ft.rootEnd, ft.rootHasTime = time.Time{}, time.Time{}, false
+	}
+	ft.recs = append(ft.recs, ref)
+	ref.seg.live++
+	ft.spans[id.Span] = struct{}{}
+	ft.bytes += collectionSize(as)
+
+	if id.Span == ft.root.Span {
+		if ft.name == "" {
+			for _, a := range as {
+				if a.Key == "Name" {
+					ft.name = string(a.Value)
+					break
+				}
+			}
+		}
+		ft.rootStart, ft.rootEnd, ft.rootHasTime = mergeTimes(ft.rootStart, ft.rootEnd, ft.rootHasTime, start, end, hasTime)
+	}
+	ft.start, ft.end, ft.hasTime = mergeTimes(ft.start, ft.end, ft.hasTime, start, end, hasTime)
+	return nil
+}
+
+// mergeTimes returns the time range covering both [s1, e1] (if ok1) and
+// [s2, e2] (if ok2).
+func mergeTimes(s1, e1 time.Time, ok1 bool, s2, e2 time.Time, ok2 bool) (time.Time, time.Time, bool) {
+	if !ok2 {
+		return s1, e1, ok1
+	}
+	if !ok1 {
+		return s2, e2, true
+	}
+	if s2.Before(s1) {
+		s1 = s2
+	}
+	if e2.After(e1) {
+		e1 = e2
+	}
+	return s1, e1, true
+}
+
+// indexDelete removes the trace from the index.
+func (fs *FileStore) indexDelete(id ID) {
+	ft, present := fs.index[id]
+	if !present {
+		return
+	}
+	for _, ref := range ft.recs {
+		ref.seg.live--
+	}
+	delete(fs.index, id)
+}
+
+// Collect implements the Collector interface by appending the span's
+// annotations to the active segment file.
+func (fs *FileStore) Collect(id SpanID, as ...Annotation) error {
+	payload, err := proto.Marshal(newCollectPacket(id, as))
+	if err != nil {
+		return err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	ref, err := fs.appendNoLock(recordCollect, payload)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if err := fs.indexCollect(id, as, ref, now); err != nil {
+		return err
+	}
+	fs.collections.add(now)
+	fs.watchers.notify(id.Trace)
+	return nil
+}
+
+// Stats implements the StatsStore interface. The statistics are computed from
+// the index, so the segment files are not read. As the time at which a trace
+// was first collected is not stored in the segment files, the start time of
+// the traces that were collected before the store was opened is used instead.
+//
+// DiskBytes is the total size of the segment files, which includes the
+// records of deleted traces until their segment files are removed.
+func (fs *FileStore) Stats() (*StoreStats, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	var st StoreStats
+	for id, ft := range fs.index {
+		st.Traces++
+		st.Spans += len(ft.spans)
+		st.AnnotationBytes += ft.bytes
+		collected := ft.collected
+		if collected.IsZero() {
+			collected = ft.start
+		}
+		if collected.IsZero() {
+			continue
+		}
+		if st.OldestTime.IsZero() || collected.Before(st.OldestTime) {
+			st.Oldest, st.OldestTime = id, collected
+		}
+		if collected.After(st.NewestTime) {
+			st.Newest, st.NewestTime = id, collected
+		}
+	}
+	for _, seg := range fs.segments {
+		st.DiskBytes += seg.size
+	}
+	st.Collections = fs.collections.total
+	st.CollectionRate = float64(fs.collections.recent(time.Now())) / rateWindow
+	return &st, nil
+}
+
+// ListTraces implements the TraceLister interface using the index. As with
+// Stats, the start time of the traces that were collected before the store
+// was opened is used as the time they were collected.
+func (fs *FileStore) ListTraces() ([]TraceSummary, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	traces := make([]TraceSummary, 0, len(fs.index))
+	for id, ft := range fs.index {
+		collected := ft.collected
+		if collected.IsZero() {
+			collected = ft.start
+		}
+		traces = append(traces, TraceSummary{ID: id, Collected: collected, Size: ft.bytes})
+	}
+	return traces, nil
+}
+
+// Watch implements the Watcher interface.
+func (fs *FileStore) Watch(ctx context.Context, opts TracesOpts) <-chan *Trace {
+	return fs.watchers.watch(ctx, opts, fs.Trace)
+}
+
+// Delete implements the DeleteStore interface by recording the deletion of
+// the given traces. Segment files are removed once all of the traces in them
+// have been deleted.
+func (fs *FileStore) Delete(traces ...ID) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	for _, id := range traces {
+		if _, present := fs.index[id]; !present {
+			continue
+		}
+		var payload [8]byte
+		binary.BigEndian.PutUint64(payload[:], uint64(id))
+		if _, err := fs.appendNoLock(recordDelete, payload[:]); err != nil {
+			return err
+		}
+		fs.indexDelete(id)
+	}
+	return fs.removeDeadSegmentsNoLock()
+}
+
+// appendNoLock appends a record to the active segment file, starting a new
+// segment if needed, and returns the location of its payload.
+func (fs *FileStore) appendNoLock(kind byte, payload []byte) (fileRecordRef, error) {
+	if fs.readOnly {
+		return fileRecordRef{}, errReadOnly
+	}
+	seg, err := fs.activeSegmentNoLock()
+	if err != nil {
+		return fileRecordRef{}, err
+	}
+
+	buf, hdr := encodeRecord(kind, payload)
+	if _, err := seg.f.WriteAt(buf, seg.size); err != nil {
+		// Don't leave a partial record behind, as it would hide any records
+		// appended after it when the segment is replayed.
+		seg.f.Truncate(seg.size)
+		return fileRecordRef{}, err
+	}
+	if fs.SyncWrites {
+		if err := seg.f.Sync(); err != nil {
+			seg.f.Truncate(seg.size)
+			return fileRecordRef{}, err
+		}
+	}
+	ref := fileRecordRef{seg: seg, off: seg.size + int64(hdr), n: len(payload)}
+	seg.size += int64(len(buf))
+	return ref, nil
+}
+
+// activeSegmentNoLock returns the segment that records should be appended to,
+// creating a new one if there is none or if the active one is full. A full
+// segment is synced to disk before the new one is started.
+func (fs *FileStore) activeSegmentNoLock() (*fileSegment, error) {
+	var seq uint64
+	if len(fs.segments) > 0 {
+		seg := fs.segments[len(fs.segments)-1]
+		if seg.size < fs.SegmentSize {
+			return seg, nil
+		}
+		if err := seg.f.Sync(); err != nil {
+			return nil, err
+		}
+		seq = seg.seq + 1
+	}
+	f, err := os.OpenFile(fs.segmentPath(seq), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if fs.SyncWrites {
+		// Make sure that the new file's directory entry is on disk, too.
+		if err := syncDir(fs.dir); err != nil {
+			f.Close()
+			os.Remove(f.Name())
+			return nil, err
+		}
+	}
+	seg := &fileSegment{seq: seq, f: f}
+	fs.segments = append(fs.segments, seg)
+	return seg, nil
+}
+
+// removeDeadSegmentsNoLock removes the oldest segment files for as long as
+// they contain no live traces. Segments are only removed oldest-first, so
+// that a delete record is never lost while the trace it deletes is still
+// stored in an older segment. The active segment is never removed.
+func (fs *FileStore) removeDeadSegmentsNoLock() error {
+	if fs.readOnly {
+		return nil
+	}
+	for len(fs.segments) > 1 && fs.segments[0].live == 0 {
+		seg := fs.segments[0]
+		if err := seg.close(); err != nil {
+			return err
+		}
+		if err := os.Remove(seg.f.Name()); err != nil {
+			return err
+		}
+		fs.segments = fs.segments[1:]
+	}
+	return nil
+}
+
+// Trace implements the Store interface by reading the trace's spans from the
+// segment files.
+func (fs *FileStore) Trace(id ID) (*Trace, error) {
+	fs.mu.Lock()
+	ft, present := fs.index[id]
+	var recs []fileRecordRef
+	if present {
+		recs = ft.recs[:len(ft.recs):len(ft.recs)]
+	}
+	fs.mu.Unlock()
+
+	if !present {
+		return nil, ErrTraceNotFound
+	}
+	return readFileTrace(id, recs)
+}
+
+// readFileTrace reads the trace with the collect records recs from the
+// segment files. It does not need fs.mu to be held, as records are never
+// changed once they are written. If the trace is deleted (and its segment
+// files removed) while it is read, ErrTraceNotFound is returned.
+func readFileTrace(id ID, recs []fileRecordRef) (*Trace, error) {
+	// Build the trace tree the same way that MemoryStore does.
+	ms := NewMemoryStore()
+	for _, ref := range recs {
+		payload := make([]byte, ref.n)
+		if err := ref.seg.readAt(payload, ref.off); err == errSegmentClosed {
+			return nil, ErrTraceNotFound
+		} else if err != nil {
+			return nil, err
+		}
+		p := &wire.CollectPacket{}
+		if err := proto.Unmarshal(payload, p); err != nil {
+			return nil, err
+		}
+		if err := ms.Collect(spanIDFromWire(p.Spanid), annotationsFromWire(p.Annotation)...); err != nil {
+			return nil, err
+		}
+	}
+	return ms.Trace(id)
+}
+
+// Traces implements the Queryer interface. Traces are filtered and sorted
+// using the in-memory index, so only the returned traces (or, if opts.Query
+// is set, the traces that the query is evaluated against) are read from disk.
+// The index is only locked while the traces are selected, not while they are
+// read.
+func (fs *FileStore) Traces(opts TracesOpts) ([]*Trace, error) {
+	q, err := newTracesQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	var (
+		qts  []*queriedTrace
+		recs = map[ID][]fileRecordRef{}
+	)
+	for id, ft := range fs.index {
+		qt := &queriedTrace{
+			id:      id,
+			name:    ft.name,
+			start:   ft.rootStart,
+			end:     ft.rootEnd,
+			hasTime: ft.rootHasTime,
+		}
+		if q.include(qt) {
+			qts = append(qts, qt)
+			recs[id] = ft.recs[:len(ft.recs):len(ft.recs)]
+		}
+	}
+	fs.mu.Unlock()
+
+	return q.run(qts, func(id ID) (*Trace, error) {
+		return readFileTrace(id, recs[id])
+	})
+}
+
+// Aggregate implements the Aggregator interface using the in-memory index,
+// without reading any traces from disk.
+func (fs *FileStore) Aggregate(start, end time.Duration) ([]*AggregatedResult, error) {
+	startTime, endTime := aggregateWindow(start, end)
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	groups := make(map[string][]traceSample)
+	for id, ft := range fs.index {
+		if !ft.hasTime || ft.start.Before(startTime) || ft.start.After(endTime) {
+			continue
+		}
+		groups[ft.name] = append(groups[ft.name], traceSample{id: id, duration: ft.end.Sub(ft.start)})
+	}
+	return aggregateGroups(groups), nil
+}
+
+// Close closes the segment files. The FileStore must not be used after it is
+// closed.
+func (fs *FileStore) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	var firstErr error
+	for _, seg := range fs.segments {
+		if err := seg.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	fs.segments = nil
+	return firstErr
+}
+
+// syncDir syncs the directory dir to disk, so that the files that were
+// created, renamed or removed in it are, too.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+type uint64s []uint64
+
+func (u uint64s) Len() int           { return len(u) }
+func (u uint64s) Less(i, j int) bool { return u[i] < u[j] }
+func (u uint64s) Swap(i, j int)      { u[i], u[j] = u[j], u[i] }
@@ -0,0 +1,407 @@
+package appdash
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := storeT{t, fs}
+	s.MustCollect(SpanID{1, 2, 1}, Annotation{Key: "k1"}) // child before root
+	s.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "Name", Value: []byte("root")})
+	s.MustCollect(SpanID{1, 2, 1}, Annotation{Key: "k2"})
+	s.MustCollect(SpanID{2, 3, 0})
+
+	want1 := &Trace{
+		Span: Span{ID: SpanID{1, 1, 0}, Annotations: Annotations{{Key: "Name", Value: []byte("root")}}},
+		Sub: []*Trace{
+			{Span: Span{ID: SpanID{1, 2, 1}, Annotations: Annotations{{Key: "k1"}, {Key: "k2"}}}},
+		},
+	}
+	if x := s.MustTrace(1); !reflect.DeepEqual(x, want1) {
+		t.Errorf("Trace(1): got trace %+v, want %+v", x, want1)
+	}
+	if err := fs.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fs.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2) after Delete: got err %v, want ErrTraceNotFound", err)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the store and check that the index is rebuilt.
+	fs, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	s = storeT{t, fs}
+	if x := s.MustTrace(1); !reflect.DeepEqual(x, want1) {
+		t.Errorf("Trace(1) after reopen: got trace %+v, want %+v", x, want1)
+	}
+	if _, err := fs.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2) after reopen: got err %v, want ErrTraceNotFound", err)
+	}
+	traces, err := fs.Traces(TracesOpts{Query: "name:root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traces) != 1 || !reflect.DeepEqual(traces[0], want1) {
+		t.Errorf("Traces: got %v, want just trace 1", traces)
+	}
+}
+
+func TestFileStore_Traces_opts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, d := range []time.Duration{3, 1, 2} {
+		id := ID(i + 1)
+		rec := NewRecorder(SpanID{Trace: id, Span: id}, fs)
+		rec.Name("trace " + id.String())
+		rec.Event(Timespan{S: base.Add(time.Duration(i) * time.Minute), E: base.Add(time.Duration(i)*time.Minute + d*time.Second)})
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+
+	tests := []struct {
+		opts TracesOpts
+		want []ID
+	}{
+		{opts: TracesOpts{}, want: []ID{1, 2, 3}},
+		{opts: TracesOpts{Sort: SortByDuration, Desc: true}, want: []ID{1, 3, 2}},
+		{opts: TracesOpts{Offset: 1, Limit: 1}, want: []ID{2}},
+		{opts: TracesOpts{Timespan: Timespan{S: base.Add(time.Minute)}}, want: []ID{2, 3}},
+		{opts: TracesOpts{TraceIDs: []ID{3, 1}}, want: []ID{1, 3}},
+	}
+	for _, test := range tests {
+		traces, err := fs.Traces(test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []ID{}
+		for _, tr := range traces {
+			got = append(got, tr.Span.ID.Trace)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Traces(%+v): got traces %v, want %v", test.opts, got, test.want)
+		}
+	}
+}
+
+func TestFileStore_segments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	fs.SegmentSize = 1 // one record per segment
+
+	s := storeT{t, fs}
+	for i := ID(1); i <= 3; i++ {
+		s.MustCollect(SpanID{i, i, 0})
+	}
+	segments := func() int {
+		m, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(m)
+	}
+	if n := segments(); n != 3 {
+		t.Fatalf("got %d segments, want 3", n)
+	}
+
+	// Deleting trace 2 must not remove its segment while trace 1 (in an
+	// older segment) is still live.
+	if err := fs.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+	if n := segments(); n != 4 {
+		t.Errorf("got %d segments after deleting trace 2, want 4", n)
+	}
+	if err := fs.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if n := segments(); n != 3 {
+		t.Errorf("got %d segments after deleting trace 1, want 3", n)
+	}
+	if x := s.MustTrace(3); !reflect.DeepEqual(x, &Trace{Span: Span{ID: SpanID{3, 3, 0}}}) {
+		t.Errorf("Trace(3): got %+v", x)
+	}
+}
+
+func TestFileStore_reopenRecentStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.SegmentSize = 1 // one record per segment
+	old := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts, err := MarshalEvent(Timespan{S: old, E: old.Add(time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := storeT{t, fs}
+	for i := ID(1); i <= 3; i++ {
+		s.MustCollect(SpanID{i, i, 0}, ts...)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The traces that were read back from disk are deleted by a RecentStore,
+	// and so are their segment files.
+	fs, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	fs.SegmentSize = 1
+	storeT{t, &RecentStore{DeleteStore: fs, MinEvictAge: time.Hour}}.MustCollect(SpanID{4, 4, 0})
+	for i := 0; ; i++ {
+		traces, err := fs.ListTraces()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(traces) == 1 && traces[0].ID == 4 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("got traces %+v, want only trace 4", traces)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	fs.mu.Lock()
+	first := fs.segments[0].seq
+	fs.mu.Unlock()
+	if first != 3 {
+		t.Errorf("got first segment %d, want 3 (trace 4's segment)", first)
+	}
+}
+
+func TestFileStore_tornWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeT{t, fs}.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "k", Value: []byte("v")})
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash in the middle of appending a record.
+	f, err := os.OpenFile(filepath.Join(dir, "0000000000000000"+segmentExt), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte{50, 1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := storeT{t, fs}
+	s.MustCollect(SpanID{2, 2, 0})
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	fs, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	s = storeT{t, fs}
+	want1 := &Trace{Span: Span{ID: SpanID{1, 1, 0}, Annotations: Annotations{{Key: "k", Value: []byte("v")}}}}
+	if x := s.MustTrace(1); !reflect.DeepEqual(x, want1) {
+		t.Errorf("Trace(1): got %+v, want %+v", x, want1)
+	}
+	if x := s.MustTrace(2); !reflect.DeepEqual(x, &Trace{Span: Span{ID: SpanID{2, 2, 0}}}) {
+		t.Errorf("Trace(2): got %+v", x)
+	}
+}
+
+func TestOpenFileStoreReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	s := storeT{t, fs}
+	s.MustCollect(SpanID{1, 1, 0})
+	s.MustCollect(SpanID{2, 2, 0})
+	if err := fs.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a record that is still being written by the store.
+	name := filepath.Join(dir, "0000000000000000"+segmentExt)
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte{50, 1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ro, err := OpenFileStoreReadOnly(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ro.Close()
+	if x := (storeT{t, ro}).MustTrace(1); !reflect.DeepEqual(x, &Trace{Span: Span{ID: SpanID{1, 1, 0}}}) {
+		t.Errorf("Trace(1): got %+v", x)
+	}
+	if _, err := ro.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2): got err %v, want ErrTraceNotFound", err)
+	}
+	if err := ro.Collect(SpanID{3, 3, 0}); err != errReadOnly {
+		t.Errorf("Collect: got err %v, want errReadOnly", err)
+	}
+	if err := ro.Delete(1); err != errReadOnly {
+		t.Errorf("Delete: got err %v, want errReadOnly", err)
+	}
+	after, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Size() != before.Size() {
+		t.Errorf("segment file size changed from %d to %d", before.Size(), after.Size())
+	}
+}
+
+func TestFileStore_readDeleted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	fs.SegmentSize = 1 // one record per segment
+
+	s := storeT{t, fs}
+	s.MustCollect(SpanID{1, 1, 0})
+	s.MustCollect(SpanID{2, 2, 0})
+
+	// A trace that is deleted (and its segment removed) after its records
+	// were looked up in the index is not found, rather than failing to read.
+	recs := fs.index[1].recs
+	if err := fs.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readFileTrace(1, recs); err != ErrTraceNotFound {
+		t.Errorf("got err %v, want ErrTraceNotFound", err)
+	}
+}
+
+func TestFileStore_concurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	fs.SegmentSize = 512
+	fs.SyncWrites = true
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := ID(1); i <= 100; i++ {
+			if err := fs.Collect(SpanID{i, i, 0}, Annotation{Key: "Name", Value: []byte("t")}); err != nil {
+				t.Error(err)
+				return
+			}
+			if i > 10 {
+				if err := fs.Delete(i - 10); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			traces, err := fs.Traces(TracesOpts{Query: "name:t"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(traces) != 10 {
+				t.Errorf("got %d traces, want 10", len(traces))
+			}
+			return
+		default:
+		}
+		if _, err := fs.Traces(TracesOpts{Query: "name:t"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package appdash
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// A FlameGraph is a tree of span name paths (stacks), aggregated over many
+// traces. Each node is the set of spans reached from a root span by the same
+// path of span names, e.g. "GET /users" -> "db" -> "query", and holds the
+// self time of those spans. This is the data that is shown in a flame graph,
+// where the width of each node is its total time.
+//
+// The root node of a FlameGraph is named "all" and has no self time; its
+// children are the root spans of the traces.
+type FlameGraph struct {
+	Name  string        `json:"name"`
+	Self  time.Duration `json:"self"`  // total self time of the spans
+	Total time.Duration `json:"total"` // Self plus the Total of the children
+	Count int           `json:"count"` // number of spans
+
+	// Children are the nodes of the spans' children, sorted by name.
+	Children []*FlameGraph `json:"children,omitempty"`
+}
+
+// unnamedSpan is the name used in flame graphs for spans without a name, so
+// that they are aggregated together.
+const unnamedSpan = "(unnamed)"
+
+// NewFlameGraph returns the flame graph of the given traces.
+func NewFlameGraph(traces ...*Trace) *FlameGraph {
+	g := &FlameGraph{Name: "all"}
+	for _, t := range traces {
+		g.Add(t)
+	}
+	return g
+}
+
+// Add adds the spans of t to the flame graph, with t as a root span.
+func (g *FlameGraph) Add(t *Trace) {
+	g.add(t)
+}
+
+// add adds t (and its children) as a child of g, and returns the self time
+// that was added to the subtree.
+func (g *FlameGraph) add(t *Trace) time.Duration {
+	name := t.Span.Name()
+	if name == "" {
+		name = unnamedSpan
+	}
+	c := g.child(name)
+	c.Count++
+	self := t.SelfTime()
+	c.Self += self
+	c.Total += self
+	added := self
+	for _, sub := range t.Sub {
+		added += c.add(sub) // also adds to c.Total
+	}
+	g.Total += added
+	return added
+}
+
+// child returns the child of g with the given name, creating it if needed.
+func (g *FlameGraph) child(name string) *FlameGraph {
+	i := sort.Search(len(g.Children), func(i int) bool { return g.Children[i].Name >= name })
+	if i < len(g.Children) && g.Children[i].Name == name {
+		return g.Children[i]
+	}
+	c := &FlameGraph{Name: name}
+	g.Children = append(g.Children, nil)
+	copy(g.Children[i+1:], g.Children[i:])
+	g.Children[i] = c
+	return c
+}
+
+// WriteFolded writes the flame graph to w in the folded stack format used by
+// Brendan Gregg's FlameGraph tools (flamegraph.pl) and many other flame graph
+// viewers. Each line is a semicolon-separated root-to-leaf path of span names
+// followed by a space and the self time of the path's spans in microseconds,
+// e.g.:
+//
+//	GET /users;db;query 1200
+//
+// The root "all" node is omitted, and paths without self time are skipped.
+// Semicolons and line breaks in span names are replaced with underscores.
+func (g *FlameGraph) WriteFolded(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, c := range g.Children {
+		if err := c.writeFolded(bw, nil); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+var foldedNameReplacer = strings.NewReplacer(";", "_", "\n", "_", "\r", "_")
+
+func (g *FlameGraph) writeFolded(w *bufio.Writer, stack []string) error {
+	stack = append(stack, foldedNameReplacer.Replace(g.Name))
+	if us := g.Self / time.Microsecond; us > 0 {
+		if _, err := fmt.Fprintf(w, "%s %d\n", strings.Join(stack, ";"), us); err != nil {
+			return err
+		}
+	}
+	for _, c := range g.Children {
+		if err := c.writeFolded(w, stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package appdash
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestFlameGraph(t *testing.T) {
+	ms := NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	msec := func(n int) time.Time { return base.Add(time.Duration(n) * time.Millisecond) }
+
+	record := func(rec *Recorder, name string, start, end int) *Recorder {
+		if name != "" {
+			rec.Name(name)
+		}
+		rec.Event(Timespan{S: msec(start), E: msec(end)})
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		return rec
+	}
+
+	// Trace 1:        Trace 2:
+	//   GET: 0-10       GET: 0-20
+	//     db: 2-5         db: 0-8
+	//     db: 6-7         cache: 10-12
+	//                       (unnamed): 10-11
+	root := record(NewRecorder(SpanID{Trace: 1, Span: 1}, ms), "GET", 0, 10)
+	record(root.Child(), "db", 2, 5)
+	record(root.Child(), "db", 6, 7)
+	root = record(NewRecorder(SpanID{Trace: 2, Span: 1}, ms), "GET", 0, 20)
+	record(root.Child(), "db", 0, 8)
+	record(record(root.Child(), "cache;x", 10, 12).Child(), "", 10, 11)
+
+	var traces []*Trace
+	for _, id := range []ID{1, 2} {
+		tr, err := ms.Trace(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		traces = append(traces, tr)
+	}
+	g := NewFlameGraph(traces...)
+
+	if g.Total != 30*time.Millisecond || len(g.Children) != 1 {
+		t.Fatalf("got root %+v, want total 30ms and 1 child", g)
+	}
+	get := g.Children[0]
+	if get.Name != "GET" || get.Count != 2 || get.Self != 16*time.Millisecond || get.Total != 30*time.Millisecond {
+		t.Errorf("got GET node %+v, want 2 spans with 16ms self and 30ms total time", get)
+	}
+	if len(get.Children) != 2 || get.Children[0].Name != "cache;x" || get.Children[1].Name != "db" {
+		t.Fatalf("got GET children %+v, want cache;x and db", get.Children)
+	}
+	if db := get.Children[1]; db.Count != 3 || db.Self != 12*time.Millisecond {
+		t.Errorf("got db node %+v, want 3 spans with 12ms self time", db)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteFolded(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `GET 16000
+GET;cache_x 1000
+GET;cache_x;(unnamed) 1000
+GET;db 12000
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got folded stacks\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package appdash
+
+const (
+	// maxInternValueLen is the maximum length of the annotation values that
+	// are interned. Longer values (such as SQL queries or URLs) are rarely
+	// repeated exactly.
+	maxInternValueLen = 32
+
+	// maxInternEntries is the number of keys or values that an internTable
+	// holds before it is cleared, so that unique keys or values (such as
+	// IDs and timestamps) don't make it grow without bounds.
+	maxInternEntries = 4096
+)
+
+// An internTable interns annotation keys and small annotation values, so
+// that a store only keeps one copy of each of the keys and values (such as
+// "_schema:HTTPServer" or "GET") that are repeated in most spans.
+//
+// Interning is transparent to users of the annotations, except that the
+// Value slices of different annotations may share their underlying arrays,
+// so they must not be modified.
+type internTable struct {
+	keys   map[string]string
+	values map[string][]byte
+}
+
+// appendAnnotations appends the annotations in src to dst, with their keys
+// and values interned, and returns the result.
+func (t *internTable) appendAnnotations(dst Annotations, src []Annotation) Annotations {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(Annotations, 0, len(src))
+	}
+	for _, a := range src {
+		dst = append(dst, Annotation{Key: t.key(a.Key), Value: t.value(a.Value)})
+	}
+	return dst
+}
+
+// internTrace interns the annotations of all spans in the trace, in place.
+func (t *internTable) internTrace(tr *Trace) {
+	tr.Walk(func(tr *Trace, depth int, post bool) error {
+		if !post {
+			for i, a := range tr.Annotations {
+				tr.Annotations[i] = Annotation{Key: t.key(a.Key), Value: t.value(a.Value)}
+			}
+		}
+		return nil
+	})
+}
+
+func (t *internTable) key(k string) string {
+	if s, ok := t.keys[k]; ok {
+		return s
+	}
+	if t.keys == nil || len(t.keys) >= maxInternEntries {
+		t.keys = make(map[string]string)
+	}
+	t.keys[k] = k
+	return k
+}
+
+func (t *internTable) value(v []byte) []byte {
+	if len(v) == 0 || len(v) > maxInternValueLen {
+		return v
+	}
+	if b, ok := t.values[string(v)]; ok {
+		return b
+	}
+	if t.values == nil || len(t.values) >= maxInternEntries {
+		t.values = make(map[string][]byte)
+	}
+	// Copy the value, so that the other annotations that it's shared with
+	// don't change if the caller modifies v.
+	b := append([]byte(nil), v...)
+	t.values[string(b)] = b
+	return b
+}
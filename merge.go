@@ -0,0 +1,126 @@
+package appdash
+
+import "bytes"
+
+// MergeTraces merges two partial copies of the same trace, such as the spans
+// of a trace that were collected by two different servers, and returns the
+// resulting trace. Neither a nor b is modified. Either of them may be nil, in
+// which case a copy of the other is returned (or nil if both are nil).
+//
+// Spans are matched by their span ID. The annotations of a span that is
+// present in both traces are the union of its annotations in each, in order,
+// with the annotations of b that are already present in a (i.e. that have the
+// same key and value) omitted.
+//
+// The tree is rebuilt from the spans' parent IDs, so that a span whose parent
+// was only present in the other trace is attached to it. As with the stores,
+// spans whose parent is in neither trace are attached to the root span, and if
+// the actual root span is missing the top-most span found by following the
+// parents of the first span of a (or b) is used as a temporary root. If the
+// parent IDs of some spans form a cycle, one span of the cycle is attached to
+// the root, so that no spans are lost.
+func MergeTraces(a, b *Trace) *Trace {
+	var (
+		spans = map[ID]*Trace{}
+		order []*Trace // in order of first appearance
+	)
+	for _, t := range []*Trace{a, b} {
+		if t == nil {
+			continue
+		}
+		for _, s := range t.Flatten() {
+			m, ok := spans[s.ID.Span]
+			if !ok {
+				m = &Trace{Span: Span{ID: s.ID, Annotations: append(Annotations(nil), s.Annotations...)}}
+				spans[s.ID.Span] = m
+				order = append(order, m)
+				continue
+			}
+			m.Annotations = mergeAnnotations(m.Annotations, s.Annotations)
+		}
+	}
+	if len(order) == 0 {
+		return nil
+	}
+
+	// Find the root span. If it's missing, walk up from the first span (at
+	// most once per span, in case the parent IDs form a cycle).
+	var root *Trace
+	for _, s := range order {
+		if s.ID.IsRoot() {
+			root = s
+			break
+		}
+	}
+	if root == nil {
+		root = order[0]
+		for range order {
+			p, ok := spans[root.ID.Parent]
+			if !ok {
+				break
+			}
+			root = p
+		}
+	}
+
+	parent := make(map[*Trace]*Trace, len(order))
+	for _, s := range order {
+		if s == root {
+			continue
+		}
+		if p, ok := spans[s.ID.Parent]; ok && p != s {
+			parent[s] = p
+		} else {
+			parent[s] = root
+		}
+	}
+
+	// Spans whose parent IDs form a cycle that doesn't include the root would
+	// be unreachable from it, so the first span found in each such cycle is
+	// attached to the root instead.
+	reachable := map[*Trace]bool{root: true}
+	for _, s := range order {
+		var (
+			path   []*Trace
+			onPath = map[*Trace]bool{}
+		)
+		p := s
+		for !reachable[p] && !onPath[p] {
+			onPath[p] = true
+			path = append(path, p)
+			p = parent[p]
+		}
+		if onPath[p] {
+			parent[p] = root
+		}
+		for _, p := range path {
+			reachable[p] = true
+		}
+	}
+
+	for _, s := range order {
+		if s != root {
+			parent[s].Sub = append(parent[s].Sub, s)
+		}
+	}
+	return root
+}
+
+// mergeAnnotations appends the annotations in src that are not already in dst
+// to dst, and returns the result.
+func mergeAnnotations(dst, src Annotations) Annotations {
+	n := len(dst)
+	for _, a := range src {
+		dup := false
+		for _, d := range dst[:n] {
+			if d.Key == a.Key && bytes.Equal(d.Value, a.Value) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			dst = append(dst, a)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,92 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeTraces(t *testing.T) {
+	ms1, ms2 := NewMemoryStore(), NewMemoryStore()
+	collect := func(ms *MemoryStore, id SpanID, anns ...Annotation) {
+		if err := ms.Collect(id, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Store 1 has the root span 1 and its child 2. Store 2 has span 2 (with
+	// an extra annotation), and 3 and 4, the children of 2 and 3.
+	name := func(n string) Annotation { return Annotation{Key: "Name", Value: []byte(n)} }
+	collect(ms1, SpanID{1, 1, 0}, name("root"))
+	collect(ms1, SpanID{1, 2, 1}, name("a"))
+	collect(ms2, SpanID{1, 3, 2}, name("b"))
+	collect(ms2, SpanID{1, 2, 1}, name("a"), Annotation{Key: "k", Value: []byte("v")})
+	collect(ms2, SpanID{1, 4, 3}, name("c"))
+
+	a, err := ms1.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ms2.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := MergeTraces(a, b)
+
+	type flat struct {
+		Span, Parent ID
+		Depth        int
+		Annotations  int
+	}
+	var got []flat
+	for _, s := range m.Flatten() {
+		got = append(got, flat{s.ID.Span, s.ID.Parent, s.Depth, len(s.Annotations)})
+	}
+	want := []flat{
+		{Span: 1, Parent: 0, Depth: 0, Annotations: 1},
+		{Span: 2, Parent: 1, Depth: 1, Annotations: 2},
+		{Span: 3, Parent: 2, Depth: 2, Annotations: 1},
+		{Span: 4, Parent: 3, Depth: 3, Annotations: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got merged spans %+v, want %+v", got, want)
+	}
+	if orphans := m.Orphans(); len(orphans) != 0 {
+		t.Errorf("got %d orphans, want none", len(orphans))
+	}
+
+	// The inputs must not be modified.
+	if len(a.Sub) != 1 || len(a.Sub[0].Sub) != 0 || len(a.Sub[0].Annotations) != 1 {
+		t.Errorf("trace a was modified: %v", a)
+	}
+
+	// Without the root span, the top-most span is the temporary root.
+	m = MergeTraces(b, nil)
+	if m.ID.Span != 2 {
+		t.Errorf("got root span %v, want 2", m.ID.Span)
+	}
+	if MergeTraces(nil, nil) != nil {
+		t.Error("got non-nil merge of nil traces")
+	}
+}
+
+func TestMergeTraces_cycle(t *testing.T) {
+	// Spans 2 and 3 are each other's parents, so neither is a descendant of
+	// the root span.
+	a := &Trace{Span: Span{ID: SpanID{1, 1, 0}}}
+	b := &Trace{
+		Span: Span{ID: SpanID{1, 2, 3}},
+		Sub:  []*Trace{{Span: Span{ID: SpanID{1, 3, 2}}}},
+	}
+	m := MergeTraces(a, b)
+
+	var got []ID
+	for _, s := range m.Flatten() {
+		got = append(got, s.ID.Span)
+	}
+	if want := []ID{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got spans %v, want %v", got, want)
+	}
+	if len(m.Sub) != 1 || m.Sub[0].ID.Span != 2 {
+		t.Errorf("got root children %v, want span 2", m.Sub)
+	}
+}
@@ -1,10 +1,56 @@
 package appdash
 
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// A SourceError is an error returned by one of the stores or queryers
+// underlying a MultiStore or MultiQueryer.
+type SourceError struct {
+	// Index is the index of the source in the list of stores or queryers
+	// given to MultiStore or MultiQueryer.
+	Index int
+
+	// Source is the store or queryer that returned the error.
+	Source interface{}
+
+	// Err is the error returned by the source.
+	Err error
+}
+
+// Error implements the error interface. The source is described by its String
+// method, if it has one.
+func (e *SourceError) Error() string {
+	if s, ok := e.Source.(fmt.Stringer); ok {
+		return fmt.Sprintf("source %d (%s): %s", e.Index, s, e.Err)
+	}
+	return fmt.Sprintf("source %d (%T): %s", e.Index, e.Source, e.Err)
+}
+
+// A MultiError is returned by a MultiStore or MultiQueryer when one or more of
+// its underlying stores or queryers returned an error.
+type MultiError []*SourceError
+
+// Error implements the error interface.
+func (e MultiError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // multiStore is like a normal store except all operations occur on the multiple
 // underlying stores.
 type multiStore struct {
 	// stores is the underlying set of stores that operations take place on.
 	stores []Store
+
+	// merge is whether Trace merges the copies of the trace found in all
+	// stores, instead of returning the first one.
+	merge bool
 }
 
 // Collect implements the Collector interface by invoking Collect on each
@@ -18,17 +64,48 @@ func (ms *multiStore) Collect(id SpanID, anns ...Annotation) error {
 	return nil
 }
 
-// Trace implements the Store interface by returning the first trace found by
-// asking each underlying store for it in consecutive order.
+// Trace implements the Store interface by asking each underlying store for the
+// trace in parallel, and returning the one found by the first store (in
+// consecutive order) that has it. If the multiStore was created by
+// MergingMultiStore, the traces found by all stores are merged instead (see
+// MergeTraces).
+//
+// If no store has the trace and one or more of them returned an error other
+// than ErrTraceNotFound, a MultiError describing those errors is returned.
 func (ms *multiStore) Trace(t ID) (*Trace, error) {
-	for _, s := range ms.stores {
-		trace, err := s.Trace(t)
-		if err == ErrTraceNotFound {
-			continue
-		} else if err != nil {
-			return nil, err
+	traces := make([]*Trace, len(ms.stores))
+	errs := make([]error, len(ms.stores))
+	var wg sync.WaitGroup
+	for i, s := range ms.stores {
+		wg.Add(1)
+		go func(i int, s Store) {
+			defer wg.Done()
+			traces[i], errs[i] = s.Trace(t)
+		}(i, s)
+	}
+	wg.Wait()
+
+	var (
+		merged *Trace
+		merr   MultiError
+	)
+	for i, trace := range traces {
+		switch errs[i] {
+		case nil:
+			if !ms.merge {
+				return trace, nil
+			}
+			merged = MergeTraces(merged, trace)
+		case ErrTraceNotFound:
+		default:
+			merr = append(merr, &SourceError{Index: i, Source: ms.stores[i], Err: errs[i]})
 		}
-		return trace, nil
+	}
+	if merged != nil {
+		return merged, nil
+	}
+	if merr != nil {
+		return nil, merr
 	}
 	return nil, ErrTraceNotFound
 }
@@ -41,6 +118,17 @@ func MultiStore(s ...Store) Store {
 	}
 }
 
+// MergingMultiStore is like MultiStore, except that its Trace method merges
+// the spans of the trace from all of the stores that have it, rather than
+// returning the copy from the first one. This is useful when the services
+// involved in a trace report to different collectors.
+func MergingMultiStore(s ...Store) Store {
+	return &multiStore{
+		stores: s,
+		merge:  true,
+	}
+}
+
 // multiStore is like a normal queryer except it queries from multiple
 // underlying stores.
 type multiQueryer struct {
@@ -48,20 +136,44 @@ type multiQueryer struct {
 	queryers []Queryer
 }
 
-// Traces implements the Queryer interface by returning the union of all
-// underlying stores.
+// Traces implements the Queryer interface by querying all underlying queryers
+// in parallel and returning the union of their results, sorted and paginated
+// according to opts. If more than one queryer returns a trace with the same
+// ID, the one from the first queryer (in consecutive order) is used.
 //
-// It panics if any underlying store does not implement the appdash Queryer
-// interface.
+// If some of the queryers return an error, the union of the results of the
+// others is returned along with a MultiError describing the errors. If all of
+// them return an error, no traces are returned.
 func (mq *multiQueryer) Traces(opts TracesOpts) ([]*Trace, error) {
+	// Each queryer must return enough traces to fill the requested page on
+	// its own, as the page is only determined once the results are merged.
+	sub := opts
+	sub.Offset = 0
+	if opts.Limit > 0 {
+		sub.Limit = opts.Offset + opts.Limit
+	}
+
+	results := make([][]*Trace, len(mq.queryers))
+	errs := make([]error, len(mq.queryers))
+	var wg sync.WaitGroup
+	for i, q := range mq.queryers {
+		wg.Add(1)
+		go func(i int, q Queryer) {
+			defer wg.Done()
+			results[i], errs[i] = q.Traces(sub)
+		}(i, q)
+	}
+	wg.Wait()
+
 	var (
 		union = make(map[ID]struct{})
 		all   []*Trace
+		merr  MultiError
 	)
-	for _, q := range mq.queryers {
-		traces, err := q.Traces(TracesOpts{})
-		if err != nil {
-			return nil, err
+	for i, traces := range results {
+		if errs[i] != nil {
+			merr = append(merr, &SourceError{Index: i, Source: mq.queryers[i], Err: errs[i]})
+			continue
 		}
 		for _, t := range traces {
 			if _, ok := union[t.ID.Trace]; !ok {
@@ -70,6 +182,24 @@ func (mq *multiQueryer) Traces(opts TracesOpts) ([]*Trace, error) {
 			}
 		}
 	}
+	if len(mq.queryers) > 0 && len(merr) == len(mq.queryers) {
+		return nil, merr
+	}
+
+	// The traces have already been filtered by each queryer, so only sort
+	// and paginate them here.
+	all, err := applyTracesOpts(all, TracesOpts{
+		Sort:   opts.Sort,
+		Desc:   opts.Desc,
+		Offset: opts.Offset,
+		Limit:  opts.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if merr != nil {
+		return all, merr
+	}
 	return all, nil
 }
 
@@ -0,0 +1,133 @@
+package appdash
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// errQueryer is a Store and Queryer that always fails.
+type errQueryer struct{ err error }
+
+func (q errQueryer) Collect(SpanID, ...Annotation) error      { return q.err }
+func (q errQueryer) Trace(ID) (*Trace, error)                 { return nil, q.err }
+func (q errQueryer) Traces(opts TracesOpts) ([]*Trace, error) { return nil, q.err }
+
+func TestMultiQueryer_Traces(t *testing.T) {
+	ms1, ms2 := NewMemoryStore(), NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// collect records a root span in ms, starting at base+offset.
+	collect := func(ms *MemoryStore, id ID, offset time.Duration) {
+		rec := NewRecorder(SpanID{Trace: id, Span: id}, ms)
+		rec.Name("s")
+		rec.Event(Timespan{S: base.Add(offset), E: base.Add(offset + time.Second)})
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+	collect(ms1, 1, 1*time.Minute)
+	collect(ms2, 2, 2*time.Minute)
+	collect(ms1, 3, 3*time.Minute)
+	collect(ms2, 4, 4*time.Minute)
+	collect(ms2, 3, 3*time.Minute) // duplicate of trace 3 in ms1
+
+	mq := MultiQueryer(ms1, ms2)
+	tests := []struct {
+		opts TracesOpts
+		want []ID
+	}{
+		{opts: TracesOpts{}, want: []ID{1, 2, 3, 4}},
+		{opts: TracesOpts{Desc: true}, want: []ID{4, 3, 2, 1}},
+		{opts: TracesOpts{Offset: 1, Limit: 2}, want: []ID{2, 3}},
+		{opts: TracesOpts{Desc: true, Limit: 3}, want: []ID{4, 3, 2}},
+		{opts: TracesOpts{TraceIDs: []ID{1, 4}}, want: []ID{1, 4}},
+		{opts: TracesOpts{Timespan: Timespan{S: base.Add(2*time.Minute + 30*time.Second)}}, want: []ID{3, 4}},
+	}
+	for _, test := range tests {
+		traces, err := mq.Traces(test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []ID{}
+		for _, tr := range traces {
+			got = append(got, tr.Span.ID.Trace)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Traces(%+v): got traces %v, want %v", test.opts, got, test.want)
+		}
+	}
+}
+
+func TestMultiQueryer_Traces_errors(t *testing.T) {
+	ms := NewMemoryStore()
+	if err := ms.Collect(SpanID{Trace: 1, Span: 1}); err != nil {
+		t.Fatal(err)
+	}
+	fail := errQueryer{errors.New("unavailable")}
+
+	// Some queryers fail: the others' traces are returned with the errors.
+	traces, err := MultiQueryer(ms, fail).Traces(TracesOpts{})
+	merr, ok := err.(MultiError)
+	if !ok {
+		t.Fatalf("got error %v, want MultiError", err)
+	}
+	if len(merr) != 1 || merr[0].Index != 1 || merr[0].Err != fail.err {
+		t.Errorf("got errors %v, want one error from source 1", merr)
+	}
+	if len(traces) != 1 {
+		t.Errorf("got %d traces, want 1", len(traces))
+	}
+
+	// All queryers fail.
+	traces, err = MultiQueryer(fail, fail).Traces(TracesOpts{})
+	if merr, ok := err.(MultiError); !ok || len(merr) != 2 {
+		t.Errorf("got error %v, want MultiError with 2 errors", err)
+	}
+	if traces != nil {
+		t.Errorf("got traces %v, want nil", traces)
+	}
+}
+
+func TestMultiStore_Trace(t *testing.T) {
+	ms1, ms2 := NewMemoryStore(), NewMemoryStore()
+	if err := ms2.Collect(SpanID{Trace: 1, Span: 1}); err != nil {
+		t.Fatal(err)
+	}
+	fail := errQueryer{errors.New("unavailable")}
+
+	// A failing store doesn't prevent the trace from being found in others.
+	if _, err := MultiStore(fail, ms1, ms2).Trace(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MultiStore(ms1, ms2).Trace(2); err != ErrTraceNotFound {
+		t.Errorf("got error %v, want ErrTraceNotFound", err)
+	}
+	if _, err := MultiStore(ms1, fail).Trace(2); err == ErrTraceNotFound || err == nil {
+		t.Errorf("got error %v, want MultiError", err)
+	}
+}
+
+func TestMergingMultiStore_Trace(t *testing.T) {
+	ms1, ms2 := NewMemoryStore(), NewMemoryStore()
+	if err := ms1.Collect(SpanID{Trace: 1, Span: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ms2.Collect(SpanID{Trace: 1, Span: 2, Parent: 1}); err != nil {
+		t.Fatal(err)
+	}
+	fail := errQueryer{errors.New("unavailable")}
+
+	trace, err := MergingMultiStore(ms1, fail, ms2).Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trace.Sub) != 1 || trace.Sub[0].ID.Span != 2 {
+		t.Errorf("got trace %v, want span 2 merged under span 1", trace)
+	}
+	if _, err := MergingMultiStore(ms1, ms2).Trace(2); err != ErrTraceNotFound {
+		t.Errorf("got error %v, want ErrTraceNotFound", err)
+	}
+}
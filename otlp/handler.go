@@ -0,0 +1,116 @@
+package otlp
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// TracesPath is the URL path of the OTLP/HTTP traces endpoint.
+const TracesPath = "/v1/traces"
+
+// maxRequestSize is the maximum size of a request body (after decompression)
+// that a Handler accepts.
+const maxRequestSize = 16 << 20
+
+// The content types of the OTLP/HTTP encodings.
+const (
+	protobufContentType = "application/x-protobuf"
+	jsonContentType     = "application/json"
+)
+
+// Handler is an http.Handler that accepts OTLP/HTTP trace export requests in
+// the protobuf or JSON encoding (optionally gzip-encoded), as sent by
+// OpenTelemetry exporters to /v1/traces, and collects their spans into
+// Collector.
+//
+// As with an OTLP receiver, a 200 OK response with an empty
+// ExportTraceServiceResponse (in the encoding of the request) is sent if the
+// spans were collected, and a 400 Bad Request response if they are invalid.
+// Requests larger than 16 MB (after decompression) are rejected with a 413
+// Request Entity Too Large response.
+type Handler struct {
+	Collector appdash.Collector
+
+	// Log is the logger that collection errors are logged to, or nil to use
+	// the default logger.
+	Log *log.Logger
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	defer r.Body.Close()
+
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if contentType != protobufContentType && contentType != jsonContentType {
+		http.Error(w, "otlp: unsupported content type "+contentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var body io.ReadCloser = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, body, maxRequestSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "otlp: request too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req ExportTraceServiceRequest
+	if contentType == jsonContentType {
+		err = json.Unmarshal(data, &req)
+	} else {
+		err = req.Unmarshal(data)
+	}
+	if err != nil {
+		http.Error(w, "otlp: invalid request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	cs, err := convert(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := collect(h.Collector, cs); err != nil {
+		h.logf("otlp: collecting %d spans failed: %s", len(cs), err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	if contentType == jsonContentType {
+		io.WriteString(w, "{}")
+	}
+}
+
+func (h *Handler) logf(format string, args ...interface{}) {
+	if h.Log != nil {
+		h.Log.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
@@ -0,0 +1,82 @@
+package otlp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// The OTLP JSON encoding differs from the standard protobuf JSON mapping in
+// that trace and span IDs are hex strings instead of base64. Like in the
+// standard mapping, 64-bit integers are encoded as decimal strings, but
+// numbers are also accepted.
+
+// An ID is a trace or span ID. It is encoded as a hex string in JSON.
+type ID []byte
+
+// MarshalJSON encodes the ID as a hex string.
+func (id ID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(id))
+}
+
+// UnmarshalJSON decodes the ID from a hex string.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("otlp: invalid ID %q", s)
+	}
+	*id = b
+	return nil
+}
+
+// Uint64 is an unsigned 64-bit integer (such as a timestamp). It is encoded
+// as a decimal string in JSON.
+type Uint64 uint64
+
+// MarshalJSON encodes the integer as a decimal string.
+func (v Uint64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(v), 10))
+}
+
+// UnmarshalJSON decodes the integer from a decimal string or a JSON number.
+func (v *Uint64) UnmarshalJSON(data []byte) error {
+	i, err := strconv.ParseUint(jsonNumber(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("otlp: invalid integer %s", data)
+	}
+	*v = Uint64(i)
+	return nil
+}
+
+// Int64 is a signed 64-bit integer. It is encoded as a decimal string in
+// JSON.
+type Int64 int64
+
+// MarshalJSON encodes the integer as a decimal string.
+func (v Int64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatInt(int64(v), 10))
+}
+
+// UnmarshalJSON decodes the integer from a decimal string or a JSON number.
+func (v *Int64) UnmarshalJSON(data []byte) error {
+	i, err := strconv.ParseInt(jsonNumber(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("otlp: invalid integer %s", data)
+	}
+	*v = Int64(i)
+	return nil
+}
+
+// jsonNumber returns the JSON number or string data without the quotes of a
+// string.
+func jsonNumber(data []byte) string {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		return string(data[1 : len(data)-1])
+	}
+	return string(data)
+}
@@ -0,0 +1,429 @@
+// Package otlp converts OpenTelemetry traces in the OTLP format into appdash
+// spans, so that services that are instrumented with an OpenTelemetry SDK can
+// report their spans to an appdash server.
+//
+// The Handler accepts the same requests as the /v1/traces endpoint of an
+// OTLP/HTTP receiver, in either the protobuf or the JSON encoding (see `appdash
+// serve --otlp`).
+//
+// The types in this package mirror the messages of the OTLP trace protobuf
+// definitions (opentelemetry/proto/trace/v1/trace.proto and its
+// dependencies), but only the fields that appdash uses. Unknown fields are
+// ignored when decoding. See the export package for the conversion of appdash
+// traces into OTLP.
+//
+// # Conversion
+//
+// Each OTLP span is collected as an appdash span with the following events
+// and annotations:
+//
+//	name                      SpanNameEvent
+//	start and end times       Timespan
+//	events                    log events (with the event's attributes, if
+//	                          any, appended to its name as JSON)
+//	resource attributes       annotations with the same keys and values
+//	scope name and version    the "otel.scope.name" and "otel.scope.version"
+//	                          annotations
+//	attributes                annotations with the same keys and values
+//	kind                      the "span.kind" annotation (e.g. "server")
+//	status                    the "otel.status_code" (e.g. "ERROR") and
+//	                          "otel.status_description" annotations, and
+//	                          "error" = "true" if the status is ERROR
+//
+// Links between spans are not collected.
+//
+// As with the zipkin package, only the lower 64 bits (the last 8 bytes) of a
+// trace ID are used as the appdash trace ID, and the full ID is kept in the
+// "otlp.traceId" annotation if its upper 64 bits are not zero.
+package otlp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// The annotation keys that a span's scope, kind, status and 128-bit trace ID
+// are recorded with.
+const (
+	ScopeNameKey         = "otel.scope.name"
+	ScopeVersionKey      = "otel.scope.version"
+	SpanKindKey          = "span.kind"
+	StatusCodeKey        = "otel.status_code"
+	StatusDescriptionKey = "otel.status_description"
+	ErrorKey             = "error"
+	TraceIDKey           = "otlp.traceId"
+)
+
+// ExportTraceServiceRequest is the request message of the OTLP trace service,
+// as sent to /v1/traces.
+type ExportTraceServiceRequest struct {
+	ResourceSpans []ResourceSpans `json:"resourceSpans,omitempty"`
+}
+
+// ResourceSpans are the spans of a resource (such as a service).
+type ResourceSpans struct {
+	Resource   *Resource    `json:"resource,omitempty"`
+	ScopeSpans []ScopeSpans `json:"scopeSpans,omitempty"`
+	SchemaURL  string       `json:"schemaUrl,omitempty"`
+}
+
+// A Resource is the entity that produces spans, described by its attributes
+// (e.g. "service.name").
+type Resource struct {
+	Attributes []KeyValue `json:"attributes,omitempty"`
+}
+
+// ScopeSpans are the spans produced by an instrumentation scope (such as an
+// instrumentation library).
+type ScopeSpans struct {
+	Scope     *InstrumentationScope `json:"scope,omitempty"`
+	Spans     []Span                `json:"spans,omitempty"`
+	SchemaURL string                `json:"schemaUrl,omitempty"`
+}
+
+// An InstrumentationScope is the instrumentation library that produces spans.
+type InstrumentationScope struct {
+	Name       string     `json:"name,omitempty"`
+	Version    string     `json:"version,omitempty"`
+	Attributes []KeyValue `json:"attributes,omitempty"`
+}
+
+// A Span is a single operation within a trace.
+type Span struct {
+	TraceID           ID         `json:"traceId"`
+	SpanID            ID         `json:"spanId"`
+	TraceState        string     `json:"traceState,omitempty"`
+	ParentSpanID      ID         `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              SpanKind   `json:"kind,omitempty"`
+	StartTimeUnixNano Uint64     `json:"startTimeUnixNano,omitempty"`
+	EndTimeUnixNano   Uint64     `json:"endTimeUnixNano,omitempty"`
+	Attributes        []KeyValue `json:"attributes,omitempty"`
+	Events            []Event    `json:"events,omitempty"`
+	Status            *Status    `json:"status,omitempty"`
+}
+
+// SpanKind is the type of a span.
+type SpanKind int32
+
+// Span kinds.
+const (
+	SpanKindUnspecified SpanKind = iota
+	SpanKindInternal
+	SpanKindServer
+	SpanKindClient
+	SpanKindProducer
+	SpanKindConsumer
+)
+
+var spanKindNames = map[SpanKind]string{
+	SpanKindInternal: "internal",
+	SpanKindServer:   "server",
+	SpanKindClient:   "client",
+	SpanKindProducer: "producer",
+	SpanKindConsumer: "consumer",
+}
+
+// String returns the lower-case name of the span kind, as used in the
+// "span.kind" annotation, or "" if it is unspecified or unknown.
+func (k SpanKind) String() string { return spanKindNames[k] }
+
+// An Event is a timestamped event in a span.
+type Event struct {
+	TimeUnixNano Uint64     `json:"timeUnixNano,omitempty"`
+	Name         string     `json:"name"`
+	Attributes   []KeyValue `json:"attributes,omitempty"`
+}
+
+// Status is the status of a finished span.
+type Status struct {
+	Message string     `json:"message,omitempty"`
+	Code    StatusCode `json:"code,omitempty"`
+}
+
+// StatusCode is the status code of a span.
+type StatusCode int32
+
+// Status codes.
+const (
+	StatusCodeUnset StatusCode = iota
+	StatusCodeOK
+	StatusCodeError
+)
+
+// String returns the name of the status code, as used in the
+// "otel.status_code" annotation, or "" if it is unset or unknown.
+func (c StatusCode) String() string {
+	switch c {
+	case StatusCodeOK:
+		return "OK"
+	case StatusCodeError:
+		return "ERROR"
+	}
+	return ""
+}
+
+// A KeyValue is an attribute.
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// An AnyValue is an attribute value. At most one of its fields is set.
+type AnyValue struct {
+	StringValue *string       `json:"stringValue,omitempty"`
+	BoolValue   *bool         `json:"boolValue,omitempty"`
+	IntValue    *Int64        `json:"intValue,omitempty"`
+	DoubleValue *float64      `json:"doubleValue,omitempty"`
+	ArrayValue  *ArrayValue   `json:"arrayValue,omitempty"`
+	KvlistValue *KeyValueList `json:"kvlistValue,omitempty"`
+	BytesValue  []byte        `json:"bytesValue,omitempty"`
+}
+
+// ArrayValue is a list of attribute values.
+type ArrayValue struct {
+	Values []AnyValue `json:"values,omitempty"`
+}
+
+// KeyValueList is a list of attributes, which may be nested in another
+// attribute.
+type KeyValueList struct {
+	Values []KeyValue `json:"values,omitempty"`
+}
+
+// String returns the value as it is stored in an appdash annotation: strings
+// as is, booleans and numbers in their usual text form, bytes in hex, and
+// arrays and lists as JSON.
+func (v *AnyValue) String() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.IntValue != nil:
+		return strconv.FormatInt(int64(*v.IntValue), 10)
+	case v.DoubleValue != nil:
+		return strconv.FormatFloat(*v.DoubleValue, 'g', -1, 64)
+	case v.BytesValue != nil:
+		return hex.EncodeToString(v.BytesValue)
+	case v.ArrayValue != nil, v.KvlistValue != nil:
+		b, err := json.Marshal(v.value())
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+	return ""
+}
+
+// value returns the value as a plain Go value, for encoding arrays and lists
+// as JSON.
+func (v *AnyValue) value() interface{} {
+	switch {
+	case v.ArrayValue != nil:
+		vals := make([]interface{}, len(v.ArrayValue.Values))
+		for i := range v.ArrayValue.Values {
+			vals[i] = v.ArrayValue.Values[i].value()
+		}
+		return vals
+	case v.KvlistValue != nil:
+		return attributeMap(v.KvlistValue.Values)
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	case v.IntValue != nil:
+		return int64(*v.IntValue)
+	case v.DoubleValue != nil:
+		return *v.DoubleValue
+	}
+	return v.String()
+}
+
+// attributeMap returns the attributes as a map from key to plain Go value.
+func attributeMap(attrs []KeyValue) map[string]interface{} {
+	m := make(map[string]interface{}, len(attrs))
+	for i := range attrs {
+		m[attrs[i].Key] = attrs[i].Value.value()
+	}
+	return m
+}
+
+// AppdashSpanID returns the appdash span ID of the span. An error is returned
+// if the trace or span ID is missing or does not have the right length, or if
+// the lower 64 bits of the trace ID are zero.
+func (s *Span) AppdashSpanID() (appdash.SpanID, error) {
+	if len(s.TraceID) != 16 || isZero(s.TraceID[8:]) {
+		return appdash.SpanID{}, fmt.Errorf("otlp: invalid trace ID %x", []byte(s.TraceID))
+	}
+	if len(s.SpanID) != 8 || isZero(s.SpanID) {
+		return appdash.SpanID{}, fmt.Errorf("otlp: invalid span ID %x", []byte(s.SpanID))
+	}
+	id := appdash.SpanID{
+		Trace: appdash.ID(binary.BigEndian.Uint64(s.TraceID[8:])),
+		Span:  appdash.ID(binary.BigEndian.Uint64(s.SpanID)),
+	}
+	if len(s.ParentSpanID) != 0 {
+		if len(s.ParentSpanID) != 8 {
+			return appdash.SpanID{}, fmt.Errorf("otlp: invalid parent span ID %x", []byte(s.ParentSpanID))
+		}
+		id.Parent = appdash.ID(binary.BigEndian.Uint64(s.ParentSpanID))
+	}
+	return id, nil
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// AppdashAnnotations returns the appdash annotations of the span, as
+// described in the package documentation. The resource and scope are those
+// that the span was sent with, and may be nil.
+func (s *Span) AppdashAnnotations(res *Resource, scope *InstrumentationScope) (appdash.Annotations, error) {
+	var events []appdash.Event
+	if s.Name != "" {
+		events = append(events, appdash.SpanName(s.Name))
+	}
+	if s.StartTimeUnixNano != 0 {
+		start := nanosToTime(s.StartTimeUnixNano)
+		end := start
+		if s.EndTimeUnixNano >= s.StartTimeUnixNano {
+			end = nanosToTime(s.EndTimeUnixNano)
+		}
+		events = append(events, appdash.Timespan{S: start, E: end})
+	}
+	for i := range s.Events {
+		e := &s.Events[i]
+		msg := e.Name
+		if len(e.Attributes) > 0 {
+			b, err := json.Marshal(attributeMap(e.Attributes))
+			if err != nil {
+				return nil, err
+			}
+			msg += " " + string(b)
+		}
+		events = append(events, appdash.LogWithTimestamp(msg, nanosToTime(e.TimeUnixNano)))
+	}
+
+	var anns appdash.Annotations
+	for _, e := range events {
+		ea, err := appdash.MarshalEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		anns = append(anns, ea...)
+	}
+
+	add := func(k, v string) {
+		anns = append(anns, appdash.Annotation{Key: k, Value: []byte(v)})
+	}
+	if res != nil {
+		anns = appendAttributes(anns, res.Attributes)
+	}
+	if scope != nil {
+		if scope.Name != "" {
+			add(ScopeNameKey, scope.Name)
+		}
+		if scope.Version != "" {
+			add(ScopeVersionKey, scope.Version)
+		}
+	}
+	anns = appendAttributes(anns, s.Attributes)
+	if k := s.Kind.String(); k != "" {
+		add(SpanKindKey, k)
+	}
+	if s.Status != nil {
+		if c := s.Status.Code.String(); c != "" {
+			add(StatusCodeKey, c)
+		}
+		if s.Status.Message != "" {
+			add(StatusDescriptionKey, s.Status.Message)
+		}
+		if s.Status.Code == StatusCodeError {
+			add(ErrorKey, "true")
+		}
+	}
+	if len(s.TraceID) == 16 && !isZero(s.TraceID[:8]) {
+		add(TraceIDKey, hex.EncodeToString(s.TraceID))
+	}
+	return anns, nil
+}
+
+// appendAttributes appends the attributes, sorted by key, to anns as
+// annotations.
+func appendAttributes(anns appdash.Annotations, attrs []KeyValue) appdash.Annotations {
+	start := len(anns)
+	for i := range attrs {
+		anns = append(anns, appdash.Annotation{Key: attrs[i].Key, Value: []byte(attrs[i].Value.String())})
+	}
+	sort.Stable(annotationsByKey(anns[start:]))
+	return anns
+}
+
+type annotationsByKey appdash.Annotations
+
+func (a annotationsByKey) Len() int           { return len(a) }
+func (a annotationsByKey) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a annotationsByKey) Less(i, j int) bool { return a[i].Key < a[j].Key }
+
+func nanosToTime(ns Uint64) time.Time {
+	return time.Unix(0, int64(ns))
+}
+
+// Collect converts the spans in the request and collects them into c. If any
+// span is invalid, an error is returned and none of the spans are collected.
+func Collect(c appdash.Collector, req *ExportTraceServiceRequest) error {
+	cs, err := convert(req)
+	if err != nil {
+		return err
+	}
+	return collect(c, cs)
+}
+
+// A convertedSpan is the appdash span ID and annotations of an OTLP span.
+type convertedSpan struct {
+	id   appdash.SpanID
+	anns appdash.Annotations
+}
+
+func convert(req *ExportTraceServiceRequest) ([]convertedSpan, error) {
+	var cs []convertedSpan
+	for i := range req.ResourceSpans {
+		rs := &req.ResourceSpans[i]
+		for j := range rs.ScopeSpans {
+			ss := &rs.ScopeSpans[j]
+			for k := range ss.Spans {
+				s := &ss.Spans[k]
+				id, err := s.AppdashSpanID()
+				if err != nil {
+					return nil, err
+				}
+				anns, err := s.AppdashAnnotations(rs.Resource, ss.Scope)
+				if err != nil {
+					return nil, err
+				}
+				cs = append(cs, convertedSpan{id, anns})
+			}
+		}
+	}
+	return cs, nil
+}
+
+func collect(c appdash.Collector, cs []convertedSpan) error {
+	for _, s := range cs {
+		if err := c.Collect(s.id, s.anns...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
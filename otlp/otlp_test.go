@@ -0,0 +1,239 @@
+package otlp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// Helpers for encoding protobuf test messages.
+func pbUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func pbKey(num, wire int) []byte { return pbUvarint(nil, uint64(num<<3|wire)) }
+
+func pbVarint(num int, v uint64) []byte { return pbUvarint(pbKey(num, wireVarint), v) }
+
+func pbFixed64(num int, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(pbKey(num, wireFixed64), buf[:]...)
+}
+
+func pbBytes(num int, parts ...[]byte) []byte {
+	b := bytes.Join(parts, nil)
+	return append(pbUvarint(pbKey(num, wireBytes), uint64(len(b))), b...)
+}
+
+func pbString(num int, s string) []byte { return pbBytes(num, []byte(s)) }
+
+func pbAttr(key string, value []byte) []byte {
+	return pbBytes(9, pbString(1, key), pbBytes(2, value))
+}
+
+var (
+	testTraceID = []byte{0x46, 0x3a, 0xc3, 0x5c, 0x9f, 0x64, 0x13, 0xad, 0x48, 0x48, 0x5a, 0x39, 0x53, 0xbb, 0x61, 0x24}
+	testStart   = time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func testProtoRequest() []byte {
+	attr := func(num int, key string, value []byte) []byte {
+		return pbBytes(num, pbString(1, key), pbBytes(2, value))
+	}
+	span := pbBytes(2, // ScopeSpans.spans
+		pbBytes(1, testTraceID),
+		pbBytes(2, []byte{0, 0, 0, 0, 0, 0, 0, 2}),
+		pbBytes(4, []byte{0, 0, 0, 0, 0, 0, 0, 1}),
+		pbString(5, "GET /users"),
+		pbVarint(6, uint64(SpanKindServer)),
+		pbFixed64(7, uint64(testStart.UnixNano())),
+		pbFixed64(8, uint64(testStart.Add(2*time.Millisecond).UnixNano())),
+		pbAttr("http.method", pbString(1, "GET")),
+		pbAttr("http.status_code", pbVarint(3, 500)),
+		pbAttr("retry", pbVarint(2, 1)),
+		pbAttr("ratio", pbFixed64(4, math.Float64bits(0.5))),
+		pbVarint(99, 1), // unknown field
+		pbBytes(11, // event
+			pbFixed64(1, uint64(testStart.Add(time.Millisecond).UnixNano())),
+			pbString(2, "exception"),
+			attr(3, "exception.message", pbString(1, "boom")),
+		),
+		pbBytes(15, pbString(2, "internal error"), pbVarint(3, uint64(StatusCodeError))),
+	)
+	return pbBytes(1, // ExportTraceServiceRequest.resource_spans
+		pbBytes(1, attr(1, "service.name", pbString(1, "frontend"))),
+		pbBytes(2,
+			pbBytes(1, pbString(1, "net/http"), pbString(2, "1.0")),
+			span,
+		),
+	)
+}
+
+const testJSONRequest = `{
+	"resourceSpans": [{
+		"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "frontend"}}]},
+		"scopeSpans": [{
+			"scope": {"name": "net/http", "version": "1.0"},
+			"spans": [{
+				"traceId": "463ac35c9f6413ad48485a3953bb6124",
+				"spanId": "0000000000000002",
+				"parentSpanId": "0000000000000001",
+				"name": "GET /users",
+				"kind": 2,
+				"startTimeUnixNano": "1464782400000000000",
+				"endTimeUnixNano": 1464782400002000000,
+				"attributes": [
+					{"key": "http.method", "value": {"stringValue": "GET"}},
+					{"key": "http.status_code", "value": {"intValue": "500"}},
+					{"key": "retry", "value": {"boolValue": true}},
+					{"key": "ratio", "value": {"doubleValue": 0.5}}
+				],
+				"events": [{
+					"timeUnixNano": "1464782400001000000",
+					"name": "exception",
+					"attributes": [{"key": "exception.message", "value": {"stringValue": "boom"}}]
+				}],
+				"status": {"message": "internal error", "code": 2}
+			}]
+		}]
+	}]
+}`
+
+// checkCollected checks that the span of the test requests was collected
+// into ms.
+func checkCollected(t *testing.T, ms *appdash.MemoryStore) {
+	tr, err := ms.Trace(0x48485a3953bb6124)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The parent span wasn't collected, so the span is the trace's root.
+	if want := (appdash.SpanID{Trace: 0x48485a3953bb6124, Span: 2, Parent: 1}); tr.ID != want {
+		t.Errorf("got span ID %v, want %v", tr.ID, want)
+	}
+	if name := tr.Span.Name(); name != "GET /users" {
+		t.Errorf("got name %q, want %q", name, "GET /users")
+	}
+
+	var ts appdash.Timespan
+	if err := appdash.UnmarshalEvent(tr.Annotations, &ts); err != nil {
+		t.Fatal(err)
+	}
+	if !ts.S.Equal(testStart) || ts.E.Sub(ts.S) != 2*time.Millisecond {
+		t.Errorf("got timespan %v-%v, want %v + 2ms", ts.S, ts.E, testStart)
+	}
+
+	m := tr.Annotations.StringMap()
+	for k, v := range map[string]string{
+		"service.name":       "frontend",
+		ScopeNameKey:         "net/http",
+		ScopeVersionKey:      "1.0",
+		"http.method":        "GET",
+		"http.status_code":   "500",
+		"retry":              "true",
+		"ratio":              "0.5",
+		SpanKindKey:          "server",
+		StatusCodeKey:        "ERROR",
+		StatusDescriptionKey: "internal error",
+		ErrorKey:             "true",
+		TraceIDKey:           "463ac35c9f6413ad48485a3953bb6124",
+		"Msg":                `exception {"exception.message":"boom"}`,
+	} {
+		if got, ok := m[k]; !ok || got != v {
+			t.Errorf("got annotation %s=%q (present %v), want %q", k, got, ok, v)
+		}
+	}
+
+	// Error filtering must find the span.
+	q, err := appdash.ParseQuery("error:true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := q.Match(tr); err != nil || !ok {
+		t.Errorf("got query match %v (error %v), want true", ok, err)
+	}
+}
+
+func TestExportTraceServiceRequest_Unmarshal(t *testing.T) {
+	var req ExportTraceServiceRequest
+	if err := req.Unmarshal(testProtoRequest()); err != nil {
+		t.Fatal(err)
+	}
+	ms := appdash.NewMemoryStore()
+	if err := Collect(ms, &req); err != nil {
+		t.Fatal(err)
+	}
+	checkCollected(t, ms)
+
+	// Truncated messages must be rejected.
+	data := testProtoRequest()
+	if err := req.Unmarshal(data[:len(data)-1]); err == nil {
+		t.Error("got no error for a truncated message")
+	}
+}
+
+func TestSpan_AppdashSpanID(t *testing.T) {
+	for _, s := range []Span{
+		{TraceID: testTraceID[:8], SpanID: ID{0, 0, 0, 0, 0, 0, 0, 1}},
+		{TraceID: make(ID, 16), SpanID: ID{0, 0, 0, 0, 0, 0, 0, 1}},
+		{TraceID: testTraceID, SpanID: make(ID, 8)},
+		{TraceID: testTraceID, SpanID: ID{0, 0, 0, 0, 0, 0, 0, 1}, ParentSpanID: ID{1}},
+	} {
+		if id, err := s.AppdashSpanID(); err == nil {
+			t.Errorf("%+v: got span ID %v, want error", s, id)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	for _, test := range []struct {
+		contentType, body string
+	}{
+		{"application/x-protobuf", string(testProtoRequest())},
+		{"application/json; charset=utf-8", testJSONRequest},
+	} {
+		ms := appdash.NewMemoryStore()
+		srv := httptest.NewServer(&Handler{Collector: ms})
+		resp, err := http.Post(srv.URL+TracesPath, test.contentType, strings.NewReader(test.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		srv.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: got status %d, want %d", test.contentType, resp.StatusCode, http.StatusOK)
+			continue
+		}
+		checkCollected(t, ms)
+	}
+
+	srv := httptest.NewServer(&Handler{Collector: appdash.NewMemoryStore()})
+	defer srv.Close()
+	for _, test := range []struct {
+		contentType, body string
+		status            int
+	}{
+		{"text/plain", "", http.StatusUnsupportedMediaType},
+		{"application/json", "{", http.StatusBadRequest},
+		{"application/json", `{"resourceSpans": [{"scopeSpans": [{"spans": [{"traceId": "01", "spanId": "01"}]}]}]}`, http.StatusBadRequest},
+		{"application/x-protobuf", "\xff", http.StatusBadRequest},
+		{"application/x-protobuf", strings.Repeat("\x00", maxRequestSize+1), http.StatusRequestEntityTooLarge},
+	} {
+		resp, err := http.Post(srv.URL+TracesPath, test.contentType, strings.NewReader(test.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%s %.20q: got status %d, want %d", test.contentType, test.body, resp.StatusCode, test.status)
+		}
+	}
+}
@@ -0,0 +1,564 @@
+package otlp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// The OTLP protobuf encoding is encoded and decoded by hand, instead of with
+// code generated from the OTLP .proto files, so that this package doesn't depend
+// on the OpenTelemetry protobuf packages (or on a newer protobuf runtime than
+// the rest of appdash).
+
+// Marshal encodes the request in the OTLP protobuf encoding.
+func (r *ExportTraceServiceRequest) Marshal() ([]byte, error) {
+	var e protoEncoder
+	for i := range r.ResourceSpans {
+		e.message(1, r.ResourceSpans[i].marshal)
+	}
+	return e.b, nil
+}
+
+// Unmarshal decodes the request from the OTLP protobuf encoding.
+func (r *ExportTraceServiceRequest) Unmarshal(data []byte) error {
+	*r = ExportTraceServiceRequest{}
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			var rs ResourceSpans
+			if err := d.message(wire, rs.unmarshal); err != nil {
+				return err
+			}
+			r.ResourceSpans = append(r.ResourceSpans, rs)
+			return nil
+		}
+		return d.skip(wire)
+	})
+}
+
+func (rs *ResourceSpans) marshal(e *protoEncoder) {
+	if rs.Resource != nil {
+		e.message(1, rs.Resource.marshal)
+	}
+	for i := range rs.ScopeSpans {
+		e.message(2, rs.ScopeSpans[i].marshal)
+	}
+	e.string(3, rs.SchemaURL)
+}
+
+func (rs *ResourceSpans) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			rs.Resource = new(Resource)
+			return d.message(wire, rs.Resource.unmarshal)
+		case 2:
+			var ss ScopeSpans
+			if err := d.message(wire, ss.unmarshal); err != nil {
+				return err
+			}
+			rs.ScopeSpans = append(rs.ScopeSpans, ss)
+			return nil
+		case 3:
+			return d.string(wire, &rs.SchemaURL)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (r *Resource) marshal(e *protoEncoder) {
+	e.keyValues(1, r.Attributes)
+}
+
+func (r *Resource) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			return d.keyValue(wire, &r.Attributes)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (ss *ScopeSpans) marshal(e *protoEncoder) {
+	if ss.Scope != nil {
+		e.message(1, ss.Scope.marshal)
+	}
+	for i := range ss.Spans {
+		e.message(2, ss.Spans[i].marshal)
+	}
+	e.string(3, ss.SchemaURL)
+}
+
+func (ss *ScopeSpans) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			ss.Scope = new(InstrumentationScope)
+			return d.message(wire, ss.Scope.unmarshal)
+		case 2:
+			var s Span
+			if err := d.message(wire, s.unmarshal); err != nil {
+				return err
+			}
+			ss.Spans = append(ss.Spans, s)
+			return nil
+		case 3:
+			return d.string(wire, &ss.SchemaURL)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (s *InstrumentationScope) marshal(e *protoEncoder) {
+	e.string(1, s.Name)
+	e.string(2, s.Version)
+	e.keyValues(3, s.Attributes)
+}
+
+func (s *InstrumentationScope) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			return d.string(wire, &s.Name)
+		case 2:
+			return d.string(wire, &s.Version)
+		case 3:
+			return d.keyValue(wire, &s.Attributes)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (s *Span) marshal(e *protoEncoder) {
+	e.bytes(1, s.TraceID)
+	e.bytes(2, s.SpanID)
+	e.string(3, s.TraceState)
+	e.bytes(4, s.ParentSpanID)
+	e.string(5, s.Name)
+	e.varint(6, uint64(s.Kind))
+	e.fixed64(7, uint64(s.StartTimeUnixNano))
+	e.fixed64(8, uint64(s.EndTimeUnixNano))
+	e.keyValues(9, s.Attributes)
+	for i := range s.Events {
+		e.message(11, s.Events[i].marshal)
+	}
+	if s.Status != nil {
+		e.message(15, s.Status.marshal)
+	}
+}
+
+func (s *Span) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			return d.bytes(wire, (*[]byte)(&s.TraceID))
+		case 2:
+			return d.bytes(wire, (*[]byte)(&s.SpanID))
+		case 3:
+			return d.string(wire, &s.TraceState)
+		case 4:
+			return d.bytes(wire, (*[]byte)(&s.ParentSpanID))
+		case 5:
+			return d.string(wire, &s.Name)
+		case 6:
+			v, err := d.varint(wire)
+			s.Kind = SpanKind(v)
+			return err
+		case 7:
+			v, err := d.fixed64(wire)
+			s.StartTimeUnixNano = Uint64(v)
+			return err
+		case 8:
+			v, err := d.fixed64(wire)
+			s.EndTimeUnixNano = Uint64(v)
+			return err
+		case 9:
+			return d.keyValue(wire, &s.Attributes)
+		case 11:
+			var e Event
+			if err := d.message(wire, e.unmarshal); err != nil {
+				return err
+			}
+			s.Events = append(s.Events, e)
+			return nil
+		case 15:
+			s.Status = new(Status)
+			return d.message(wire, s.Status.unmarshal)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (e *Event) marshal(enc *protoEncoder) {
+	enc.fixed64(1, uint64(e.TimeUnixNano))
+	enc.string(2, e.Name)
+	enc.keyValues(3, e.Attributes)
+}
+
+func (e *Event) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			v, err := d.fixed64(wire)
+			e.TimeUnixNano = Uint64(v)
+			return err
+		case 2:
+			return d.string(wire, &e.Name)
+		case 3:
+			return d.keyValue(wire, &e.Attributes)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (s *Status) marshal(e *protoEncoder) {
+	e.string(2, s.Message)
+	e.varint(3, uint64(s.Code))
+}
+
+func (s *Status) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 2:
+			return d.string(wire, &s.Message)
+		case 3:
+			v, err := d.varint(wire)
+			s.Code = StatusCode(v)
+			return err
+		}
+		return d.skip(wire)
+	})
+}
+
+func (kv *KeyValue) marshal(e *protoEncoder) {
+	e.string(1, kv.Key)
+	e.message(2, kv.Value.marshal)
+}
+
+func (kv *KeyValue) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			return d.string(wire, &kv.Key)
+		case 2:
+			return d.message(wire, kv.Value.unmarshal)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (v *AnyValue) marshal(e *protoEncoder) {
+	// Unlike other fields, the fields of a oneof are encoded even if they
+	// have their default value.
+	switch {
+	case v.StringValue != nil:
+		e.key(1, wireBytes)
+		e.uvarint(uint64(len(*v.StringValue)))
+		e.b = append(e.b, *v.StringValue...)
+	case v.BoolValue != nil:
+		var b uint64
+		if *v.BoolValue {
+			b = 1
+		}
+		e.key(2, wireVarint)
+		e.uvarint(b)
+	case v.IntValue != nil:
+		e.key(3, wireVarint)
+		e.uvarint(uint64(*v.IntValue))
+	case v.DoubleValue != nil:
+		e.key(4, wireFixed64)
+		e.b = binaryAppendUint64(e.b, math.Float64bits(*v.DoubleValue))
+	case v.ArrayValue != nil:
+		e.message(5, v.ArrayValue.marshal)
+	case v.KvlistValue != nil:
+		e.message(6, v.KvlistValue.marshal)
+	case v.BytesValue != nil:
+		e.key(7, wireBytes)
+		e.uvarint(uint64(len(v.BytesValue)))
+		e.b = append(e.b, v.BytesValue...)
+	}
+}
+
+func (v *AnyValue) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		if num >= 1 && num <= 7 {
+			*v = AnyValue{} // only the last field of a oneof is kept
+		}
+		switch num {
+		case 1:
+			v.StringValue = new(string)
+			return d.string(wire, v.StringValue)
+		case 2:
+			x, err := d.varint(wire)
+			b := x != 0
+			v.BoolValue = &b
+			return err
+		case 3:
+			x, err := d.varint(wire)
+			i := Int64(x)
+			v.IntValue = &i
+			return err
+		case 4:
+			x, err := d.fixed64(wire)
+			f := math.Float64frombits(x)
+			v.DoubleValue = &f
+			return err
+		case 5:
+			v.ArrayValue = new(ArrayValue)
+			return d.message(wire, v.ArrayValue.unmarshal)
+		case 6:
+			v.KvlistValue = new(KeyValueList)
+			return d.message(wire, v.KvlistValue.unmarshal)
+		case 7:
+			v.BytesValue = []byte{}
+			return d.bytes(wire, &v.BytesValue)
+		}
+		return d.skip(wire)
+	})
+}
+
+func (a *ArrayValue) marshal(e *protoEncoder) {
+	for i := range a.Values {
+		e.message(1, a.Values[i].marshal)
+	}
+}
+
+func (a *ArrayValue) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			var v AnyValue
+			if err := d.message(wire, v.unmarshal); err != nil {
+				return err
+			}
+			a.Values = append(a.Values, v)
+			return nil
+		}
+		return d.skip(wire)
+	})
+}
+
+func (l *KeyValueList) marshal(e *protoEncoder) {
+	e.keyValues(1, l.Values)
+}
+
+func (l *KeyValueList) unmarshal(data []byte) error {
+	return decodeMessage(data, func(d *protoDecoder, num, wire int) error {
+		switch num {
+		case 1:
+			return d.keyValue(wire, &l.Values)
+		}
+		return d.skip(wire)
+	})
+}
+
+// Protobuf wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("otlp: truncated protobuf message")
+
+// A protoDecoder reads the fields of a protobuf message.
+type protoDecoder struct{ b []byte }
+
+// decodeMessage calls field for each field of the protobuf message in data,
+// with the field's number and wire type. The field func must read or skip
+// the field's value.
+func decodeMessage(data []byte, field func(d *protoDecoder, num, wire int) error) error {
+	d := &protoDecoder{b: data}
+	for len(d.b) > 0 {
+		key, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+		num, wire := int(key>>3), int(key&7)
+		if num <= 0 {
+			return fmt.Errorf("otlp: invalid protobuf field number %d", num)
+		}
+		if err := field(d, num, wire); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *protoDecoder) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	d.b = d.b[n:]
+	return v, nil
+}
+
+func (d *protoDecoder) next(n uint64) ([]byte, error) {
+	if n > uint64(len(d.b)) {
+		return nil, errTruncated
+	}
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b, nil
+}
+
+func wireTypeError(want, got int) error {
+	return fmt.Errorf("otlp: got protobuf wire type %d, want %d", got, want)
+}
+
+func (d *protoDecoder) varint(wire int) (uint64, error) {
+	if wire != wireVarint {
+		return 0, wireTypeError(wireVarint, wire)
+	}
+	return d.uvarint()
+}
+
+func (d *protoDecoder) fixed64(wire int) (uint64, error) {
+	if wire != wireFixed64 {
+		return 0, wireTypeError(wireFixed64, wire)
+	}
+	b, err := d.next(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (d *protoDecoder) bytes(wire int, v *[]byte) error {
+	if wire != wireBytes {
+		return wireTypeError(wireBytes, wire)
+	}
+	n, err := d.uvarint()
+	if err != nil {
+		return err
+	}
+	b, err := d.next(n)
+	if err != nil {
+		return err
+	}
+	*v = append((*v)[:0], b...)
+	return nil
+}
+
+func (d *protoDecoder) string(wire int, v *string) error {
+	var b []byte
+	if err := d.bytes(wire, &b); err != nil {
+		return err
+	}
+	*v = string(b)
+	return nil
+}
+
+func (d *protoDecoder) message(wire int, unmarshal func([]byte) error) error {
+	if wire != wireBytes {
+		return wireTypeError(wireBytes, wire)
+	}
+	n, err := d.uvarint()
+	if err != nil {
+		return err
+	}
+	b, err := d.next(n)
+	if err != nil {
+		return err
+	}
+	return unmarshal(b)
+}
+
+func (d *protoDecoder) keyValue(wire int, v *[]KeyValue) error {
+	var kv KeyValue
+	if err := d.message(wire, kv.unmarshal); err != nil {
+		return err
+	}
+	*v = append(*v, kv)
+	return nil
+}
+
+func (d *protoDecoder) skip(wire int) error {
+	var err error
+	switch wire {
+	case wireVarint:
+		_, err = d.uvarint()
+	case wireFixed64:
+		_, err = d.next(8)
+	case wireBytes:
+		var n uint64
+		if n, err = d.uvarint(); err == nil {
+			_, err = d.next(n)
+		}
+	case wireFixed32:
+		_, err = d.next(4)
+	default:
+		err = fmt.Errorf("otlp: unsupported protobuf wire type %d", wire)
+	}
+	return err
+}
+
+// A protoEncoder appends the fields of a protobuf message to b. Fields with
+// their default value are omitted, as in proto3.
+type protoEncoder struct{ b []byte }
+
+func (e *protoEncoder) uvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	e.b = append(e.b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func (e *protoEncoder) key(num, wire int) {
+	e.uvarint(uint64(num)<<3 | uint64(wire))
+}
+
+func (e *protoEncoder) varint(num int, v uint64) {
+	if v != 0 {
+		e.key(num, wireVarint)
+		e.uvarint(v)
+	}
+}
+
+func (e *protoEncoder) fixed64(num int, v uint64) {
+	if v != 0 {
+		e.key(num, wireFixed64)
+		e.b = binaryAppendUint64(e.b, v)
+	}
+}
+
+func binaryAppendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func (e *protoEncoder) bytes(num int, v []byte) {
+	if len(v) > 0 {
+		e.key(num, wireBytes)
+		e.uvarint(uint64(len(v)))
+		e.b = append(e.b, v...)
+	}
+}
+
+func (e *protoEncoder) string(num int, v string) {
+	if v != "" {
+		e.key(num, wireBytes)
+		e.uvarint(uint64(len(v)))
+		e.b = append(e.b, v...)
+	}
+}
+
+// message encodes an embedded message, whose fields are encoded by marshal.
+// Unlike other fields, it is encoded even if it is empty.
+func (e *protoEncoder) message(num int, marshal func(*protoEncoder)) {
+	var m protoEncoder
+	marshal(&m)
+	e.key(num, wireBytes)
+	e.uvarint(uint64(len(m.b)))
+	e.b = append(e.b, m.b...)
+}
+
+func (e *protoEncoder) keyValues(num int, kvs []KeyValue) {
+	for i := range kvs {
+		e.message(num, kvs[i].marshal)
+	}
+}
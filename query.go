@@ -0,0 +1,232 @@
+package appdash
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// A TraceSortKey specifies the order in which a Queryer returns traces.
+type TraceSortKey int
+
+const (
+	// SortByStartTime sorts traces by the start time of their root span's
+	// timespan. Traces without a timespan sort before all others.
+	SortByStartTime TraceSortKey = iota
+
+	// SortByDuration sorts traces by the duration of their root span's
+	// timespan.
+	SortByDuration
+
+	// SortByName sorts traces by the name of their root span.
+	SortByName
+)
+
+// String returns the name of the sort key, as accepted by ParseTraceSortKey.
+func (k TraceSortKey) String() string {
+	switch k {
+	case SortByStartTime:
+		return "start"
+	case SortByDuration:
+		return "duration"
+	case SortByName:
+		return "name"
+	default:
+		return fmt.Sprintf("TraceSortKey(%d)", int(k))
+	}
+}
+
+// ParseTraceSortKey parses a sort key name:
+//
+//	"start" -> SortByStartTime
+//	"duration" -> SortByDuration
+//	"name" -> SortByName
+func ParseTraceSortKey(s string) (TraceSortKey, error) {
+	switch s {
+	case "start":
+		return SortByStartTime, nil
+	case "duration":
+		return SortByDuration, nil
+	case "name":
+		return SortByName, nil
+	default:
+		return 0, fmt.Errorf("unknown trace sort key: %q", s)
+	}
+}
+
+// queriedTrace is a trace along with the information needed to filter and
+// sort it according to a TracesOpts.
+type queriedTrace struct {
+	id         ID
+	name       string
+	start, end time.Time
+	hasTime    bool
+
+	// trace is the trace itself, or nil if it has not been loaded yet (see
+	// tracesQuery.run).
+	trace *Trace
+}
+
+// newQueriedTrace returns the queriedTrace for t, based on its root span.
+func newQueriedTrace(t *Trace) (*queriedTrace, error) {
+	var events []Event
+	if err := UnmarshalEvents(t.Span.Annotations, &events); err != nil {
+		return nil, err
+	}
+	qt := &queriedTrace{id: t.Span.ID.Trace, name: t.Span.Name(), trace: t}
+	qt.start, qt.end, qt.hasTime = findTraceTimes(events)
+	return qt, nil
+}
+
+// tracesQuery is a TracesOpts that has been validated and prepared for
+// filtering traces.
+type tracesQuery struct {
+	opts  TracesOpts
+	ids   map[ID]struct{} // nil if not filtering by ID
+	query *Query          // nil if not filtering by query
+}
+
+// newTracesQuery returns a tracesQuery for opts. An error is returned if
+// opts.Query is not a valid query.
+func newTracesQuery(opts TracesOpts) (*tracesQuery, error) {
+	q := &tracesQuery{opts: opts}
+	if len(opts.TraceIDs) > 0 {
+		q.ids = make(map[ID]struct{}, len(opts.TraceIDs))
+		for _, id := range opts.TraceIDs {
+			q.ids[id] = struct{}{}
+		}
+	}
+	if opts.Query != "" {
+		var err error
+		q.query, err = ParseQuery(opts.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// include reports whether qt passes the trace ID and timespan filters. These
+// only need the summary information in qt, not the trace itself.
+func (q *tracesQuery) include(qt *queriedTrace) bool {
+	if q.ids != nil {
+		if _, ok := q.ids[qt.id]; !ok {
+			return false
+		}
+	}
+	ts := q.opts.Timespan
+	if !ts.S.IsZero() || !ts.E.IsZero() {
+		if !qt.hasTime {
+			return false
+		}
+		if !ts.E.IsZero() && qt.start.After(ts.E) {
+			return false
+		}
+		if !ts.S.IsZero() && qt.end.Before(ts.S) {
+			return false
+		}
+	}
+	return true
+}
+
+// run sorts qts, filters them by the query and paginates the result. The
+// traces in qts must already have been filtered using include.
+//
+// Traces that have not been loaded yet are loaded using load, which is only
+// called for the traces that are needed to evaluate the query or that are
+// returned. If load returns ErrTraceNotFound, the trace is skipped.
+func (q *tracesQuery) run(qts []*queriedTrace, load func(ID) (*Trace, error)) ([]*Trace, error) {
+	var s sort.Interface = queriedTraces{key: q.opts.Sort, traces: qts}
+	if q.opts.Desc {
+		s = sort.Reverse(s)
+	}
+	sort.Sort(s)
+
+	skip := q.opts.Offset
+	result := []*Trace{}
+	for _, qt := range qts {
+		if q.opts.Limit > 0 && len(result) == q.opts.Limit {
+			break
+		}
+		if q.query == nil && skip > 0 {
+			// Skip without loading the trace.
+			skip--
+			continue
+		}
+
+		t := qt.trace
+		if t == nil {
+			var err error
+			t, err = load(qt.id)
+			if err == ErrTraceNotFound {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if q.query != nil {
+			ok, err := q.query.Match(t)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+		}
+		result = append(result, t)
+	}
+	return result, nil
+}
+
+// applyTracesOpts filters, sorts and paginates the given traces according to
+// opts. It is used by Queryer implementations once they have gathered their
+// candidate traces.
+func applyTracesOpts(traces []*Trace, opts TracesOpts) ([]*Trace, error) {
+	q, err := newTracesQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+	qts := make([]*queriedTrace, 0, len(traces))
+	for _, t := range traces {
+		qt, err := newQueriedTrace(t)
+		if err != nil {
+			return nil, err
+		}
+		if q.include(qt) {
+			qts = append(qts, qt)
+		}
+	}
+	return q.run(qts, nil)
+}
+
+// queriedTraces sorts traces by the given key. Ties are broken by trace ID so
+// that the order (and thus pagination) is stable.
+type queriedTraces struct {
+	key    TraceSortKey
+	traces []*queriedTrace
+}
+
+func (q queriedTraces) Len() int      { return len(q.traces) }
+func (q queriedTraces) Swap(i, j int) { q.traces[i], q.traces[j] = q.traces[j], q.traces[i] }
+func (q queriedTraces) Less(i, j int) bool {
+	a, b := q.traces[i], q.traces[j]
+	switch q.key {
+	case SortByDuration:
+		if da, db := a.end.Sub(a.start), b.end.Sub(b.start); da != db {
+			return da < db
+		}
+	case SortByName:
+		if a.name != b.name {
+			return a.name < b.name
+		}
+	default:
+		if !a.start.Equal(b.start) {
+			return a.start.Before(b.start)
+		}
+	}
+	return a.id < b.id
+}
@@ -0,0 +1,82 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Traces_opts(t *testing.T) {
+	ms := NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// collect records a root span named name, starting at base+offset and
+	// lasting d.
+	collect := func(id ID, name string, offset, d time.Duration) {
+		rec := NewRecorder(SpanID{Trace: id, Span: id}, ms)
+		rec.Name(name)
+		rec.Event(Timespan{S: base.Add(offset), E: base.Add(offset + d)})
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+	collect(1, "c", 1*time.Minute, 3*time.Second)
+	collect(2, "a", 2*time.Minute, 1*time.Second)
+	collect(3, "b", 3*time.Minute, 2*time.Second)
+	collect(4, "d", 4*time.Minute, 4*time.Second)
+
+	tests := []struct {
+		opts TracesOpts
+		want []ID
+	}{
+		{opts: TracesOpts{}, want: []ID{1, 2, 3, 4}},
+		{opts: TracesOpts{Desc: true}, want: []ID{4, 3, 2, 1}},
+		{opts: TracesOpts{Sort: SortByDuration}, want: []ID{2, 3, 1, 4}},
+		{opts: TracesOpts{Sort: SortByName, Desc: true}, want: []ID{4, 1, 3, 2}},
+		{opts: TracesOpts{Limit: 2}, want: []ID{1, 2}},
+		{opts: TracesOpts{Offset: 1, Limit: 2}, want: []ID{2, 3}},
+		{opts: TracesOpts{Offset: 10}, want: []ID{}},
+		{opts: TracesOpts{TraceIDs: []ID{3, 1, 3, 42}}, want: []ID{1, 3}},
+		{
+			opts: TracesOpts{Timespan: Timespan{S: base.Add(2 * time.Minute), E: base.Add(3 * time.Minute)}},
+			want: []ID{2, 3},
+		},
+		{
+			opts: TracesOpts{Timespan: Timespan{S: base.Add(3*time.Minute + time.Second)}},
+			want: []ID{3, 4},
+		},
+		{
+			opts: TracesOpts{Timespan: Timespan{E: base.Add(time.Minute + time.Second)}},
+			want: []ID{1},
+		},
+	}
+	for _, test := range tests {
+		traces, err := ms.Traces(test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []ID{}
+		for _, tr := range traces {
+			got = append(got, tr.Span.ID.Trace)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Traces(%+v): got traces %v, want %v", test.opts, got, test.want)
+		}
+	}
+}
+
+func TestParseTraceSortKey(t *testing.T) {
+	for _, k := range []TraceSortKey{SortByStartTime, SortByDuration, SortByName} {
+		got, err := ParseTraceSortKey(k.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != k {
+			t.Errorf("ParseTraceSortKey(%q): got %v, want %v", k.String(), got, k)
+		}
+	}
+	if _, err := ParseTraceSortKey("bogus"); err == nil {
+		t.Error("ParseTraceSortKey(\"bogus\"): expected error")
+	}
+}
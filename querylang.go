@@ -0,0 +1,493 @@
+package appdash
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Query is a parsed trace query expression, which can be used to search for
+// traces. Queries are created using ParseQuery, and are most commonly used via
+// the TracesOpts.Query field.
+//
+// A query is a set of terms, each comparing a field with a value:
+//
+//	name:"Serve /api/*" AND Server.Response.StatusCode>=500 AND duration>200ms
+//
+// Terms may be combined using AND, OR and NOT (in order of increasing
+// precedence) and grouped using parentheses. Terms separated by whitespace
+// alone are implicitly combined using AND.
+//
+// The following fields are supported:
+//
+//	name      the span's name (i.e. the "Name" annotation)
+//	duration  the duration of the span's timespan events, e.g. 200ms or 1.5s
+//	<key>     the value of any annotation with the given key
+//
+// And the following operators:
+//
+//	:   glob match, where * matches any sequence of characters and ? matches a
+//	    single character
+//	=   equal
+//	!=  not equal
+//	<, <=, >, >=  compare numerically if both sides are numbers, as durations
+//	    if both sides are durations, and lexicographically otherwise
+//
+// Values containing whitespace or any of the characters ( ) " must be quoted
+// using Go string literal syntax.
+//
+// A term matches a trace if any span in the trace matches it. For example,
+// the query above matches traces containing a span named "Serve /api/..." and
+// a span whose response status code was 500 or above, and a span that took
+// longer than 200ms.
+type Query struct {
+	src  string
+	expr queryExpr
+}
+
+// ParseQuery parses the given query expression, see Query for details.
+func ParseQuery(s string) (*Query, error) {
+	p := &queryParser{lex: &queryLexer{src: s}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != queryEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return &Query{src: s, expr: expr}, nil
+}
+
+// String returns the query expression as it was given to ParseQuery.
+func (q *Query) String() string { return q.src }
+
+// Match reports whether the given trace matches the query.
+func (q *Query) Match(t *Trace) (bool, error) {
+	return q.expr.match(t)
+}
+
+// queryExpr is a node in a parsed query expression.
+type queryExpr interface {
+	match(t *Trace) (bool, error)
+}
+
+type (
+	queryAnd struct{ x, y queryExpr }
+	queryOr  struct{ x, y queryExpr }
+	queryNot struct{ x queryExpr }
+)
+
+func (e *queryAnd) match(t *Trace) (bool, error) {
+	ok, err := e.x.match(t)
+	if err != nil || !ok {
+		return false, err
+	}
+	return e.y.match(t)
+}
+
+func (e *queryOr) match(t *Trace) (bool, error) {
+	ok, err := e.x.match(t)
+	if err != nil || ok {
+		return ok, err
+	}
+	return e.y.match(t)
+}
+
+func (e *queryNot) match(t *Trace) (bool, error) {
+	ok, err := e.x.match(t)
+	return !ok, err
+}
+
+// queryTerm is a single field-operator-value comparison.
+type queryTerm struct {
+	field, op, value string
+
+	glob     *regexp.Regexp // compiled value, for the ":" operator
+	duration time.Duration  // parsed value, for the duration field
+}
+
+// match implements the queryExpr interface by reporting whether any span in
+// t matches the term.
+func (e *queryTerm) match(t *Trace) (bool, error) {
+	ok, err := e.matchSpan(&t.Span)
+	if err != nil || ok {
+		return ok, err
+	}
+	for _, sub := range t.Sub {
+		ok, err := e.match(sub)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// matchSpan reports whether the given span matches the term.
+func (e *queryTerm) matchSpan(s *Span) (bool, error) {
+	if e.field == "duration" {
+		var events []Event
+		if err := UnmarshalEvents(s.Annotations, &events); err != nil {
+			return false, err
+		}
+		start, end, ok := findTraceTimes(events)
+		if !ok {
+			return false, nil
+		}
+		return compareQueryValues(e.op, end.Sub(start), e.duration), nil
+	}
+
+	key := e.field
+	if key == "name" {
+		key = "Name"
+	}
+	for _, a := range s.Annotations {
+		if a.Key != key {
+			continue
+		}
+		if e.matchValue(string(a.Value)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchValue reports whether the given annotation value matches the term.
+func (e *queryTerm) matchValue(v string) bool {
+	if e.op == ":" {
+		return e.glob.MatchString(v)
+	}
+	if a, err := strconv.ParseFloat(v, 64); err == nil {
+		if b, err := strconv.ParseFloat(e.value, 64); err == nil {
+			return compareQueryValues(e.op, a, b)
+		}
+	}
+	if a, err := time.ParseDuration(v); err == nil {
+		if b, err := time.ParseDuration(e.value); err == nil {
+			return compareQueryValues(e.op, a, b)
+		}
+	}
+	return compareQueryValues(e.op, v, e.value)
+}
+
+// compareQueryValues compares a and b (which must be of the same type: either
+// float64, time.Duration or string) using the given operator.
+func compareQueryValues(op string, a, b interface{}) bool {
+	var c int
+	switch a := a.(type) {
+	case float64:
+		c = compareFloats(a, b.(float64))
+	case time.Duration:
+		c = compareFloats(float64(a), float64(b.(time.Duration)))
+	case string:
+		c = strings.Compare(a, b.(string))
+	}
+	switch op {
+	case ":", "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// globToRegexp compiles a glob pattern, where * matches any sequence of
+// characters and ? matches any single character, into a regexp.
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var buf strings.Builder
+	buf.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			buf.WriteString(".*")
+		case '?':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	buf.WriteString("$")
+	return regexp.Compile(buf.String())
+}
+
+// QueryError is returned by ParseQuery when a query expression is invalid.
+type QueryError struct {
+	Query string // the query expression
+	Pos   int    // byte offset of the error in Query
+	Msg   string // description of the error
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("query: %s at offset %d in %q", e.Msg, e.Pos, e.Query)
+}
+
+type queryTokenKind int
+
+const (
+	queryEOF queryTokenKind = iota
+	queryWord
+	queryString
+	queryOp
+	queryLParen
+	queryRParen
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string // the token's value (unquoted, for strings)
+	pos  int
+}
+
+func (t queryToken) String() string {
+	switch t.kind {
+	case queryEOF:
+		return "end of query"
+	case queryString:
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// queryLexer splits a query expression into tokens.
+type queryLexer struct {
+	src string
+	pos int
+}
+
+// isQueryOpChar reports whether r may be part of an operator.
+func isQueryOpChar(r byte) bool {
+	return r == ':' || r == '=' || r == '!' || r == '<' || r == '>'
+}
+
+// isSpaceAt reports whether the rune at l.pos is whitespace, and returns its
+// width in bytes.
+func (l *queryLexer) isSpaceAt() (bool, int) {
+	r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+	return unicode.IsSpace(r), size
+}
+
+// next returns the next token. If afterOp is true, the token is lexed as a
+// value (which may contain operator characters) rather than as a field.
+func (l *queryLexer) next(afterOp bool) (queryToken, error) {
+	for l.pos < len(l.src) {
+		space, size := l.isSpaceAt()
+		if !space {
+			break
+		}
+		l.pos += size
+	}
+	start := l.pos
+	if l.pos == len(l.src) {
+		return queryToken{kind: queryEOF, pos: start}, nil
+	}
+
+	switch c := l.src[l.pos]; {
+	case c == '(':
+		l.pos++
+		return queryToken{kind: queryLParen, text: "(", pos: start}, nil
+	case c == ')':
+		l.pos++
+		return queryToken{kind: queryRParen, text: ")", pos: start}, nil
+	case c == '"':
+		// Find the closing quote, skipping escaped characters.
+		end := l.pos + 1
+		for ; end < len(l.src) && l.src[end] != '"'; end++ {
+			if l.src[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(l.src) {
+			return queryToken{}, &QueryError{Query: l.src, Pos: start, Msg: "unterminated string"}
+		}
+		s, err := strconv.Unquote(l.src[start : end+1])
+		if err != nil {
+			return queryToken{}, &QueryError{Query: l.src, Pos: start, Msg: "invalid string"}
+		}
+		l.pos = end + 1
+		return queryToken{kind: queryString, text: s, pos: start}, nil
+	case !afterOp && isQueryOpChar(c):
+		for l.pos < len(l.src) && isQueryOpChar(l.src[l.pos]) {
+			l.pos++
+		}
+		return queryToken{kind: queryOp, text: l.src[start:l.pos], pos: start}, nil
+	}
+
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == '(' || c == ')' || c == '"' || (!afterOp && isQueryOpChar(c)) {
+			break
+		}
+		space, size := l.isSpaceAt()
+		if space {
+			break
+		}
+		l.pos += size
+	}
+	return queryToken{kind: queryWord, text: l.src[start:l.pos], pos: start}, nil
+}
+
+// queryParser is a recursive descent parser for query expressions.
+type queryParser struct {
+	lex *queryLexer
+	tok queryToken
+}
+
+func (p *queryParser) next() error {
+	return p.nextValue(false)
+}
+
+func (p *queryParser) nextValue(afterOp bool) error {
+	tok, err := p.lex.next(afterOp)
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *queryParser) errorf(format string, args ...interface{}) error {
+	return &QueryError{Query: p.lex.src, Pos: p.tok.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// isKeyword reports whether the current token is the given keyword.
+func (p *queryParser) isKeyword(k string) bool {
+	return p.tok.kind == queryWord && p.tok.text == k
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("OR") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = &queryOr{x, y}
+	}
+	return x, nil
+}
+
+func (p *queryParser) parseAnd() (queryExpr, error) {
+	x, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if p.isKeyword("AND") {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		} else if p.tok.kind == queryEOF || p.tok.kind == queryRParen || p.isKeyword("OR") {
+			return x, nil
+		}
+		y, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		x = &queryAnd{x, y}
+	}
+}
+
+func (p *queryParser) parseNot() (queryExpr, error) {
+	if p.isKeyword("NOT") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNot{x}, nil
+	}
+	if p.tok.kind == queryLParen {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != queryRParen {
+			return nil, p.errorf("expected ) but found %s", p.tok)
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		return x, nil
+	}
+	return p.parseTerm()
+}
+
+func (p *queryParser) parseTerm() (queryExpr, error) {
+	if p.tok.kind != queryWord && p.tok.kind != queryString {
+		return nil, p.errorf("expected field but found %s", p.tok)
+	}
+	term := &queryTerm{field: p.tok.text}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind != queryOp {
+		return nil, p.errorf("expected operator after field %q but found %s", term.field, p.tok)
+	}
+	switch p.tok.text {
+	case ":", "=", "!=", "<", "<=", ">", ">=":
+		term.op = p.tok.text
+	default:
+		return nil, p.errorf("unknown operator %q", p.tok.text)
+	}
+	if err := p.nextValue(true); err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind != queryWord && p.tok.kind != queryString {
+		return nil, p.errorf("expected value after %s%s but found %s", term.field, term.op, p.tok)
+	}
+	term.value = p.tok.text
+	if term.field == "duration" {
+		d, err := time.ParseDuration(term.value)
+		if err != nil {
+			return nil, p.errorf("invalid duration %q", term.value)
+		}
+		term.duration = d
+	} else if term.op == ":" {
+		glob, err := globToRegexp(term.value)
+		if err != nil {
+			return nil, p.errorf("invalid pattern %q", term.value)
+		}
+		term.glob = glob
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	return term, nil
+}
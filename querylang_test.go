@@ -0,0 +1,116 @@
+package appdash
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseQuery_errors(t *testing.T) {
+	tests := []string{
+		`name`,
+		`name:`,
+		`name~foo`,
+		`:foo`,
+		`name:"foo`,
+		`(name:foo`,
+		`name:foo)`,
+		`name:foo AND`,
+		`duration>fast`,
+	}
+	for _, q := range tests {
+		if _, err := ParseQuery(q); err == nil {
+			t.Errorf("ParseQuery(%q): expected error", q)
+		} else if _, ok := err.(*QueryError); !ok {
+			t.Errorf("ParseQuery(%q): got error %T, want *QueryError", q, err)
+		}
+	}
+}
+
+func TestQuery_Match(t *testing.T) {
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	span := func(id SpanID, d time.Duration, anns ...Annotation) Span {
+		ts, err := MarshalEvent(Timespan{S: start, E: start.Add(d)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Span{ID: id, Annotations: append(anns, ts...)}
+	}
+	trace := &Trace{
+		Span: span(SpanID{1, 1, 0}, 300*time.Millisecond,
+			Annotation{Key: "Name", Value: []byte("Serve /api/users")},
+			Annotation{Key: "Server.Response.StatusCode", Value: []byte("502")},
+		),
+		Sub: []*Trace{
+			{Span: span(SpanID{1, 2, 1}, 50*time.Millisecond,
+				Annotation{Key: "Name", Value: []byte("SQL")},
+				Annotation{Key: "Tag", Value: []byte("slow query")},
+			)},
+			{Span: span(SpanID{1, 3, 1}, 10*time.Millisecond,
+				Annotation{Key: "Name", Value: []byte("voilà")},
+			)},
+		},
+	}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{`name:"Serve /api/*"`, true},
+		{`name:"Serve /app/*"`, false},
+		{`name:SQL`, true},
+		{`name:S?L`, true},
+		{`name=Serve`, false},
+		{`Server.Response.StatusCode>=500`, true},
+		{`Server.Response.StatusCode<500`, false},
+		{`Server.Response.StatusCode!=200`, true},
+		{`duration>200ms`, true},
+		{`duration>1s`, false},
+		{`duration<100ms`, true},
+		{`Tag:"slow query"`, true},
+		{`name:"Serve /api/*" AND Server.Response.StatusCode>=500 AND duration>200ms`, true},
+		{`name:"Serve /api/*" Server.Response.StatusCode>=500`, true},
+		{`name:foo OR name:SQL`, true},
+		{`name:foo OR name:bar`, false},
+		{`NOT name:SQL`, false},
+		{`NOT (name:foo OR name:bar)`, true},
+		{`name:SQL AND (duration>1s OR Tag:slow*)`, true},
+		{`Missing.Key:*`, false},
+		{`name:voilà`, true},
+		{`name:voil`, false},
+		{`name:voilà AND name:SQL`, true},
+	}
+	for _, test := range tests {
+		q, err := ParseQuery(test.query)
+		if err != nil {
+			t.Errorf("ParseQuery(%q): %s", test.query, err)
+			continue
+		}
+		got, err := q.Match(trace)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("query %q: got match %v, want %v", test.query, got, test.want)
+		}
+	}
+}
+
+func TestMemoryStore_Traces_query(t *testing.T) {
+	ms := NewMemoryStore()
+	for i := ID(1); i <= 3; i++ {
+		rec := NewRecorder(SpanID{Trace: i, Span: i}, ms)
+		rec.Name("trace " + i.String())
+		rec.Finish()
+	}
+	traces, err := ms.Traces(TracesOpts{Query: `name:"trace *2"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traces) != 1 || traces[0].Span.ID.Trace != 2 {
+		t.Errorf("got traces %v, want just trace 2", traces)
+	}
+
+	if _, err := ms.Traces(TracesOpts{Query: `name:`}); err == nil {
+		t.Error("expected error for invalid query")
+	}
+}
@@ -0,0 +1,423 @@
+package appdash
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultIdleTimeout is the default SamplingStore.IdleTimeout.
+const defaultIdleTimeout = 10 * time.Second
+
+// decisionTTLFactor is how many times IdleTimeout a SamplingStore remembers
+// whether it kept or dropped a trace, so that spans arriving late are handled
+// the same way as the rest of the trace.
+const decisionTTLFactor = 6
+
+// statusCodeKeys are the annotation keys that hold HTTP response status codes,
+// as recorded by the httptrace package (Server/ClientEvent) and by OpenTracing
+// instrumentation.
+var statusCodeKeys = []string{
+	"Server.Response.StatusCode",
+	"Client.Response.StatusCode",
+	"http.status_code",
+}
+
+// A SamplingStore wraps another store and performs tail-based sampling: it
+// buffers each trace until the trace looks complete or goes idle, and then
+// decides whether to keep it (by passing its spans on to the underlying
+// store) or to drop it. Unlike head-based sampling, the decision can take the
+// whole trace into account, so all traffic can be traced while only the
+// interesting traces are stored.
+//
+// A trace looks complete when its root span has been collected along with a
+// timespan event (i.e., the root span has finished) and the parent of every
+// other span has been collected. A trace goes idle when none of its spans have
+// been collected for IdleTimeout.
+//
+// A trace is kept if it matches any of the rules given by the KeepErrors,
+// MinDuration and Names fields, or otherwise at random with a probability of
+// BaselinePercent. Spans collected after the decision has been made are kept
+// or dropped along with the rest of their trace.
+//
+// Buffered traces are not visible in the underlying store. Stop (or Flush)
+// should be called before exiting so that they are not lost.
+type SamplingStore struct {
+	// DeleteStore is the underlying store that kept traces are saved to.
+	DeleteStore
+
+	// IdleTimeout is the time after the last span of a trace was collected
+	// that the trace is decided upon, if it doesn't look complete before
+	// then.
+	//
+	// Default IdleTimeout = 10 * time.Second (10s).
+	IdleTimeout time.Duration
+
+	// KeepErrors is whether to keep traces that contain an error: a span
+	// with an HTTP response status code of 500 or higher (as recorded in a
+	// httptrace.ServerEvent or httptrace.ClientEvent), a client request that
+	// failed without a response (recorded by httptrace as a status code of
+	// -1), or an "error" annotation of "true" (as recorded by OpenTracing).
+	KeepErrors bool
+
+	// MinDuration, if non-zero, keeps traces that last at least this long.
+	MinDuration time.Duration
+
+	// Names, if non-nil, keeps traces that have a span whose name matches.
+	Names *regexp.Regexp
+
+	// BaselinePercent is the percentage (0 to 100) of the traces not kept
+	// by any of the rules above that are kept at random.
+	BaselinePercent float64
+
+	// Debug is whether to log debug messages.
+	Debug bool
+
+	mu       sync.Mutex
+	pending  *MemoryStore            // buffered traces
+	buffered map[ID]*samplingTrace   // trace ID -> state of buffered trace
+	decided  map[ID]samplingDecision // trace ID -> decision, for late spans
+
+	started, stopped bool
+	stopChan         chan struct{}
+}
+
+// samplingTrace is the state of a trace buffered by a SamplingStore.
+type samplingTrace struct {
+	lastSeen time.Time
+	rootDone bool // the root span has been collected along with a timespan
+
+	// spans are the IDs of the collected spans, and missing are the IDs of
+	// the parents of collected spans that haven't been collected themselves.
+	// They are updated as each span is collected, so that checking whether
+	// the trace looks complete doesn't require walking the whole trace.
+	spans, missing map[ID]struct{}
+}
+
+// add records the collection of the span id.
+func (st *samplingTrace) add(id SpanID) {
+	if _, seen := st.spans[id.Span]; seen {
+		return
+	}
+	st.spans[id.Span] = struct{}{}
+	delete(st.missing, id.Span)
+	if id.Parent != 0 {
+		if _, seen := st.spans[id.Parent]; !seen {
+			st.missing[id.Parent] = struct{}{}
+		}
+	}
+}
+
+// samplingDecision is whether a SamplingStore kept a trace.
+type samplingDecision struct {
+	keep     bool
+	lastSeen time.Time
+}
+
+// Collect buffers the span until its trace is decided upon. If the trace has
+// already been decided upon, the span is either passed on to the underlying
+// store or dropped.
+//
+// Spans and kept traces are passed on to the underlying store without holding
+// the SamplingStore's lock, so a slow underlying store doesn't block the
+// buffering of other spans.
+func (ss *SamplingStore) Collect(id SpanID, anns ...Annotation) error {
+	ss.mu.Lock()
+	forward, kept, err := ss.collect(id, anns, time.Now())
+	ss.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if forward {
+		if err := ss.DeleteStore.Collect(id, anns...); err != nil {
+			return err
+		}
+	}
+	return collectTraces(ss.DeleteStore, kept)
+}
+
+// collect buffers the span, or handles it according to the decision made for
+// its trace (see Collect). It reports whether the span must be passed on to
+// the underlying store, and returns the traces that were kept as a result of
+// collecting it, which must be passed on too. The ss.mu lock must be held
+// while calling collect.
+func (ss *SamplingStore) collect(id SpanID, anns []Annotation, now time.Time) (forward bool, kept []*Trace, err error) {
+	if ss.stopped {
+		return true, nil, nil
+	}
+	if !ss.started {
+		ss.start()
+	}
+
+	if d, ok := ss.decided[id.Trace]; ok {
+		d.lastSeen = now
+		ss.decided[id.Trace] = d
+		return d.keep, nil, nil
+	}
+
+	if err := ss.pending.Collect(id, anns...); err != nil {
+		return false, nil, err
+	}
+	st, ok := ss.buffered[id.Trace]
+	if !ok {
+		st = &samplingTrace{spans: map[ID]struct{}{}, missing: map[ID]struct{}{}}
+		ss.buffered[id.Trace] = st
+	}
+	st.lastSeen = now
+	st.add(id)
+	if id.IsRoot() && !st.rootDone {
+		var events []Event
+		if err := UnmarshalEvents(anns, &events); err != nil {
+			return false, nil, err
+		}
+		_, _, st.rootDone = findTraceTimes(events)
+	}
+	if !st.rootDone || len(st.missing) > 0 {
+		return false, nil, nil
+	}
+
+	t, err := ss.decide(id.Trace, now)
+	if err != nil || t == nil {
+		return false, nil, err
+	}
+	return false, []*Trace{t}, nil
+}
+
+// decide decides whether to keep the buffered trace and removes it from the
+// buffer. It returns the trace if it is kept, so that it can be passed on to
+// the underlying store after the ss.mu lock is released. The ss.mu lock must
+// be held while calling decide.
+func (ss *SamplingStore) decide(id ID, now time.Time) (*Trace, error) {
+	t, err := ss.pending.Trace(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ss.pending.Delete(id); err != nil {
+		return nil, err
+	}
+	delete(ss.buffered, id)
+
+	keep, err := ss.keep(t)
+	if err != nil {
+		return nil, err
+	}
+	ss.decided[id] = samplingDecision{keep: keep, lastSeen: now}
+	if ss.Debug {
+		log.Printf("SamplingStore: trace %v: keep=%v", id, keep)
+	}
+	if !keep {
+		return nil, nil
+	}
+	return t, nil
+}
+
+// collectTraces collects all of the spans in the traces using c.
+func collectTraces(c Collector, traces []*Trace) error {
+	for _, t := range traces {
+		if err := collectTrace(c, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectTrace collects all of the spans in t using c.
+func collectTrace(c Collector, t *Trace) error {
+	if err := c.Collect(t.Span.ID, t.Span.Annotations...); err != nil {
+		return err
+	}
+	for _, sub := range t.Sub {
+		if err := collectTrace(c, sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keep reports whether the trace t should be kept according to the rules.
+func (ss *SamplingStore) keep(t *Trace) (bool, error) {
+	if ss.KeepErrors && traceHasError(t) {
+		return true, nil
+	}
+	if ss.MinDuration != 0 {
+		start, end, ok, err := traceTimes(t)
+		if err != nil {
+			return false, err
+		}
+		if ok && end.Sub(start) >= ss.MinDuration {
+			return true, nil
+		}
+	}
+	if ss.Names != nil && traceHasName(t, ss.Names) {
+		return true, nil
+	}
+	return ss.BaselinePercent > 0 && rand.Float64()*100 < ss.BaselinePercent, nil
+}
+
+// traceHasError reports whether any span in t records an error (see
+// SamplingStore.KeepErrors).
+func traceHasError(t *Trace) bool {
+	for _, a := range t.Span.Annotations {
+		if a.Key == "error" && string(a.Value) == "true" {
+			return true
+		}
+		for _, k := range statusCodeKeys {
+			if a.Key != k {
+				continue
+			}
+			if code, err := strconv.Atoi(string(a.Value)); err == nil && (code >= 500 || code < 0) {
+				return true
+			}
+		}
+	}
+	for _, sub := range t.Sub {
+		if traceHasError(sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// traceHasName reports whether the name of any span in t matches re.
+func traceHasName(t *Trace, re *regexp.Regexp) bool {
+	if name := t.Span.Name(); name != "" && re.MatchString(name) {
+		return true
+	}
+	for _, sub := range t.Sub {
+		if traceHasName(sub, re) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delete deletes the traces from the buffer and from the underlying store.
+func (ss *SamplingStore) Delete(traces ...ID) error {
+	ss.mu.Lock()
+	if ss.pending != nil {
+		if err := ss.pending.Delete(traces...); err != nil {
+			ss.mu.Unlock()
+			return err
+		}
+		for _, id := range traces {
+			delete(ss.buffered, id)
+			delete(ss.decided, id)
+		}
+	}
+	ss.mu.Unlock()
+	return ss.DeleteStore.Delete(traces...)
+}
+
+// Watch implements the Watcher interface by watching the underlying store, if
+// it is a Watcher. Buffered traces are not sent until they are kept.
+func (ss *SamplingStore) Watch(ctx context.Context, opts TracesOpts) <-chan *Trace {
+	return watchStore(ctx, ss.DeleteStore, opts)
+}
+
+// Stats implements the StatsStore interface by returning the statistics of
+// the underlying store, if it is a StatsStore. Buffered traces are not
+// included until they are kept. If the underlying store is not a StatsStore,
+// ErrNoStats is returned.
+func (ss *SamplingStore) Stats() (*StoreStats, error) {
+	return storeStats(ss.DeleteStore)
+}
+
+// Flush immediately decides upon all buffered traces, whether or not they
+// look complete.
+func (ss *SamplingStore) Flush() error {
+	ss.mu.Lock()
+	kept, err := ss.flush(time.Time{})
+	ss.mu.Unlock()
+	if cerr := collectTraces(ss.DeleteStore, kept); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// flush decides upon the buffered traces that have been idle since before
+// idleBefore (or all buffered traces, if idleBefore is zero), and forgets the
+// decisions that are too old to matter. It returns the kept traces, which
+// must be passed on to the underlying store (even if an error is returned).
+// The ss.mu lock must be held while calling flush.
+func (ss *SamplingStore) flush(idleBefore time.Time) ([]*Trace, error) {
+	now := time.Now()
+	var kept []*Trace
+	for id, st := range ss.buffered {
+		if idleBefore.IsZero() || st.lastSeen.Before(idleBefore) {
+			t, err := ss.decide(id, now)
+			if err != nil {
+				return kept, err
+			}
+			if t != nil {
+				kept = append(kept, t)
+			}
+		}
+	}
+	ttl := decisionTTLFactor * ss.idleTimeout()
+	for id, d := range ss.decided {
+		if now.Sub(d.lastSeen) > ttl {
+			delete(ss.decided, id)
+		}
+	}
+	return kept, nil
+}
+
+func (ss *SamplingStore) idleTimeout() time.Duration {
+	if ss.IdleTimeout == 0 {
+		return defaultIdleTimeout
+	}
+	return ss.IdleTimeout
+}
+
+// start initializes the store and starts the goroutine that decides upon
+// idle traces. The ss.mu lock must be held while calling start.
+func (ss *SamplingStore) start() {
+	ss.pending = NewMemoryStore()
+	ss.buffered = map[ID]*samplingTrace{}
+	ss.decided = map[ID]samplingDecision{}
+	ss.stopChan = make(chan struct{})
+	ss.started = true
+
+	idle := ss.idleTimeout()
+	go func() {
+		for {
+			select {
+			case <-time.After(idle / 2):
+				ss.mu.Lock()
+				kept, err := ss.flush(time.Now().Add(-idle))
+				ss.mu.Unlock()
+				if cerr := collectTraces(ss.DeleteStore, kept); err == nil {
+					err = cerr
+				}
+				if err != nil {
+					log.Printf("SamplingStore: %s", err)
+				}
+			case <-ss.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// Stop decides upon all buffered traces (see Flush) and stops the goroutine
+// that decides upon idle traces. After stopping, spans are passed directly
+// to the underlying store.
+func (ss *SamplingStore) Stop() error {
+	ss.mu.Lock()
+	if !ss.started || ss.stopped {
+		ss.mu.Unlock()
+		return nil
+	}
+	close(ss.stopChan)
+	ss.stopped = true
+	kept, err := ss.flush(time.Time{})
+	ss.mu.Unlock()
+	if cerr := collectTraces(ss.DeleteStore, kept); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,171 @@
+package appdash
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSamplingStore(t *testing.T) {
+	ms := NewMemoryStore()
+	ss := &SamplingStore{
+		DeleteStore: ms,
+		IdleTimeout: time.Hour,
+		KeepErrors:  true,
+		MinDuration: time.Second,
+		Names:       regexp.MustCompile(`^important`),
+	}
+	defer ss.Stop()
+
+	// collect records a complete trace whose root span is named name and
+	// lasts d, with a child span that has the given annotations.
+	start := time.Now()
+	collect := func(id ID, name string, d time.Duration, anns ...Annotation) {
+		child := NewRecorder(SpanID{Trace: id, Span: id + 100, Parent: id}, ss)
+		child.Annotation(anns...)
+		child.Finish()
+		root := NewRecorder(SpanID{Trace: id, Span: id}, ss)
+		root.Name(name)
+		root.Event(Timespan{S: start, E: start.Add(d)})
+		root.Finish()
+		if errs := append(root.Errors(), child.Errors()...); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+	collect(1, "boring", time.Millisecond)
+	collect(2, "boring", time.Millisecond, Annotation{Key: "Server.Response.StatusCode", Value: []byte("503")})
+	collect(3, "boring", time.Millisecond, Annotation{Key: "error", Value: []byte("true")})
+	collect(4, "boring", 2*time.Second)
+	collect(5, "important thing", time.Millisecond)
+	collect(6, "boring", time.Millisecond, Annotation{Key: "Server.Response.StatusCode", Value: []byte("404")})
+	collect(7, "boring", time.Millisecond, Annotation{Key: "Client.Response.StatusCode", Value: []byte("-1")})
+
+	want := map[ID]bool{1: false, 2: true, 3: true, 4: true, 5: true, 6: false, 7: true}
+	for id, keep := range want {
+		_, err := ms.Trace(id)
+		if kept := err == nil; kept != keep {
+			t.Errorf("trace %v: got kept %v, want %v (err %v)", id, kept, keep, err)
+		}
+	}
+	if st, err := ss.Stats(); err != nil {
+		t.Fatal(err)
+	} else if st.Traces != 5 {
+		t.Errorf("got %d traces in stats, want 5", st.Traces)
+	}
+
+	// A late span of a kept trace is passed on, and one of a dropped trace
+	// is dropped.
+	storeT{t, ss}.MustCollect(SpanID{2, 200, 2})
+	storeT{t, ss}.MustCollect(SpanID{1, 200, 1})
+	if tr := (storeT{t, ms}).MustTrace(2); len(tr.Sub) != 2 {
+		t.Errorf("trace 2: got %d children, want 2", len(tr.Sub))
+	}
+	if _, err := ms.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("trace 1: got err %v, want ErrTraceNotFound", err)
+	}
+}
+
+func TestSamplingStore_incomplete(t *testing.T) {
+	ms := NewMemoryStore()
+	ss := &SamplingStore{DeleteStore: ms, IdleTimeout: time.Hour, BaselinePercent: 100}
+	defer ss.Stop()
+
+	// The root span has finished, but the parent of span 3 hasn't been
+	// collected yet.
+	s := storeT{t, ss}
+	s.MustCollect(SpanID{1, 3, 2})
+	rec := NewRecorder(SpanID{Trace: 1, Span: 1}, ss)
+	rec.Event(Timespan{S: time.Now(), E: time.Now()})
+	rec.Finish()
+	if _, err := ms.Trace(1); err != ErrTraceNotFound {
+		t.Fatalf("incomplete trace was passed on (err %v)", err)
+	}
+
+	s.MustCollect(SpanID{1, 2, 1})
+	if _, err := ms.Trace(1); err != nil {
+		t.Fatalf("complete trace was not passed on: %s", err)
+	}
+
+	// Incomplete traces are decided upon when flushed.
+	s.MustCollect(SpanID{2, 2, 1})
+	if err := ss.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ms.Trace(2); err != nil {
+		t.Fatalf("flushed trace was not passed on: %s", err)
+	}
+}
+
+// blockingStore is a DeleteStore whose Collect blocks until unblock is
+// closed.
+type blockingStore struct {
+	DeleteStore
+	collecting chan struct{}
+	unblock    chan struct{}
+}
+
+func (s *blockingStore) Collect(id SpanID, anns ...Annotation) error {
+	select {
+	case s.collecting <- struct{}{}:
+	default:
+	}
+	<-s.unblock
+	return s.DeleteStore.Collect(id, anns...)
+}
+
+func TestSamplingStore_slowStore(t *testing.T) {
+	bs := &blockingStore{DeleteStore: NewMemoryStore(), collecting: make(chan struct{}, 1), unblock: make(chan struct{})}
+	ss := &SamplingStore{DeleteStore: bs, IdleTimeout: time.Hour, BaselinePercent: 100}
+	defer ss.Stop()
+
+	// Passing on the kept trace 1 blocks, but spans of other traces are
+	// still buffered meanwhile.
+	done := make(chan error)
+	anns, err := MarshalEvent(Timespan{S: time.Now(), E: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { done <- ss.Collect(SpanID{Trace: 1, Span: 1}, anns...) }()
+	<-bs.collecting
+	collected := make(chan error)
+	go func() { collected <- ss.Collect(SpanID{2, 2, 1}) }()
+	select {
+	case err := <-collected:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Collect blocked while a kept trace was passed on")
+	}
+	close(bs.unblock)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSamplingStore_missingParents(t *testing.T) {
+	ms := NewMemoryStore()
+	ss := &SamplingStore{DeleteStore: ms, IdleTimeout: time.Hour, BaselinePercent: 100}
+	defer ss.Stop()
+
+	// The root span arrives first, and the spans below it arrive
+	// grandchildren first.
+	s := storeT{t, ss}
+	rec := NewRecorder(SpanID{Trace: 1, Span: 1}, ss)
+	rec.Event(Timespan{S: time.Now(), E: time.Now()})
+	s.MustCollect(SpanID{1, 4, 3})
+	s.MustCollect(SpanID{1, 5, 3})
+	s.MustCollect(SpanID{1, 3, 2})
+	rec.Finish()
+	if _, err := ms.Trace(1); err != ErrTraceNotFound {
+		t.Fatalf("incomplete trace was passed on (err %v)", err)
+	}
+	s.MustCollect(SpanID{1, 2, 1})
+	tr, err := ms.Trace(1)
+	if err != nil {
+		t.Fatalf("complete trace was not passed on: %s", err)
+	}
+	if n := len(tr.Flatten()); n != 5 {
+		t.Errorf("got %d spans, want 5", n)
+	}
+}
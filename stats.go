@@ -0,0 +1,135 @@
+package appdash
+
+import (
+	"errors"
+	"time"
+)
+
+// A StatsStore is a store that can report statistics about the traces it
+// holds, e.g. to see when it is about to reach its limits.
+type StatsStore interface {
+	Store
+
+	// Stats returns the current statistics of the store.
+	Stats() (*StoreStats, error)
+}
+
+// ErrNoStats is returned by the Stats methods of store wrappers (such as
+// RecentStore) when the underlying store is not a StatsStore.
+var ErrNoStats = errors.New("store does not report statistics")
+
+// StoreStats are statistics about the traces in a store, as returned by
+// StatsStore.Stats.
+type StoreStats struct {
+	// Traces and Spans are the number of traces and spans in the store.
+	Traces, Spans int
+
+	// AnnotationBytes is the approximate size in bytes of the span data in
+	// the store, as used by ByteLimitStore.
+	AnnotationBytes int64
+
+	// Oldest and Newest are the IDs of the traces that were first collected
+	// the longest and the shortest time ago, and OldestTime and NewestTime
+	// are when they were first collected. They are zero if the store is
+	// empty.
+	Oldest, Newest         ID
+	OldestTime, NewestTime time.Time
+
+	// DiskBytes is the size in bytes of the files that the store keeps its
+	// traces in (for a FileStore), or zero if the store is in memory.
+	DiskBytes int64
+
+	// Evicted is the number of traces that were deleted by a RecentStore,
+	// LimitStore or ByteLimitStore wrapping the store to stay within its
+	// limits.
+	Evicted int64
+
+	// Collections is the number of Collect calls made on the store, and
+	// CollectionRate is the average number of calls per second over the
+	// last minute.
+	Collections    int64
+	CollectionRate float64
+
+	// MaxTraces, MaxBytes and MaxAge are the limits of the RecentStore,
+	// LimitStore or ByteLimitStore wrapping the store, or zero if there is
+	// no such limit.
+	MaxTraces int
+	MaxBytes  int64
+	MaxAge    time.Duration
+}
+
+// storeStats calls s.Stats if s is a StatsStore. Otherwise, it returns
+// ErrNoStats.
+func storeStats(s Store) (*StoreStats, error) {
+	if ss, ok := s.(StatsStore); ok {
+		return ss.Stats()
+	}
+	return nil, ErrNoStats
+}
+
+// Stats implements the StatsStore interface.
+func (ms *MemoryStore) Stats() (*StoreStats, error) {
+	var (
+		st     StoreStats
+		now    = time.Now()
+		recent int64
+	)
+	for i := range ms.shards {
+		sh := &ms.shards[i]
+		sh.Lock()
+		for id, l := range sh.limits {
+			st.Traces++
+			st.Spans += l.spans
+			st.AnnotationBytes += l.bytes
+			if l.collected.IsZero() {
+				continue
+			}
+			if st.OldestTime.IsZero() || l.collected.Before(st.OldestTime) {
+				st.Oldest, st.OldestTime = id, l.collected
+			}
+			if l.collected.After(st.NewestTime) {
+				st.Newest, st.NewestTime = id, l.collected
+			}
+		}
+		st.Collections += sh.collections.total
+		recent += sh.collections.recent(now)
+		sh.Unlock()
+	}
+	st.CollectionRate = float64(recent) / rateWindow
+	return &st, nil
+}
+
+// rateWindow is the number of seconds over which a rateCounter counts recent
+// events.
+const rateWindow = 60
+
+// A rateCounter counts events, and how many of them occurred in the last
+// rateWindow seconds. The zero value is ready to use.
+type rateCounter struct {
+	total   int64
+	buckets [rateWindow]struct{ sec, n int64 } // events per second, by sec % rateWindow
+}
+
+// add counts an event that occurred at the given time.
+func (c *rateCounter) add(now time.Time) {
+	c.total++
+	sec := now.Unix()
+	b := &c.buckets[sec%rateWindow]
+	if b.sec != sec {
+		b.sec, b.n = sec, 0
+	}
+	b.n++
+}
+
+// recent returns the number of events that occurred in the rateWindow
+// seconds up to the given time.
+func (c *rateCounter) recent(now time.Time) int64 {
+	sec := now.Unix()
+	var n int64
+	for _, b := range c.buckets {
+		if b.sec > sec-rateWindow && b.sec <= sec {
+			n += b.n
+		}
+	}
+	return n
+}
@@ -0,0 +1,149 @@
+package appdash
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Stats(t *testing.T) {
+	ms := NewMemoryStore()
+	ls := &LimitStore{Max: 2, DeleteStore: ms}
+	collect := func(id SpanID, anns ...Annotation) {
+		if err := ls.Collect(id, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	collect(SpanID{1, 1, 0})
+	collect(SpanID{2, 2, 0}, Annotation{Key: "k", Value: []byte("v")})
+	collect(SpanID{2, 3, 2})
+	collect(SpanID{3, 4, 0}) // evicts trace 1
+
+	st, err := ls.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Traces != 2 || st.Spans != 3 {
+		t.Errorf("got %d traces and %d spans, want 2 and 3", st.Traces, st.Spans)
+	}
+	if want := 3*3*8 + int64(len("k")+len("v")); st.AnnotationBytes != want {
+		t.Errorf("got %d annotation bytes, want %d", st.AnnotationBytes, want)
+	}
+	if st.Oldest != 2 || st.Newest != 3 {
+		t.Errorf("got oldest trace %v and newest trace %v, want 2 and 3", st.Oldest, st.Newest)
+	}
+	if st.Evicted != 1 || st.MaxTraces != 2 {
+		t.Errorf("got %d evicted traces with max %d, want 1 with max 2", st.Evicted, st.MaxTraces)
+	}
+	if st.Collections != 4 {
+		t.Errorf("got %d collections, want 4", st.Collections)
+	}
+
+	if _, err := (&RecentStore{DeleteStore: struct{ DeleteStore }{ms}}).Stats(); err != ErrNoStats {
+		t.Errorf("got error %v for a store without stats, want ErrNoStats", err)
+	}
+}
+
+func TestFileStore_Stats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := storeT{t, fs}
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	timespan := func(start time.Time) Annotations {
+		anns, err := MarshalEvent(Timespan{S: start, E: start.Add(time.Second)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return anns
+	}
+	s.MustCollect(SpanID{1, 1, 0}, timespan(base.Add(time.Minute))...)
+	s.MustCollect(SpanID{2, 2, 0}, timespan(base)...)
+	s.MustCollect(SpanID{2, 2, 0}, Annotation{Key: "k", Value: []byte("v")}) // same span again
+	s.MustCollect(SpanID{2, 3, 2})
+
+	// wantStats checks the statistics that don't depend on when the traces
+	// were collected.
+	wantStats := func(st *StoreStats) {
+		if st.Traces != 2 || st.Spans != 3 {
+			t.Errorf("got %d traces and %d spans, want 2 and 3", st.Traces, st.Spans)
+		}
+		want := 2*collectionSize(timespan(base)) + 3*8 + int64(len("k")+len("v")) + 3*8
+		if st.AnnotationBytes != want {
+			t.Errorf("got %d annotation bytes, want %d", st.AnnotationBytes, want)
+		}
+		var size int64
+		files, _ := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+		for _, f := range files {
+			fi, err := os.Stat(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			size += fi.Size()
+		}
+		if size == 0 || st.DiskBytes != size {
+			t.Errorf("got %d disk bytes, want %d", st.DiskBytes, size)
+		}
+	}
+
+	st, err := fs.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats(st)
+	if st.Oldest != 1 || st.Newest != 2 {
+		t.Errorf("got oldest trace %v and newest trace %v, want 1 and 2", st.Oldest, st.Newest)
+	}
+	if st.Collections != 4 {
+		t.Errorf("got %d collections, want 4", st.Collections)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// After reopening the store, the traces' start times are used as the
+	// times they were collected.
+	fs, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+	st, err = fs.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats(st)
+	if st.Oldest != 2 || !st.OldestTime.Equal(base) || st.Newest != 1 {
+		t.Errorf("got oldest trace %v (at %s) and newest trace %v, want 2 (at %s) and 1", st.Oldest, st.OldestTime, st.Newest, base)
+	}
+	if st.Collections != 0 {
+		t.Errorf("got %d collections after reopening, want 0", st.Collections)
+	}
+}
+
+func TestRateCounter(t *testing.T) {
+	var c rateCounter
+	base := time.Unix(1000, 0)
+	c.add(base)
+	c.add(base.Add(500 * time.Millisecond))
+	c.add(base.Add(30 * time.Second))
+	if got := c.recent(base.Add(30 * time.Second)); got != 3 {
+		t.Errorf("got %d recent events, want 3", got)
+	}
+	c.add(base.Add(90 * time.Second))
+	if c.total != 4 {
+		t.Errorf("got total %d, want 4", c.total)
+	}
+	if got := c.recent(base.Add(90 * time.Second)); got != 1 {
+		t.Errorf("got %d recent events after a minute, want 1", got)
+	}
+}
@@ -1,12 +1,17 @@
 package appdash
 
 import (
+	"container/list"
+	"context"
 	"encoding/gob"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -24,15 +29,41 @@ var (
 	// ErrTraceNotFound is returned by Store.GetTrace when no trace is
 	// found with the given ID.
 	ErrTraceNotFound = errors.New("trace not found")
+
+	// ErrSpanNotFound is returned by MemoryStore.DeleteSpan when no span
+	// is found with the given ID.
+	ErrSpanNotFound = errors.New("span not found")
 )
 
 // TraceOpts bundles the options used for list of traces.
 type TracesOpts struct {
 	// Timespan specifies a time range values which can be used as input for filtering traces.
+	//
+	// Only traces whose timespan (see Trace.TimespanEvent) overlaps with the
+	// given time range are returned. A zero start or end time means that the
+	// range is unbounded on that side, and a zero Timespan disables filtering.
 	Timespan Timespan
 
 	// TraceIDs filters the returned traces to just the ones with the given IDs.
 	TraceIDs []ID
+
+	// Query, if non-empty, filters the returned traces to just the ones
+	// matching the given query expression (see Query for the syntax).
+	Query string
+
+	// Sort is the key by which the returned traces are sorted. The zero value
+	// sorts traces by their start time.
+	Sort TraceSortKey
+
+	// Desc is whether traces are sorted in descending order rather than
+	// ascending order.
+	Desc bool
+
+	// Offset is the number of traces to skip, after filtering and sorting.
+	Offset int
+
+	// Limit, if non-zero, is the maximum number of traces to return.
+	Limit int
 }
 
 // A Queryer indexes spans and makes them queryable.
@@ -77,65 +108,167 @@ type Aggregator interface {
 
 // NewMemoryStore creates a new in-memory store
 func NewMemoryStore() *MemoryStore {
-	return &MemoryStore{
-		trace: map[ID]*Trace{},
-		span:  map[ID]map[ID]*Trace{},
+	ms := &MemoryStore{}
+	for i := range ms.shards {
+		ms.shards[i].reset()
 	}
+	return ms
 }
 
 // A MemoryStore is an in-memory Store that also implements the PersistentStore
 // interface.
+//
+// To save memory, a MemoryStore only keeps one copy of each annotation key and
+// of each short annotation value that it has collected. The values of the
+// annotations in the traces it returns may therefore be shared with other
+// spans, and must not be modified.
 type MemoryStore struct {
-	trace map[ID]*Trace        // trace ID -> trace tree
-	span  map[ID]map[ID]*Trace // trace ID -> span ID -> trace (sub)tree
-
-	sync.Mutex // protects trace
+	// MaxTraceSpans, if non-zero, is the maximum number of spans that are
+	// kept for each trace. Once a trace reaches it, further spans of the
+	// trace (other than its root span) are dropped, and a synthetic child of
+	// the trace's root span named "N spans truncated" records how many were
+	// dropped. This prevents a single runaway trace from using up all of the
+	// store's memory.
+	MaxTraceSpans int
+
+	// MaxTraceBytes, if non-zero, is the approximate maximum size in bytes of
+	// the span data (see ByteLimitStore) that is kept for each trace. Spans
+	// and annotations that would exceed it are dropped, as with
+	// MaxTraceSpans.
+	MaxTraceBytes int64
+
+	// shards holds the traces, partitioned by trace ID so that spans of
+	// different traces can be collected and read concurrently.
+	shards [memoryStoreShards]memoryStoreShard
+
+	watchers traceWatchers
 
 	log bool
 }
 
+// memoryStoreShards is the number of shards of a MemoryStore.
+const memoryStoreShards = 32
+
+// A memoryStoreShard is the part of a MemoryStore that holds the traces whose
+// IDs map to it (see MemoryStore.shard).
+type memoryStoreShard struct {
+	trace  map[ID]*Trace        // trace ID -> trace tree
+	span   map[ID]map[ID]*Trace // trace ID -> span ID -> trace (sub)tree
+	limits map[ID]*traceLimits  // trace ID -> size of trace
+	intern internTable          // annotation keys and values of the shard's spans
+
+	collections rateCounter // Collect calls on the shard's traces
+
+	sync.Mutex // protects all fields
+}
+
+// reset initializes the shard's maps, discarding any traces in it.
+func (sh *memoryStoreShard) reset() {
+	sh.trace = map[ID]*Trace{}
+	sh.span = map[ID]map[ID]*Trace{}
+	sh.limits = map[ID]*traceLimits{}
+	sh.intern = internTable{}
+}
+
+// shard returns the shard that holds the trace with the given ID.
+func (ms *MemoryStore) shard(trace ID) *memoryStoreShard {
+	return &ms.shards[uint64(trace)%memoryStoreShards]
+}
+
+// Lock locks the whole store (i.e. all of its shards), blocking all other
+// operations on it until Unlock is called.
+func (ms *MemoryStore) Lock() {
+	for i := range ms.shards {
+		ms.shards[i].Lock()
+	}
+}
+
+// Unlock unlocks the store after a call to Lock.
+func (ms *MemoryStore) Unlock() {
+	for i := range ms.shards {
+		ms.shards[i].Unlock()
+	}
+}
+
 // Compile-time "implements" check.
 var _ interface {
 	Store
 	Queryer
+	Aggregator
+	Watcher
+	StatsStore
+	TraceLister
 } = (*MemoryStore)(nil)
 
 // Collect implements the Collector interface by collecting the events that
 // occurred in the span in-memory.
 func (ms *MemoryStore) Collect(id SpanID, as ...Annotation) error {
-	ms.Lock()
-	defer ms.Unlock()
-	return ms.collectNoLock(id, as...)
+	return ms.collectAfter(id, as, nil)
 }
 
-// collectNoLock is the same as Collect, but it does not grab the lock.
+// collectAfter is the same as Collect, but if before is non-nil, it is called
+// while the lock of the trace's shard is held, before the span is collected.
+// If it returns an error, the span is not collected. This lets WALStore log
+// the changes to the traces of each shard in the order they are made.
+func (ms *MemoryStore) collectAfter(id SpanID, as []Annotation, before func() error) error {
+	sh := ms.shard(id.Trace)
+	sh.Lock()
+	defer sh.Unlock()
+	if before != nil {
+		if err := before(); err != nil {
+			return err
+		}
+	}
+	sh.collections.add(time.Now())
+	if err := ms.collectNoLock(id, as...); err != nil {
+		return err
+	}
+	ms.watchers.notify(id.Trace)
+	return nil
+}
+
+// Watch implements the Watcher interface.
+func (ms *MemoryStore) Watch(ctx context.Context, opts TracesOpts) <-chan *Trace {
+	return ms.watchers.watch(ctx, opts, ms.Trace)
+}
+
+// collectNoLock is the same as Collect, but it does not grab the lock of the
+// trace's shard.
 func (ms *MemoryStore) collectNoLock(id SpanID, as ...Annotation) error {
+	sh := ms.shard(id.Trace)
 	if ms.log {
 		log.Printf("Collect %v", id)
 	}
 
+	if ms.truncateNoLock(id, as) {
+		if ms.log {
+			log.Printf("Drop %v (trace %v is truncated)", id, id.Trace)
+		}
+		return nil
+	}
+
 	// Initialize span map if needed.
-	if _, present := ms.span[id.Trace]; !present {
-		ms.span[id.Trace] = map[ID]*Trace{}
+	if _, present := sh.span[id.Trace]; !present {
+		sh.span[id.Trace] = map[ID]*Trace{}
 	}
 
 	// Create or update span.
-	s, present := ms.span[id.Trace][id.Span]
+	s, present := sh.span[id.Trace][id.Span]
 	if !present {
-		s = &Trace{Span: Span{ID: id, Annotations: as}}
-		ms.span[id.Trace][id.Span] = s
+		s = &Trace{Span: Span{ID: id, Annotations: sh.intern.appendAnnotations(nil, as)}}
+		sh.span[id.Trace][id.Span] = s
 	} else {
 		if ms.log {
 			if len(as) > 0 {
 				log.Printf("Add %d annotations to %v", len(as), id)
 			}
 		}
-		s.Annotations = append(s.Annotations, as...)
+		s.Annotations = sh.intern.appendAnnotations(s.Annotations, as)
 		return nil
 	}
 
 	// Create trace tree if it doesn't already exist.
-	root, present := ms.trace[id.Trace]
+	root, present := sh.trace[id.Trace]
 	if !present {
 		// Root span hasn't been seen yet, so make this the temporary
 		// root (until we collect the actual root).
@@ -146,7 +279,7 @@ func (ms *MemoryStore) collectNoLock(id SpanID, as ...Annotation) error {
 				log.Printf("Create temporary trace %v root %v", id.Trace, id)
 			}
 		}
-		ms.trace[id.Trace] = s
+		sh.trace[id.Trace] = s
 		root = s
 	}
 
@@ -163,7 +296,7 @@ func (ms *MemoryStore) collectNoLock(id SpanID, as ...Annotation) error {
 				log.Printf("Set new temp root %v and move previous temp root %v (child of new temp root)", root.Span.ID, oldRoot.Span.ID)
 			}
 		}
-		ms.trace[id.Trace] = root // set new root
+		sh.trace[id.Trace] = root // set new root
 		ms.reattachChildren(root, oldRoot)
 		ms.insert(root, oldRoot) // reinsert the old root
 
@@ -198,10 +331,119 @@ func (ms *MemoryStore) collectNoLock(id SpanID, as ...Annotation) error {
 	return nil
 }
 
+// truncatedSpanID is the span ID of the synthetic span that records how many
+// spans and annotations of a trace were dropped by a MemoryStore because of
+// its MaxTraceSpans or MaxTraceBytes limits.
+const truncatedSpanID ID = 1<<64 - 1
+
+// traceLimits is the size of a trace in a MemoryStore, as checked against its
+// MaxTraceSpans and MaxTraceBytes limits.
+type traceLimits struct {
+	spans     int       // number of spans (excluding marker)
+	bytes     int64     // approximate size of spans (see collectionSize)
+	collected time.Time // when the trace was first collected
+
+	// marker is the synthetic span that records the number of dropped
+	// spans and annotations, or nil if none were dropped.
+	marker                   *Trace
+	droppedSpans, droppedAnn int
+}
+
+// newTraceLimits returns the size of the given trace, which may contain a
+// truncation marker span.
+//
+// As the time at which the trace was first collected is unknown, its start
+// time is used instead.
+func newTraceLimits(t *Trace) *traceLimits {
+	l := &traceLimits{collected: t.StartTime()}
+	t.Walk(func(t *Trace, depth int, post bool) error {
+		if post {
+			return nil
+		}
+		if t.ID.Span != truncatedSpanID {
+			l.spans++
+			l.bytes += collectionSize(t.Annotations)
+			return nil
+		}
+		l.marker = t
+		for _, a := range t.Annotations {
+			switch a.Key {
+			case "TruncatedSpans":
+				l.droppedSpans, _ = strconv.Atoi(string(a.Value))
+			case "TruncatedAnnotations":
+				l.droppedAnn, _ = strconv.Atoi(string(a.Value))
+			}
+		}
+		return nil
+	})
+	return l
+}
+
+// truncateNoLock accounts for the collection of the given span and
+// annotations, and reports whether they must be dropped instead because the
+// trace has reached the MaxTraceSpans or MaxTraceBytes limits. Dropped
+// collections are recorded in the trace's truncation marker span.
+//
+// The first span of a trace and root spans are never dropped. Once a span of
+// a trace has been dropped, all of the trace's new spans are dropped too, so
+// that the children of dropped spans don't show up as orphans.
+func (ms *MemoryStore) truncateNoLock(id SpanID, as []Annotation) bool {
+	sh := ms.shard(id.Trace)
+	l, present := sh.limits[id.Trace]
+	if !present {
+		l = &traceLimits{collected: time.Now()}
+		sh.limits[id.Trace] = l
+	}
+	_, exists := sh.span[id.Trace][id.Span]
+	size := collectionSize(as)
+	if exists {
+		size -= 3 * 8 // span ID is already accounted for
+	}
+
+	root, hasRoot := sh.trace[id.Trace]
+	var drop bool
+	switch {
+	case !hasRoot || id.IsRoot():
+		// Never drop the first span or the root span of a trace.
+	case !exists && (l.marker != nil || ms.MaxTraceSpans > 0 && l.spans >= ms.MaxTraceSpans):
+		drop = true
+	case ms.MaxTraceBytes > 0 && l.bytes+size > ms.MaxTraceBytes:
+		drop = true
+	}
+	if !drop {
+		if !exists {
+			l.spans++
+		}
+		l.bytes += size
+		return false
+	}
+
+	if exists {
+		l.droppedAnn += len(as)
+	} else {
+		l.droppedSpans++
+	}
+	if l.marker == nil {
+		l.marker = &Trace{Span: Span{ID: SpanID{Trace: id.Trace, Span: truncatedSpanID, Parent: root.Span.ID.Span}}}
+		sh.span[id.Trace][truncatedSpanID] = l.marker
+		root.Sub = append(root.Sub, l.marker)
+	}
+	name := fmt.Sprintf("%d spans truncated", l.droppedSpans)
+	if l.droppedAnn > 0 {
+		name += fmt.Sprintf(" (and %d annotations)", l.droppedAnn)
+	}
+	l.marker.Annotations = Annotations{
+		{Key: "Name", Value: []byte(name)},
+		{Key: "TruncatedSpans", Value: []byte(strconv.Itoa(l.droppedSpans))},
+		{Key: "TruncatedAnnotations", Value: []byte(strconv.Itoa(l.droppedAnn))},
+	}
+	return true
+}
+
 // insert inserts t into the trace tree whose root (or temp root) is
 // root.
 func (ms *MemoryStore) insert(root, t *Trace) {
-	p, present := ms.span[t.ID.Trace][t.ID.Parent]
+	p, present := ms.shard(t.ID.Trace).span[t.ID.Trace][t.ID.Parent]
 	if present {
 		if ms.log {
 			log.Printf("Add %v as a child of parent %v", t.Span.ID, p.Span.ID)
@@ -240,77 +482,270 @@ func (ms *MemoryStore) reattachChildren(dst, src *Trace) {
 // Trace implements the Store interface by returning the Trace (a tree of
 // spans) for the given trace span ID or, if no such trace exists, by returning
 // ErrTraceNotFound.
+//
+// The returned trace is a snapshot that is not modified when more spans of the
+// trace are collected, so it can be used without holding any locks.
 func (ms *MemoryStore) Trace(id ID) (*Trace, error) {
-	ms.Lock()
-	defer ms.Unlock()
+	sh := ms.shard(id)
+	sh.Lock()
+	defer sh.Unlock()
 
 	return ms.traceNoLock(id)
 }
 
+// traceNoLock is the same as Trace, but it does not grab the lock of the
+// trace's shard.
 func (ms *MemoryStore) traceNoLock(id ID) (*Trace, error) {
-	t, present := ms.trace[id]
+	t, present := ms.shard(id).trace[id]
 	if !present {
 		return nil, ErrTraceNotFound
 	}
-	return t, nil
+	return snapshotTrace(t), nil
+}
+
+// snapshotTrace returns a copy of the tree of Trace values rooted at t that
+// is not affected by further collections. Only the tree structure needs to be
+// copied: annotations are only ever appended to a span's Annotations, which
+// doesn't modify the elements visible through a copy of the slice.
+func snapshotTrace(t *Trace) *Trace {
+	c := &Trace{Span: t.Span}
+	if t.Sub != nil {
+		c.Sub = make([]*Trace, len(t.Sub))
+		for i, sub := range t.Sub {
+			c.Sub[i] = snapshotTrace(sub)
+		}
+	}
+	return c
 }
 
-// Traces implements the Queryer interface.
+// Traces implements the Queryer interface. The shards of the store are locked
+// one at a time while their traces are filtered by ID and timespan, so
+// filtering and sorting the traces doesn't block the collection of spans.
+// Only the traces that are returned are copied (see Trace), so a page of
+// traces can be requested without copying the whole store.
 func (ms *MemoryStore) Traces(opts TracesOpts) ([]*Trace, error) {
-	ms.Lock()
-	defer ms.Unlock()
+	q, err := newTracesQuery(opts)
+	if err != nil {
+		return nil, err
+	}
 
-	var ts []*Trace
-	for id := range ms.trace {
-		t, err := ms.traceNoLock(id)
+	var qts []*queriedTrace
+	add := func(t *Trace) error {
+		qt, err := newQueriedTrace(t)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		qt.trace = nil // only copy the trace if it is selected (see load)
+		if q.include(qt) {
+			qts = append(qts, qt)
+		}
+		return nil
+	}
+	if len(opts.TraceIDs) > 0 {
+		// Look up just the requested traces, rather than visiting every trace in
+		// the store.
+		seen := make(map[ID]struct{}, len(opts.TraceIDs))
+		for _, id := range opts.TraceIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			sh := ms.shard(id)
+			sh.Lock()
+			var err error
+			if t, present := sh.trace[id]; present {
+				err = add(t)
+			}
+			sh.Unlock()
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		for i := range ms.shards {
+			sh := &ms.shards[i]
+			sh.Lock()
+			for _, t := range sh.trace {
+				if err := add(t); err != nil {
+					sh.Unlock()
+					return nil, err
+				}
+			}
+			sh.Unlock()
+		}
+	}
+
+	// load copies a selected trace. If there is a query, it is evaluated on
+	// the trace in the store first, so that only the matching traces are
+	// copied.
+	load := func(id ID) (*Trace, error) {
+		sh := ms.shard(id)
+		sh.Lock()
+		defer sh.Unlock()
+		t, present := sh.trace[id]
+		if !present {
+			return nil, ErrTraceNotFound // deleted since it was selected
 		}
-		ts = append(ts, t)
+		if q.query != nil {
+			if ok, err := q.query.Match(t); err != nil {
+				return nil, err
+			} else if !ok {
+				return nil, ErrTraceNotFound // skipped by tracesQuery.run
+			}
+		}
+		return snapshotTrace(t), nil
 	}
-	return ts, nil
+	return q.run(qts, load)
+}
+
+// NumTraces returns the number of traces in the store.
+func (ms *MemoryStore) NumTraces() int {
+	var n int
+	for i := range ms.shards {
+		sh := &ms.shards[i]
+		sh.Lock()
+		n += len(sh.trace)
+		sh.Unlock()
+	}
+	return n
+}
+
+// ListTraces implements the TraceLister interface.
+func (ms *MemoryStore) ListTraces() ([]TraceSummary, error) {
+	var traces []TraceSummary
+	for i := range ms.shards {
+		sh := &ms.shards[i]
+		sh.Lock()
+		for id, l := range sh.limits {
+			traces = append(traces, TraceSummary{ID: id, Collected: l.collected, Size: l.bytes})
+		}
+		sh.Unlock()
+	}
+	return traces, nil
 }
 
 // Delete implements the DeleteStore interface by deleting the traces given by
 // their span ID's from this in-memory store.
 func (ms *MemoryStore) Delete(traces ...ID) error {
-	ms.Lock()
-	defer ms.Unlock()
-	return ms.deleteNoLock(traces...)
+	for _, id := range traces {
+		ms.deleteAfter(id, nil)
+	}
+	return nil
+}
+
+// deleteAfter deletes the trace. Like collectAfter, it calls before (if
+// non-nil) while the lock of the trace's shard is held, and only deletes the
+// trace if it returns nil.
+func (ms *MemoryStore) deleteAfter(id ID, before func() error) error {
+	sh := ms.shard(id)
+	sh.Lock()
+	defer sh.Unlock()
+	if before != nil {
+		if err := before(); err != nil {
+			return err
+		}
+	}
+	return ms.deleteNoLock(id)
 }
 
-// deleteNoLock is the same as Delete, but it doesn't grab the lock.
+// deleteNoLock is the same as Delete, but it doesn't grab the locks of the
+// traces' shards.
 func (ms *MemoryStore) deleteNoLock(traces ...ID) error {
 	for _, id := range traces {
-		delete(ms.trace, id)
-		delete(ms.span, id)
+		sh := ms.shard(id)
+		delete(sh.trace, id)
+		delete(sh.span, id)
+		delete(sh.limits, id)
 	}
 	return nil
 }
 
-// deleteSubNoLock deletes the given subspan from this in-memory store. If
-// annotationsOnly == true then only the annotations from the span are deleted.
-//
-// TODO(slimsag): not general purpose / cannot handle removal of deep subspans
-// (e.g. Root->Sub->Sub). This is not important for our uses in AggregateStore,
-// however, as it uses only one level deep subspans.
+// DeleteSpan deletes the given span, at any depth, from this in-memory store,
+// along with all of its descendants. Deleting the root span of a trace deletes
+// the whole trace. If the span is not in the store, ErrSpanNotFound is
+// returned.
+func (ms *MemoryStore) DeleteSpan(id SpanID) error {
+	return ms.deleteSpanAfter(id, nil)
+}
+
+// deleteSpanAfter deletes the span. Like collectAfter, it calls before (if
+// non-nil) while the lock of the span's shard is held, and only deletes the
+// span if it is present and before returns nil.
+func (ms *MemoryStore) deleteSpanAfter(id SpanID, before func() error) error {
+	sh := ms.shard(id.Trace)
+	sh.Lock()
+	defer sh.Unlock()
+	if _, present := sh.span[id.Trace][id.Span]; !present {
+		return ErrSpanNotFound
+	}
+	if before != nil {
+		if err := before(); err != nil {
+			return err
+		}
+	}
+	ms.deleteSubNoLock(id, false)
+	return nil
+}
+
+// deleteSubNoLock deletes the given span, at any depth, from this in-memory
+// store, along with all of its descendants. Deleting the root span of a trace
+// deletes the whole trace. If annotationsOnly == true then only the
+// annotations from the span are deleted. It reports whether the span was
+// found.
 func (ms *MemoryStore) deleteSubNoLock(s SpanID, annotationsOnly bool) bool {
-	if sub, ok := ms.span[s.Trace]; ok {
-		if tr, ok := sub[s.Span]; ok {
-			tr.Annotations = nil
-
-			if !annotationsOnly {
-				delete(sub, s.Span)
-
-				// Remove from root *Trace.Sub slice, too.
-				root := ms.trace[s.Trace]
-				for i, t := range root.Sub {
-					if t != tr {
-						continue
-					}
-					root.Sub = append(root.Sub[:i], root.Sub[i+1:]...)
-				}
+	sh := ms.shard(s.Trace)
+	spans, ok := sh.span[s.Trace]
+	if !ok {
+		return false
+	}
+	tr, ok := spans[s.Span]
+	if !ok {
+		return false
+	}
+	l := sh.limits[s.Trace]
+	if annotationsOnly {
+		if l != nil && tr != l.marker {
+			l.bytes -= collectionSize(tr.Annotations) - 3*8
+		}
+		tr.Annotations = nil
+		return true
+	}
+	root := sh.trace[s.Trace]
+	if tr == root {
+		ms.deleteNoLock(s.Trace)
+		return true
+	}
+
+	// The span is either a child of its parent or, if its parent hasn't
+	// been collected, a temporary child of the root.
+	if p, ok := spans[tr.Span.ID.Parent]; !ok || !removeSub(p, tr) {
+		removeSub(root, tr)
+	}
+	tr.Walk(func(t *Trace, depth int, post bool) error {
+		if post {
+			return nil
+		}
+		delete(spans, t.Span.ID.Span)
+		if l != nil {
+			if t == l.marker {
+				l.marker = nil
+			} else {
+				l.spans--
+				l.bytes -= collectionSize(t.Annotations)
 			}
+		}
+		return nil
+	})
+	tr.Annotations = nil
+	return true
+}
+
+// removeSub removes t from the children of parent, and reports whether it was
+// found.
+func removeSub(parent, t *Trace) bool {
+	for i, sub := range parent.Sub {
+		if sub == t {
+			parent.Sub = append(parent.Sub[:i], parent.Sub[i+1:]...)
 			return true
 		}
 	}
@@ -320,31 +755,87 @@ func (ms *MemoryStore) deleteSubNoLock(s SpanID, annotationsOnly bool) bool {
 type memoryStoreData struct {
 	Trace map[ID]*Trace
 	Span  map[ID]map[ID]*Trace
+
+	// WALSeq is the sequence number of the last write-ahead log record that
+	// is included in the data (see WALStore), or zero.
+	WALSeq uint64
 }
 
 // Write implements the PersistentStore interface by gob-encoding and writing
-// ms's internal data structures out to w.
+// ms's internal data structures out to w. The store is only locked while the
+// traces are copied, not while they are encoded and written.
 func (ms *MemoryStore) Write(w io.Writer) error {
 	ms.Lock()
-	defer ms.Unlock()
+	traces := ms.snapshotNoLock()
+	ms.Unlock()
+	return writeTraces(w, traces, 0)
+}
+
+// snapshotNoLock returns a snapshot (see snapshotTrace) of all of the traces
+// in the store. The caller must hold the locks of all of the shards, i.e.
+// call Lock.
+func (ms *MemoryStore) snapshotNoLock() map[ID]*Trace {
+	traces := map[ID]*Trace{}
+	for i := range ms.shards {
+		for id, t := range ms.shards[i].trace {
+			traces[id] = snapshotTrace(t)
+		}
+	}
+	return traces
+}
 
-	data := memoryStoreData{ms.trace, ms.span}
+// writeTraces gob-encodes the traces (in the format written by Write and read
+// by ReadFrom) to w, along with the given WAL sequence number.
+func writeTraces(w io.Writer, traces map[ID]*Trace, walSeq uint64) error {
+	data := memoryStoreData{Trace: traces, Span: map[ID]map[ID]*Trace{}, WALSeq: walSeq}
+	for id, t := range traces {
+		data.Span[id] = map[ID]*Trace{}
+		indexSpans(data.Span[id], t)
+	}
 	return gob.NewEncoder(w).Encode(data)
 }
 
 // ReadFrom implements the PersistentStore interface by using gob-decoding to
 // load ms's internal data structures from the reader r.
 func (ms *MemoryStore) ReadFrom(r io.Reader) (int64, error) {
+	n, _, err := ms.readFrom(r)
+	return n, err
+}
+
+// readFrom is the same as ReadFrom, but it also returns the WAL sequence
+// number that the data was written with.
+func (ms *MemoryStore) readFrom(r io.Reader) (n int64, walSeq uint64, err error) {
 	ms.Lock()
 	defer ms.Unlock()
 
 	var data memoryStoreData
 	if err := gob.NewDecoder(r).Decode(&data); err != nil {
-		return 0, err
+		return 0, 0, err
+	}
+	// gob doesn't preserve pointer identity, so the decoded span map refers
+	// to copies of the spans in the trace trees. Rebuild it from the trees
+	// so that spans collected later are added to the trees. (gob also omits
+	// empty maps, so data.Trace may be nil.)
+	for i := range ms.shards {
+		ms.shards[i].reset()
+	}
+	for id, t := range data.Trace {
+		sh := ms.shard(id)
+		sh.trace[id] = t
+		sh.span[id] = map[ID]*Trace{}
+		indexSpans(sh.span[id], t)
+		sh.limits[id] = newTraceLimits(t)
+		sh.intern.internTrace(t)
+	}
+	return int64(len(data.Trace)), data.WALSeq, nil
+}
+
+// indexSpans adds t and its descendants to the span ID -> (sub)tree map m.
+func indexSpans(m map[ID]*Trace, t *Trace) {
+	m[t.Span.ID.Span] = t
+	for _, sub := range t.Sub {
+		indexSpans(m, sub)
 	}
-	ms.trace = data.Trace
-	ms.span = data.Span
-	return int64(len(ms.trace)), nil
 }
 
 // PersistentStore is a Store that can persist its data and read it
@@ -385,8 +876,58 @@ type DeleteStore interface {
 	Delete(...ID) error
 }
 
+// A TraceLister is a store that can summarize the traces it holds without
+// reading them. The store wrappers that delete traces (RecentStore,
+// LimitStore and ByteLimitStore) use it when they are first used to account
+// for the traces that were already in the underlying store, e.g. because it
+// was read back from disk.
+type TraceLister interface {
+	Store
+
+	// ListTraces returns a summary of each trace in the store, in no
+	// particular order.
+	ListTraces() ([]TraceSummary, error)
+}
+
+// TraceSummary summarizes a trace in a TraceLister.
+type TraceSummary struct {
+	ID ID
+
+	// Collected is when the trace was first collected. If that is unknown
+	// (e.g., the trace was read back from disk), it is the start time of
+	// the trace, or zero if the trace has no timespan events.
+	Collected time.Time
+
+	// Size is the approximate size in bytes of the trace's span data, as
+	// used by ByteLimitStore.
+	Size int64
+}
+
+// listTraces calls s.ListTraces if s is a TraceLister. Otherwise, it returns
+// no traces, as the traces in s can't be accounted for.
+func listTraces(s Store) ([]TraceSummary, error) {
+	if tl, ok := s.(TraceLister); ok {
+		return tl.ListTraces()
+	}
+	return nil, nil
+}
+
+// traceSummariesByCollected sorts traces by the time they were first
+// collected, oldest first.
+type traceSummariesByCollected []TraceSummary
+
+func (ts traceSummariesByCollected) Len() int      { return len(ts) }
+func (ts traceSummariesByCollected) Swap(i, j int) { ts[i], ts[j] = ts[j], ts[i] }
+func (ts traceSummariesByCollected) Less(i, j int) bool {
+	return ts[i].Collected.Before(ts[j].Collected)
+}
+
 // A RecentStore wraps another store and deletes old traces after a
 // specified amount of time.
+//
+// If the underlying store is a TraceLister, the traces that are already in it
+// when the RecentStore is first used are deleted once they are older than
+// MinEvictAge, too.
 type RecentStore struct {
 	// MinEvictAge is the minimum age of a trace before it is evicted.
 	MinEvictAge time.Duration
@@ -404,15 +945,19 @@ type RecentStore struct {
 	// lastEvicted is the last time the eviction process was run.
 	lastEvicted time.Time
 
-	mu sync.Mutex // mu guards created and lastEvicted
+	// evicted is the number of traces evicted so far.
+	evicted int64
+
+	mu sync.Mutex // mu guards created, lastEvicted and evicted
 }
 
 // Collect calls the underlying store's Collect and records the time
 // that this trace was first seen.
 func (rs *RecentStore) Collect(id SpanID, anns ...Annotation) error {
 	rs.mu.Lock()
-	if rs.created == nil {
-		rs.created = map[ID]int64{}
+	if err := rs.initNoLock(); err != nil {
+		rs.mu.Unlock()
+		return err
 	}
 	if _, present := rs.created[id.Trace]; !present {
 		rs.created[id.Trace] = time.Now().UnixNano()
@@ -425,6 +970,57 @@ func (rs *RecentStore) Collect(id SpanID, anns ...Annotation) error {
 	return rs.DeleteStore.Collect(id, anns...)
 }
 
+// initNoLock records when the traces that are already in the underlying
+// store were first collected, the first time that it is called. The rs.mu lock
+// must be held while calling initNoLock.
+func (rs *RecentStore) initNoLock() error {
+	if rs.created != nil {
+		return nil
+	}
+	traces, err := listTraces(rs.DeleteStore)
+	if err != nil {
+		return err
+	}
+	rs.created = make(map[ID]int64, len(traces))
+	now := time.Now().UnixNano()
+	for _, t := range traces {
+		// Traces without a known time are treated as new.
+		ct := now
+		if !t.Collected.IsZero() {
+			ct = t.Collected.UnixNano()
+		}
+		rs.created[t.ID] = ct
+	}
+	return nil
+}
+
+// ListTraces implements the TraceLister interface by listing the traces of
+// the underlying store, if it is a TraceLister.
+func (rs *RecentStore) ListTraces() ([]TraceSummary, error) {
+	return listTraces(rs.DeleteStore)
+}
+
+// Watch implements the Watcher interface by watching the underlying store, if
+// it is a Watcher.
+func (rs *RecentStore) Watch(ctx context.Context, opts TracesOpts) <-chan *Trace {
+	return watchStore(ctx, rs.DeleteStore, opts)
+}
+
+// Stats implements the StatsStore interface by adding the number of traces
+// evicted by rs and its MinEvictAge to the statistics of the underlying
+// store. If the underlying store is not a StatsStore, ErrNoStats is returned.
+func (rs *RecentStore) Stats() (*StoreStats, error) {
+	st, err := storeStats(rs.DeleteStore)
+	if err != nil {
+		return nil, err
+	}
+	rs.mu.Lock()
+	st.Evicted += rs.evicted
+	rs.mu.Unlock()
+	st.MaxAge = rs.MinEvictAge
+	return st, nil
+}
+
 // evictBefore evicts traces that were created before t. The rs.mu lock
 // must be held while calling evictBefore.
 func (rs *RecentStore) evictBefore(t time.Time) {
@@ -441,6 +1037,7 @@ func (rs *RecentStore) evictBefore(t time.Time) {
 	if len(toEvict) == 0 {
 		return
 	}
+	rs.evicted += int64(len(toEvict))
 
 	if rs.Debug {
 		log.Printf("RecentStore: deleting %d traces created before %s (age check took %s)", len(toEvict), t, time.Since(evictStart))
@@ -460,6 +1057,10 @@ func (rs *RecentStore) evictBefore(t time.Time) {
 
 // A LimitStore wraps another store and deletes the oldest trace when
 // the number of traces reaches the capacity (Max).
+//
+// If the underlying store is a TraceLister, the traces that are already in it
+// when the LimitStore is first used count towards the capacity, and the
+// oldest of them are deleted if there are more than Max.
 type LimitStore struct {
 	// Max is the maximum number of traces that the store should keep.
 	Max int
@@ -472,7 +1073,7 @@ type LimitStore struct {
 	traces        map[ID]struct{} // set of traces to quickly determine which traces exist in ring already.
 	ring          []int64         // ring is a circular list of trace IDs in insertion order.
 	nextInsertIdx int             // nextInsertIdx is the ring index for the next insertion.
-
+	evicted       int64           // evicted is the number of traces deleted so far.
 }
 
 // Collect calls the underlying store's Collect, deleting the oldest
@@ -483,9 +1084,8 @@ func (ls *LimitStore) Collect(id SpanID, anns ...Annotation) error {
 	}
 	ls.mu.Lock()
 	defer ls.mu.Unlock()
-	if ls.ring == nil {
-		ls.ring = make([]int64, ls.Max)
-		ls.traces = make(map[ID]struct{}, ls.Max)
+	if err := ls.initNoLock(); err != nil {
+		return err
 	}
 
 	// Check if the trace already exists in the ring. Otherwise, we would evict
@@ -503,6 +1103,7 @@ func (ls *LimitStore) Collect(id SpanID, anns ...Annotation) error {
 		if err := ls.DeleteStore.Delete(old); err != nil {
 			return err
 		}
+		ls.evicted++
 	}
 	ls.traces[id.Trace] = struct{}{}
 	ls.ring[ls.nextInsertIdx] = int64(id.Trace)
@@ -510,3 +1111,213 @@ func (ls *LimitStore) Collect(id SpanID, anns ...Annotation) error {
 
 	return ls.DeleteStore.Collect(id, anns...)
 }
+
+// initNoLock creates the ring and adds the traces that are already in the
+// underlying store to it (oldest first), the first time that it is called.
+// If there are more than ls.Max of them, the oldest are deleted. The ls.mu
+// lock must be held while calling initNoLock.
+func (ls *LimitStore) initNoLock() error {
+	if ls.ring != nil {
+		return nil
+	}
+	traces, err := listTraces(ls.DeleteStore)
+	if err != nil {
+		return err
+	}
+	sort.Sort(traceSummariesByCollected(traces))
+	if n := len(traces) - ls.Max; n > 0 {
+		old := make([]ID, n)
+		for i, t := range traces[:n] {
+			old[i] = t.ID
+		}
+		if err := ls.DeleteStore.Delete(old...); err != nil {
+			return err
+		}
+		ls.evicted += int64(n)
+		traces = traces[n:]
+	}
+
+	ls.ring = make([]int64, ls.Max)
+	ls.traces = make(map[ID]struct{}, ls.Max)
+	for _, t := range traces {
+		ls.traces[t.ID] = struct{}{}
+		ls.ring[ls.nextInsertIdx] = int64(t.ID)
+		ls.nextInsertIdx = (ls.nextInsertIdx + 1) % ls.Max
+	}
+	return nil
+}
+
+// ListTraces implements the TraceLister interface by listing the traces of
+// the underlying store, if it is a TraceLister.
+func (ls *LimitStore) ListTraces() ([]TraceSummary, error) {
+	return listTraces(ls.DeleteStore)
+}
+
+// Stats implements the StatsStore interface by adding the number of traces
+// deleted by ls and its Max to the statistics of the underlying store. If the
+// underlying store is not a StatsStore, ErrNoStats is returned.
+func (ls *LimitStore) Stats() (*StoreStats, error) {
+	st, err := storeStats(ls.DeleteStore)
+	if err != nil {
+		return nil, err
+	}
+	ls.mu.Lock()
+	st.Evicted += ls.evicted
+	ls.mu.Unlock()
+	st.MaxTraces = ls.Max
+	return st, nil
+}
+
+// Watch implements the Watcher interface by watching the underlying store, if
+// it is a Watcher.
+func (ls *LimitStore) Watch(ctx context.Context, opts TracesOpts) <-chan *Trace {
+	return watchStore(ctx, ls.DeleteStore, opts)
+}
+
+// A ByteLimitStore wraps another store and deletes the oldest traces when
+// the approximate size of the stored span data exceeds the budget
+// (MaxBytes). Unlike LimitStore, it accounts for traces varying greatly in
+// size.
+//
+// The size of a trace is estimated from the sizes of its span IDs and
+// annotation keys and values, so the actual memory used by the underlying
+// store will be somewhat higher.
+//
+// If the underlying store is a TraceLister, the traces that are already in it
+// when the ByteLimitStore is first used count towards the budget, and the
+// oldest of them are deleted by the first Collect if they exceed it.
+type ByteLimitStore struct {
+	// MaxBytes is the approximate maximum number of bytes of span data that
+	// the store should keep.
+	MaxBytes int64
+
+	// DeleteStore is the underlying store that spans are saved to and
+	// deleted from.
+	DeleteStore
+
+	mu      sync.Mutex
+	traces  map[ID]*list.Element // trace ID -> element of queue
+	queue   *list.List           // *byteLimitTrace values, in insertion order
+	total   int64                // sum of the sizes of the traces in queue
+	evicted int64                // number of traces deleted by Collect
+}
+
+// byteLimitTrace is a trace in the queue of a ByteLimitStore.
+type byteLimitTrace struct {
+	id   ID
+	size int64 // approximate size of the trace
+}
+
+// Collect calls the underlying store's Collect and then deletes the oldest
+// traces until the store is within its budget. The trace being collected is
+// never deleted, even if it alone exceeds the budget.
+func (bs *ByteLimitStore) Collect(id SpanID, anns ...Annotation) error {
+	bs.mu.Lock()
+	err := bs.initNoLock()
+	bs.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := bs.DeleteStore.Collect(id, anns...); err != nil {
+		return err
+	}
+
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	e, present := bs.traces[id.Trace]
+	if !present {
+		e = bs.queue.PushBack(&byteLimitTrace{id: id.Trace})
+		bs.traces[id.Trace] = e
+	}
+	n := collectionSize(anns)
+	e.Value.(*byteLimitTrace).size += n
+	bs.total += n
+
+	var toEvict []ID
+	for bs.total > bs.MaxBytes && bs.queue.Front() != e {
+		old := bs.removeNoLock(bs.queue.Front())
+		toEvict = append(toEvict, old.id)
+	}
+	if len(toEvict) == 0 {
+		return nil
+	}
+	bs.evicted += int64(len(toEvict))
+	return bs.DeleteStore.Delete(toEvict...)
+}
+
+// initNoLock adds the traces that are already in the underlying store to the
+// queue (oldest first), the first time that it is called. The caller must
+// hold bs.mu.
+func (bs *ByteLimitStore) initNoLock() error {
+	if bs.queue != nil {
+		return nil
+	}
+	traces, err := listTraces(bs.DeleteStore)
+	if err != nil {
+		return err
+	}
+	sort.Sort(traceSummariesByCollected(traces))
+	bs.traces = make(map[ID]*list.Element, len(traces))
+	bs.queue = list.New()
+	for _, t := range traces {
+		bs.traces[t.ID] = bs.queue.PushBack(&byteLimitTrace{id: t.ID, size: t.Size})
+		bs.total += t.Size
+	}
+	return nil
+}
+
+// Delete calls the underlying store's Delete and stops accounting for the
+// deleted traces.
+func (bs *ByteLimitStore) Delete(traces ...ID) error {
+	bs.mu.Lock()
+	for _, id := range traces {
+		if e, present := bs.traces[id]; present {
+			bs.removeNoLock(e)
+		}
+	}
+	bs.mu.Unlock()
+	return bs.DeleteStore.Delete(traces...)
+}
+
+// removeNoLock removes the trace from the queue and stops accounting for its
+// size. The caller must hold bs.mu.
+func (bs *ByteLimitStore) removeNoLock(e *list.Element) *byteLimitTrace {
+	t := bs.queue.Remove(e).(*byteLimitTrace)
+	delete(bs.traces, t.id)
+	bs.total -= t.size
+	return t
+}
+
+// ListTraces implements the TraceLister interface by listing the traces of
+// the underlying store, if it is a TraceLister.
+func (bs *ByteLimitStore) ListTraces() ([]TraceSummary, error) {
+	return listTraces(bs.DeleteStore)
+}
+
+// Watch implements the Watcher interface by watching the underlying store, if
+// it is a Watcher.
+func (bs *ByteLimitStore) Watch(ctx context.Context, opts TracesOpts) <-chan *Trace {
+	return watchStore(ctx, bs.DeleteStore, opts)
+}
+
+// Stats implements the StatsStore interface by adding the number of traces
+// deleted by bs and its MaxBytes to the statistics of the underlying store. If
+// the underlying store is not a StatsStore, ErrNoStats is returned.
+func (bs *ByteLimitStore) Stats() (*StoreStats, error) {
+	st, err := storeStats(bs.DeleteStore)
+	if err != nil {
+		return nil, err
+	}
+	bs.mu.Lock()
+	st.Evicted += bs.evicted
+	bs.mu.Unlock()
+	st.MaxBytes = bs.MaxBytes
+	return st, nil
+}
+
+// Size returns the approximate size in bytes of the span data in the store.
+func (bs *ByteLimitStore) Size() int64 {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	return bs.total
+}
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -138,6 +139,120 @@ func TestMemoryStore_deleteSubNoLock(t *testing.T) {
 	}
 }
 
+func TestMemoryStore_DeleteSpan(t *testing.T) {
+	s := NewMemoryStore()
+	ms := storeT{t, s}
+
+	// Collect root -> 2 -> 3 -> 4, and 5, an orphan whose parent (6) is
+	// missing.
+	ms.MustCollect(SpanID{1, 1, 0})
+	ms.MustCollect(SpanID{1, 2, 1})
+	ms.MustCollect(SpanID{1, 3, 2}, Annotation{Key: "k", Value: []byte("v")})
+	ms.MustCollect(SpanID{1, 4, 3})
+	ms.MustCollect(SpanID{1, 5, 6})
+
+	if err := s.DeleteSpan(SpanID{1, 3, 2}); err != nil {
+		t.Fatalf("deleting deep subspan: %s", err)
+	}
+	if err := s.DeleteSpan(SpanID{Trace: 1, Span: 5}); err != nil {
+		t.Fatalf("deleting orphan subspan: %s", err)
+	}
+	if err := s.DeleteSpan(SpanID{1, 4, 3}); err != ErrSpanNotFound {
+		t.Errorf("deleting descendant of deleted span again: got err %v, want ErrSpanNotFound", err)
+	}
+	if err := s.DeleteSpan(SpanID{2, 2, 0}); err != ErrSpanNotFound {
+		t.Errorf("deleting span of missing trace: got err %v, want ErrSpanNotFound", err)
+	}
+	st, err := s.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Spans != 2 || st.AnnotationBytes != 2*3*8 {
+		t.Errorf("got %d spans and %d bytes accounted for, want 2 and %d", st.Spans, st.AnnotationBytes, 2*3*8)
+	}
+
+	want1 := &Trace{
+		Span: Span{ID: SpanID{1, 1, 0}},
+		Sub: []*Trace{
+			{Span: Span{ID: SpanID{1, 2, 1}}, Sub: []*Trace{}},
+		},
+	}
+	if x := ms.MustTrace(1); !reflect.DeepEqual(x, want1) {
+		t.Errorf("Trace(1): got trace %+v, want %+v", x, want1)
+	}
+
+	// Deleting the root span deletes the trace.
+	if err := s.DeleteSpan(SpanID{1, 1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("got error %v, want ErrTraceNotFound", err)
+	}
+}
+
+func TestMemoryStore_truncate(t *testing.T) {
+	s := NewMemoryStore()
+	s.MaxTraceSpans = 3
+	ms := storeT{t, s}
+
+	ms.MustCollect(SpanID{1, 1, 0})
+	ms.MustCollect(SpanID{1, 2, 1})
+	ms.MustCollect(SpanID{1, 3, 2})
+	for i := ID(4); i < 10; i++ {
+		ms.MustCollect(SpanID{1, i, 2})
+	}
+	ms.MustCollect(SpanID{1, 3, 2}, Annotation{Key: "k", Value: []byte("v")}) // existing span
+
+	tr := ms.MustTrace(1)
+	var ids []ID
+	for _, sp := range tr.Flatten() {
+		ids = append(ids, sp.ID.Span)
+	}
+	if want := []ID{1, 2, 3, truncatedSpanID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got spans %v, want %v", ids, want)
+	}
+	marker := tr.FindSpan(truncatedSpanID)
+	if got, want := marker.Span.Name(), "6 spans truncated"; got != want {
+		t.Errorf("got marker name %q, want %q", got, want)
+	}
+	if got := tr.FindSpan(3).Annotations; len(got) != 1 {
+		t.Errorf("got annotations %v on existing span, want 1", got)
+	}
+
+	// The limits must survive a round trip through Write and ReadFrom.
+	var buf bytes.Buffer
+	if err := s.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	s2 := NewMemoryStore()
+	s2.MaxTraceBytes = 1
+	if _, err := s2.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	storeT{t, s2}.MustCollect(SpanID{1, 2, 1}, Annotation{Key: "k", Value: []byte("v")})
+	marker = storeT{t, s2}.MustTrace(1).FindSpan(truncatedSpanID)
+	if got, want := marker.Span.Name(), "6 spans truncated (and 1 annotations)"; got != want {
+		t.Errorf("got marker name %q after ReadFrom, want %q", got, want)
+	}
+}
+
+func TestMemoryStore_Collect_intern(t *testing.T) {
+	ms := storeT{t, NewMemoryStore()}
+	ms.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "Method", Value: []byte("GET")})
+	v := []byte("GET")
+	ms.MustCollect(SpanID{1, 2, 1}, Annotation{Key: "Method", Value: v})
+	v[0] = 'P' // must not affect the collected annotations
+
+	tr := ms.MustTrace(1)
+	a1, a2 := tr.Annotations[0], tr.Sub[0].Annotations[0]
+	if string(a1.Value) != "GET" || string(a2.Value) != "GET" {
+		t.Errorf("got values %q and %q, want GET", a1.Value, a2.Value)
+	}
+	if &a1.Value[0] != &a2.Value[0] {
+		t.Error("got distinct copies of the value, want it interned")
+	}
+}
+
 func TestMemoryStore_Collect_childCollectedBeforeRoot(t *testing.T) {
 	ms := storeT{t, NewMemoryStore()}
 
@@ -164,6 +279,34 @@ func TestMemoryStore_Collect_childCollectedBeforeRoot(t *testing.T) {
 	}
 }
 
+func TestMemoryStore_Collect_orphans(t *testing.T) {
+	ms := storeT{t, NewMemoryStore()}
+
+	t.Log("collect trace 1 grandchild and root, without the child")
+	ms.MustCollect(SpanID{1, 3, 2})
+	if x := ms.MustTrace(1); !reflect.DeepEqual(x.MissingSpans(), []ID{2}) {
+		t.Errorf("Trace(1): got missing spans %v, want [2]", x.MissingSpans())
+	}
+	ms.MustCollect(SpanID{1, 1, 0})
+	x := ms.MustTrace(1)
+	if orphans := x.Orphans(); len(orphans) != 1 || orphans[0].ID != (SpanID{1, 3, 2}) {
+		t.Errorf("Trace(1): got orphans %v, want span 3", orphans)
+	}
+	if !reflect.DeepEqual(x.MissingSpans(), []ID{2}) {
+		t.Errorf("Trace(1): got missing spans %v, want [2]", x.MissingSpans())
+	}
+
+	t.Log("collect trace 1 child")
+	ms.MustCollect(SpanID{1, 2, 1})
+	x = ms.MustTrace(1)
+	if orphans := x.Orphans(); len(orphans) != 0 {
+		t.Errorf("Trace(1): got orphans %v, want none", orphans)
+	}
+	if missing := x.MissingSpans(); len(missing) != 0 {
+		t.Errorf("Trace(1): got missing spans %v, want none", missing)
+	}
+}
+
 func TestMemoryStore_Collect_childrenCollectedInReverse(t *testing.T) {
 	ms := storeT{t, NewMemoryStore()}
 
@@ -412,6 +555,135 @@ func TestLimitStore(t *testing.T) {
 	}
 }
 
+func TestLimitStore_existing(t *testing.T) {
+	ms := NewMemoryStore()
+	for i := ID(1); i <= 3; i++ {
+		storeT{t, ms}.MustCollect(SpanID{i, i, 0})
+		time.Sleep(time.Millisecond) // order the traces by collection time
+	}
+
+	// The traces that were already in the store count towards the limit.
+	ls := &LimitStore{DeleteStore: ms, Max: 2}
+	storeT{t, ls}.MustCollect(SpanID{4, 4, 0})
+	traces, err := ms.Traces(TracesOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Sort(tracesByIDSpan(traces))
+	if len(traces) != 2 || traces[0].ID.Trace != 3 || traces[1].ID.Trace != 4 {
+		t.Errorf("got traces %v, want traces 3 and 4", traces)
+	}
+	if n := ms.NumTraces(); n != 2 {
+		t.Errorf("got NumTraces %d, want 2", n)
+	}
+}
+
+func TestByteLimitStore(t *testing.T) {
+	ms := NewMemoryStore()
+	bs := &ByteLimitStore{DeleteStore: ms, MaxBytes: 200}
+	s := storeT{t, bs}
+
+	// Each collection is 24 bytes of span ID plus the annotation sizes.
+	big := Annotation{Key: "k", Value: make([]byte, 99)} // 124 bytes in total
+	s.MustCollect(SpanID{1, 1, 0}, big)
+	s.MustCollect(SpanID{2, 2, 0})
+	if got, want := bs.Size(), int64(124+24); got != want {
+		t.Errorf("got size %d, want %d", got, want)
+	}
+
+	// Trace 1 is evicted to make room for trace 3.
+	s.MustCollect(SpanID{3, 3, 0}, big)
+	if _, err := ms.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("Trace(1): got err %v, want ErrTraceNotFound", err)
+	}
+	s.MustTrace(2)
+	s.MustTrace(3)
+
+	// The trace being collected is never evicted, even if it exceeds the
+	// budget by itself.
+	s.MustCollect(SpanID{4, 4, 0}, big, big)
+	s.MustTrace(4)
+	for _, id := range []ID{2, 3} {
+		if _, err := ms.Trace(id); err != ErrTraceNotFound {
+			t.Errorf("Trace(%v): got err %v, want ErrTraceNotFound", id, err)
+		}
+	}
+
+	// Deleting through the store updates its size.
+	if err := bs.Delete(4); err != nil {
+		t.Fatal(err)
+	}
+	if got := bs.Size(); got != 0 {
+		t.Errorf("got size %d after Delete, want 0", got)
+	}
+}
+
+func TestByteLimitStore_existing(t *testing.T) {
+	ms := NewMemoryStore()
+	for i := ID(1); i <= 3; i++ {
+		storeT{t, ms}.MustCollect(SpanID{i, i, 0}, Annotation{Key: "k", Value: make([]byte, 100)})
+		time.Sleep(time.Millisecond) // order the traces by collection time
+	}
+
+	// The traces that were already in the store count towards the budget,
+	// and the oldest ones are deleted to make room for the new trace.
+	bs := &ByteLimitStore{DeleteStore: ms, MaxBytes: 300}
+	storeT{t, bs}.MustCollect(SpanID{4, 4, 0}, Annotation{Key: "k", Value: make([]byte, 100)})
+	traces, err := ms.Traces(TracesOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Sort(tracesByIDSpan(traces))
+	if len(traces) != 2 || traces[0].ID.Trace != 3 || traces[1].ID.Trace != 4 {
+		t.Errorf("got traces %v, want traces 3 and 4", traces)
+	}
+	if size, want := bs.Size(), 2*(3*8+1+100); size != int64(want) {
+		t.Errorf("got size %d, want %d", size, want)
+	}
+}
+
+func TestByteLimitStore_deleteRecollect(t *testing.T) {
+	ms := NewMemoryStore()
+	bs := &ByteLimitStore{DeleteStore: ms, MaxBytes: 300}
+	s := storeT{t, bs}
+
+	big := Annotation{Key: "k", Value: make([]byte, 99)} // 124 bytes in total
+	s.MustCollect(SpanID{1, 1, 0}, big)
+	s.MustCollect(SpanID{2, 2, 0}, big)
+
+	// Trace 1 is deleted and collected again, so it is now newer than
+	// trace 2.
+	if err := bs.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	s.MustCollect(SpanID{1, 1, 0}, big)
+	if got, want := bs.queue.Len(), 2; got != want {
+		t.Errorf("got %d traces in queue, want %d", got, want)
+	}
+
+	// Trace 2 is the oldest, so it is evicted to make room for trace 3.
+	s.MustCollect(SpanID{3, 3, 0}, big)
+	if _, err := ms.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2): got err %v, want ErrTraceNotFound", err)
+	}
+	s.MustTrace(1)
+	s.MustTrace(3)
+	if got, want := bs.Size(), int64(2*124); got != want {
+		t.Errorf("got size %d, want %d", got, want)
+	}
+
+	// Deleted traces don't stay in the queue.
+	for i := 0; i < 100; i++ {
+		s.MustCollect(SpanID{4, 4, 0})
+		if err := bs.Delete(4); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := bs.queue.Len(), 2; got != want {
+		t.Errorf("got %d traces in queue, want %d", got, want)
+	}
+}
+
 func compareTraces(a, b *Trace) (diff []string) {
 	var cmp func(parent ID, a, b *Trace)
 	cmp = func(parent ID, a, b *Trace) {
@@ -484,6 +756,64 @@ func BenchmarkMemoryStore1000(b *testing.B) {
 	benchmarkMemoryStoreN(b, 1000)
 }
 
+// benchmarkMemoryStoreParallel collects spans of distinct traces from
+// conns goroutines at once, like a CollectorServer with that many
+// connections. If query is true, another goroutine calls Traces in a loop
+// meanwhile, like the web app rendering the traces page.
+func benchmarkMemoryStoreParallel(b *testing.B, conns int, query bool) {
+	ms := NewMemoryStore()
+	if query {
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if _, err := ms.Traces(TracesOpts{Limit: 10}); err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	var (
+		wg   sync.WaitGroup
+		next = make(chan int, conns)
+	)
+	b.ResetTimer()
+	for c := 0; c < conns; c++ {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			ann := Annotation{Key: "k", Value: []byte("v")}
+			for i := range next {
+				x := ID(i*conns + c + 1)
+				for s := ID(1); s <= 10; s++ {
+					if err := ms.Collect(SpanID{x, s, s - 1}, ann); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			}
+		}(c)
+	}
+	for i := 0; i < b.N; i++ {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+}
+
+func BenchmarkMemoryStoreParallel1(b *testing.B)  { benchmarkMemoryStoreParallel(b, 1, false) }
+func BenchmarkMemoryStoreParallel4(b *testing.B)  { benchmarkMemoryStoreParallel(b, 4, false) }
+func BenchmarkMemoryStoreParallel16(b *testing.B) { benchmarkMemoryStoreParallel(b, 16, false) }
+
+func BenchmarkMemoryStoreParallel16Query(b *testing.B) { benchmarkMemoryStoreParallel(b, 16, true) }
+
 func BenchmarkMemoryStoreWrite1000(b *testing.B) {
 	ms := NewMemoryStore()
 	var x ID
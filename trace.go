@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 )
@@ -39,6 +40,49 @@ func (t *Trace) FindSpan(spanID ID) *Trace {
 	return nil
 }
 
+// Orphans returns the spans in the trace whose parent span has not been
+// collected, which usually means that a service did not propagate the span ID
+// of the request it was handling. Stores attach such spans to the root of the
+// trace until their parent is collected, so the trace is complete if and only
+// if Orphans returns no spans.
+//
+// If t's root span is not the root span of the trace (i.e. the actual root
+// span has not been collected yet), t itself is the first orphan returned.
+func (t *Trace) Orphans() []*Trace {
+	var orphans []*Trace
+	if !t.ID.IsRoot() {
+		orphans = append(orphans, t)
+	}
+	return t.orphans(orphans)
+}
+
+func (t *Trace) orphans(orphans []*Trace) []*Trace {
+	for _, sub := range t.Sub {
+		if sub.ID.Parent != t.ID.Span {
+			orphans = append(orphans, sub)
+		}
+		orphans = sub.orphans(orphans)
+	}
+	return orphans
+}
+
+// MissingSpans returns the IDs of the spans that are missing from the trace,
+// i.e. the parents of the spans returned by Orphans, in the order in which
+// they are first referred to.
+func (t *Trace) MissingSpans() []ID {
+	var (
+		missing []ID
+		seen    = map[ID]struct{}{}
+	)
+	for _, o := range t.Orphans() {
+		if _, ok := seen[o.ID.Parent]; !ok {
+			seen[o.ID.Parent] = struct{}{}
+			missing = append(missing, o.ID.Parent)
+		}
+	}
+	return missing
+}
+
 // TreeString returns the Trace as a formatted string that visually
 // represents the trace's tree.
 func (t *Trace) TreeString() string {
@@ -59,6 +103,111 @@ func (t *Trace) TimespanEvent() (TimespanEvent, error) {
 	return timespanEvent{S: start, E: end}, nil
 }
 
+// StartTime returns the start time of the span, i.e. the earliest start time
+// of its timespan events, or the zero time if it has none.
+func (t *Trace) StartTime() time.Time {
+	start, _, _ := t.times()
+	return start
+}
+
+// EndTime returns the end time of the span, i.e. the latest end time of its
+// timespan events, or the zero time if it has none.
+func (t *Trace) EndTime() time.Time {
+	_, end, _ := t.times()
+	return end
+}
+
+// Duration returns the duration of the span, from StartTime to EndTime, or
+// zero if it has no timespan events.
+func (t *Trace) Duration() time.Duration {
+	start, end, _ := t.times()
+	return end.Sub(start)
+}
+
+// ChildTime returns the wall-clock time during which at least one of the
+// span's children was running, within the span's own timespan. Time during
+// which several children were running concurrently is only counted once (see
+// ChildOverlap). Children without timespan events are ignored, and zero is
+// returned if the span itself has no timespan events.
+func (t *Trace) ChildTime() time.Duration {
+	union, _ := t.childTimes()
+	return union
+}
+
+// ChildOverlap returns the time that the span's children spent running
+// concurrently with each other, i.e. the sum of their durations (within the
+// span's own timespan) minus ChildTime. It is zero if the children ran one
+// after the other.
+func (t *Trace) ChildOverlap() time.Duration {
+	union, sum := t.childTimes()
+	return sum - union
+}
+
+// SelfTime returns the time that the span spent without any of its children
+// running, i.e. Duration minus ChildTime.
+func (t *Trace) SelfTime() time.Duration {
+	return t.Duration() - t.ChildTime()
+}
+
+// childTimes returns the union and the sum of the children's timespans,
+// clipped to t's timespan.
+func (t *Trace) childTimes() (union, sum time.Duration) {
+	start, end, ok := t.times()
+	if !ok {
+		return 0, 0
+	}
+	var cursor time.Time // end of the union so far
+	for _, sub := range t.ChildrenByStart() {
+		s, e, ok := sub.times()
+		if !ok {
+			continue
+		}
+		if s.Before(start) {
+			s = start
+		}
+		if e.After(end) {
+			e = end
+		}
+		if !e.After(s) {
+			continue
+		}
+		sum += e.Sub(s)
+		if s.Before(cursor) {
+			s = cursor
+		}
+		if e.After(s) {
+			union += e.Sub(s)
+			cursor = e
+		}
+	}
+	return union, sum
+}
+
+// ChildrenByStart returns the span's children (Sub) sorted by start time.
+// Children without timespan events sort first, and the order of children with
+// the same start time is preserved. Sub itself is not modified.
+func (t *Trace) ChildrenByStart() []*Trace {
+	s := tracesByStart{
+		traces: append([]*Trace(nil), t.Sub...),
+		start:  make([]time.Time, len(t.Sub)),
+	}
+	for i, sub := range s.traces {
+		s.start[i] = sub.StartTime()
+	}
+	sort.Stable(s)
+	return s.traces
+}
+
+// times returns the start and end times of t's timespan events, or ok ==
+// false if it has none.
+func (t *Trace) times() (start, end time.Time, ok bool) {
+	var events []Event
+	if err := UnmarshalEvents(t.Annotations, &events); err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return findTraceTimes(events)
+}
+
 func (t *Trace) treeString(w io.Writer, depth int) {
 	const indent1 = "    "
 	indent := strings.Repeat(indent1, depth)
@@ -119,3 +268,15 @@ type tracesByIDSpan []*Trace
 func (t tracesByIDSpan) Len() int           { return len(t) }
 func (t tracesByIDSpan) Less(i, j int) bool { return t[i].Span.ID.Span < t[j].Span.ID.Span }
 func (t tracesByIDSpan) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+type tracesByStart struct {
+	traces []*Trace
+	start  []time.Time
+}
+
+func (t tracesByStart) Len() int           { return len(t.traces) }
+func (t tracesByStart) Less(i, j int) bool { return t.start[i].Before(t.start[j]) }
+func (t tracesByStart) Swap(i, j int) {
+	t.traces[i], t.traces[j] = t.traces[j], t.traces[i]
+	t.start[i], t.start[j] = t.start[j], t.start[i]
+}
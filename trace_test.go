@@ -1,6 +1,10 @@
 package appdash
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestTrace_TreeString(t *testing.T) {
 	t.Skip("TODO")
@@ -90,3 +94,72 @@ func TestTrace_FindSpan(t *testing.T) {
 		}
 	}
 }
+
+func TestTrace_timing(t *testing.T) {
+	ms := NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	ms10 := func(n int) time.Duration { return time.Duration(n) * 10 * time.Millisecond }
+	record := func(rec *Recorder, name string, start, end int) *Recorder {
+		rec.Name(name)
+		if end > start {
+			rec.Event(Timespan{S: base.Add(ms10(start)), E: base.Add(ms10(end))})
+		}
+		rec.Finish()
+		if errs := rec.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		return rec
+	}
+
+	// root: 0-10
+	//   c:        6-12  (clipped to 6-10)
+	//   a:  1-4
+	//   b:    2-5       (concurrent with a)
+	//   d:              (no timespan)
+	root := record(NewRecorder(SpanID{Trace: 1, Span: 1}, ms), "root", 0, 10)
+	record(root.Child(), "c", 6, 12)
+	record(root.Child(), "a", 1, 4)
+	record(root.Child(), "b", 2, 5)
+	record(root.Child(), "d", 0, 0)
+
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := trace.StartTime(), base; !got.Equal(want) {
+		t.Errorf("got StartTime %v, want %v", got, want)
+	}
+	if got, want := trace.EndTime(), base.Add(ms10(10)); !got.Equal(want) {
+		t.Errorf("got EndTime %v, want %v", got, want)
+	}
+	if got, want := trace.Duration(), ms10(10); got != want {
+		t.Errorf("got Duration %v, want %v", got, want)
+	}
+	if got, want := trace.ChildTime(), ms10(8); got != want {
+		t.Errorf("got ChildTime %v, want %v", got, want)
+	}
+	if got, want := trace.ChildOverlap(), ms10(2); got != want {
+		t.Errorf("got ChildOverlap %v, want %v", got, want)
+	}
+	if got, want := trace.SelfTime(), ms10(2); got != want {
+		t.Errorf("got SelfTime %v, want %v", got, want)
+	}
+
+	var names []string
+	for _, sub := range trace.ChildrenByStart() {
+		names = append(names, sub.Span.Name())
+	}
+	if got, want := strings.Join(names, " "), "d a b c"; got != want {
+		t.Errorf("got ChildrenByStart %q, want %q", got, want)
+	}
+
+	var d *Trace
+	for _, sub := range trace.Sub {
+		if sub.Span.Name() == "d" {
+			d = sub
+		}
+	}
+	if !d.StartTime().IsZero() || d.Duration() != 0 || d.SelfTime() != 0 {
+		t.Errorf("got times %v, %v, %v for span without timespan, want zero", d.StartTime(), d.Duration(), d.SelfTime())
+	}
+}
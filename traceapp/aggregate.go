@@ -64,7 +64,7 @@ func (a *App) aggregate(traces []*appdash.Trace, mode aggMode) ([]*aggItem, erro
 	for _, trace := range traces {
 		// Calculate the cumulative time -- which we can already get through the
 		// profile view's calculation method.
-		profiles, childProf, err := a.calcProfile(nil, trace)
+		profiles, childProf, err := a.calcProfile(nil, trace, nil)
 		if err != nil {
 			return nil, err
 		}
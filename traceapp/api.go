@@ -0,0 +1,179 @@
+package traceapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/export"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
+)
+
+// serveTraceAPI serves a single trace as JSON. If the trace ID is invalid, a
+// 400 Bad Request response is sent, and if the trace does not exist, a 404 Not
+// Found response is sent.
+func (a *App) serveTraceAPI(w http.ResponseWriter, r *http.Request) error {
+	traceID, err := appdash.ParseID(mux.Vars(r)["Trace"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil
+	}
+	trace, err := a.Store.Trace(traceID)
+	if err == appdash.ErrTraceNotFound {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return writeJSON(w, trace)
+}
+
+// serveTraceChrome serves a single trace in the Chrome trace event format, for
+// viewing in chrome://tracing or Perfetto. The "tracks" URL query parameter
+// selects whether the spans are laid out in a track per span depth ("depth",
+// the default) or in a process per service ("service"). If the trace ID is
+// invalid, a 400 Bad Request response is sent, and if the trace does not
+// exist, a 404 Not Found response is sent.
+func (a *App) serveTraceChrome(w http.ResponseWriter, r *http.Request) error {
+	var tracks export.ChromeTracks
+	switch v := r.URL.Query().Get("tracks"); v {
+	case "", "depth":
+		tracks = export.ChromeTracksByDepth
+	case "service":
+		tracks = export.ChromeTracksByService
+	default:
+		http.Error(w, fmt.Sprintf("invalid tracks %q (want depth or service)", v), http.StatusBadRequest)
+		return nil
+	}
+
+	traceID, err := appdash.ParseID(mux.Vars(r)["Trace"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil
+	}
+	trace, err := a.Store.Trace(traceID)
+	if err == appdash.ErrTraceNotFound {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	ct, err := export.ChromeTraceEvents(trace, tracks)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, ct)
+}
+
+// serveTracesAPI serves a JSON list of traces. The options are given by the
+// same URL query parameters as the traces page (see parseTracesOpts), except
+// that no sorting, pagination or filtering is done by default, and traces are
+// selected by ID with a comma-separated list of IDs in the "trace" parameter.
+// If the parameters are invalid, a 400 Bad Request response is sent.
+func (a *App) serveTracesAPI(w http.ResponseWriter, r *http.Request) error {
+	opts, err := parseTracesAPIOpts(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil
+	}
+	traces, err := a.Queryer.Traces(opts)
+	if err != nil {
+		return err
+	}
+	if traces == nil {
+		traces = []*appdash.Trace{}
+	}
+	return writeJSON(w, traces)
+}
+
+// parseTracesAPIOpts parses the URL query parameters of the traces API (see
+// serveTracesAPI). Unlike parseTracesOpts, it also checks that the query is
+// valid, so that an invalid query is reported to the client as such.
+func parseTracesAPIOpts(q url.Values) (appdash.TracesOpts, error) {
+	opts, err := parseTracesOpts(q, appdash.TracesOpts{})
+	if err != nil {
+		return opts, err
+	}
+	if opts.Query != "" {
+		if _, err := appdash.ParseQuery(opts.Query); err != nil {
+			return opts, err
+		}
+	}
+	if ids := q.Get("trace"); ids != "" {
+		for _, idStr := range strings.Split(ids, ",") {
+			id, err := appdash.ParseID(idStr)
+			if err != nil {
+				return opts, err
+			}
+			opts.TraceIDs = append(opts.TraceIDs, id)
+		}
+	}
+	return opts, nil
+}
+
+// serveTracesWatchAPI streams traces as they are created or updated, as
+// server-sent events whose data is the JSON trace, until the client
+// disconnects. The traces are filtered by the same URL query parameters as
+// the traces API (see serveTracesAPI); sorting and pagination are ignored.
+//
+// Unlike the other handlers, it is not a handlerFunc, since those buffer the
+// whole response. If the store is not an appdash.Watcher, a 501 Not
+// Implemented response is sent.
+func (a *App) serveTracesWatchAPI(w http.ResponseWriter, r *http.Request) {
+	opts, err := parseTracesAPIOpts(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	watcher, ok := a.Store.(appdash.Watcher)
+	if !ok {
+		http.Error(w, "store does not support watching traces", http.StatusNotImplemented)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "response does not support streaming", http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	traces := watcher.Watch(ctx, opts)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for t := range traces {
+		data, err := json.Marshal(t)
+		if err != nil {
+			a.Log.Printf("watch: trace %v: %s", t.ID.Trace, err)
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+			return // client disconnected
+		}
+		flusher.Flush()
+	}
+}
+
+// serveZipkinSpans collects the spans of a Zipkin v2 JSON request into the
+// store, so that services that are instrumented with a Zipkin tracer can use
+// the app as their Zipkin server.
+func (a *App) serveZipkinSpans(w http.ResponseWriter, r *http.Request) error {
+	h := &zipkin.Handler{Collector: a.Store, Log: a.Log}
+	h.ServeHTTP(w, r)
+	return nil
+}
+
+// writeJSON writes v to w as JSON.
+func writeJSON(w http.ResponseWriter, v interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(v)
+}
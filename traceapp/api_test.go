@@ -0,0 +1,46 @@
+package traceapp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/export"
+)
+
+func TestServeTraceChrome(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	collectTestTrace(t, ms, 1, "a", time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC))
+	srv, _ := newTestServer(t, ms)
+	defer srv.Close()
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/traces/0000000000000001/chrome.json", http.StatusOK},
+		{"/traces/0000000000000001/chrome.json?tracks=service", http.StatusOK},
+		{"/traces/0000000000000001/chrome.json?tracks=foo", http.StatusBadRequest},
+		{"/traces/xyz/chrome.json", http.StatusBadRequest},
+		{"/traces/0000000000000002/chrome.json", http.StatusNotFound},
+	}
+	for _, test := range tests {
+		resp, err := http.Get(srv.URL + test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != test.want {
+			t.Errorf("%s: got status %d, want %d", test.path, resp.StatusCode, test.want)
+		} else if resp.StatusCode == http.StatusOK {
+			var ct export.ChromeTrace
+			if err := json.NewDecoder(resp.Body).Decode(&ct); err != nil {
+				t.Errorf("%s: %s", test.path, err)
+			} else if len(ct.TraceEvents) == 0 {
+				t.Errorf("%s: got no trace events", test.path)
+			}
+		}
+		resp.Body.Close()
+	}
+}
@@ -25,8 +25,10 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -83,11 +85,22 @@ func New(r *Router, base *url.URL) (*App, error) {
 	r.r.Get(TraceSpanRoute).Handler(handlerFunc(app.serveTrace))
 	r.r.Get(TraceProfileRoute).Handler(handlerFunc(app.serveTrace))
 	r.r.Get(TraceSpanProfileRoute).Handler(handlerFunc(app.serveTrace))
+	r.r.Get(TraceChromeRoute).Handler(handlerFunc(app.serveTraceChrome))
 	r.r.Get(TraceUploadRoute).Handler(handlerFunc(app.serveTraceUpload))
+	r.r.Get(TraceDiffRoute).Handler(handlerFunc(app.serveTraceDiff))
 	r.r.Get(TracesRoute).Handler(handlerFunc(app.serveTraces))
 	r.r.Get(DashboardRoute).Handler(handlerFunc(app.serveDashboard))
 	r.r.Get(DashboardDataRoute).Handler(handlerFunc(app.serveDashboardData))
 	r.r.Get(AggregateRoute).Handler(handlerFunc(app.serveAggregate))
+	r.r.Get(FlameGraphRoute).Handler(handlerFunc(app.serveFlameGraph))
+	r.r.Get(FlameGraphFoldedRoute).Handler(handlerFunc(app.serveFlameGraphFolded))
+	r.r.Get(IncompleteRoute).Handler(handlerFunc(app.serveIncomplete))
+	r.r.Get(StatsRoute).Handler(handlerFunc(app.serveStats))
+	r.r.Get(TraceAPIRoute).Handler(handlerFunc(app.serveTraceAPI))
+	r.r.Get(TracesAPIRoute).Handler(handlerFunc(app.serveTracesAPI))
+	r.r.Get(TracesWatchAPIRoute).Handler(http.HandlerFunc(app.serveTracesWatchAPI))
+	r.r.Get(StatsAPIRoute).Handler(handlerFunc(app.serveStatsAPI))
+	r.r.Get(ZipkinSpansRoute).Handler(handlerFunc(app.serveZipkinSpans))
 
 	// Static file serving.
 	r.r.Get(StaticRoute).Handler(http.StripPrefix("/static/", http.FileServer(static.Data)))
@@ -136,8 +149,12 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	// Get sub-span if the Span route var is present.
-	if spanIDStr := v["Span"]; spanIDStr != "" {
+	// Get sub-span if the Span route var is present. Otherwise, report the
+	// spans missing from the trace, if any.
+	var missing []appdash.ID
+	if spanIDStr := v["Span"]; spanIDStr == "" {
+		missing = trace.MissingSpans()
+	} else {
 		var spanID appdash.ID
 		if spanID, err = appdash.ParseID(spanIDStr); err != nil {
 			return err
@@ -168,6 +185,16 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
+	// Mark the spans on the critical path in the timeline.
+	criticalPath := trace.CriticalPath()
+	critical := map[string]bool{}
+	for _, s := range criticalPath {
+		critical[s.Span.Span.ID.Span.String()] = true
+	}
+	for i := range visData {
+		visData[i].Critical = critical[visData[i].SpanID]
+	}
+
 	// Determine the profile URL.
 	var profile *url.URL
 	if trace.ID.Parent == 0 {
@@ -201,6 +228,11 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 	}
 	permalink.RawQuery = "permalink=" + buf.String()
 
+	chromeTrace, err := a.URLToTraceChrome(trace.ID.Trace)
+	if err != nil {
+		return err
+	}
+
 	return a.renderTemplate(w, r, "trace.html", http.StatusOK, &struct {
 		TemplateCommon
 		Trace             *appdash.Trace
@@ -209,6 +241,9 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 		ProfileURL        string
 		Permalink         string
 		JSONTrace         string
+		ChromeTraceURL    string
+		Missing           []appdash.ID
+		CriticalPath      []appdash.CriticalPathSegment
 	}{
 		Trace:             trace,
 		ShowTimelineChart: showTimelineChart,
@@ -216,6 +251,9 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 		ProfileURL:        profile.String(),
 		Permalink:         permalink.String(),
 		JSONTrace:         string(jsonTrace),
+		ChromeTraceURL:    chromeTrace.String(),
+		Missing:           missing,
+		CriticalPath:      criticalPath,
 	})
 }
 
@@ -232,33 +270,202 @@ func (a *App) serveTraces(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 
-	traces, err := a.Queryer.Traces(appdash.TracesOpts{
-		TraceIDs: showJust,
+	opts, err := parseTracesOpts(r.URL.Query(), appdash.TracesOpts{
+		Desc:  true,
+		Limit: defaultTracesLimit,
 	})
 	if err != nil {
 		return err
 	}
+	opts.TraceIDs = showJust
+
+	// Validate the search query up front, so that a mistyped query is shown to
+	// the user rather than failing the whole page.
+	var queryErr error
+	if opts.Query != "" {
+		_, queryErr = appdash.ParseQuery(opts.Query)
+	}
+
+	var (
+		traces     []*appdash.Trace
+		sourceErrs appdash.MultiError
+		hasNext    bool
+	)
+	if queryErr == nil {
+		// Request one more trace than is displayed, so that we know whether or
+		// not there is a next page.
+		opts.Limit++
+		traces, err = a.Queryer.Traces(opts)
+		if merr, ok := err.(appdash.MultiError); ok && traces != nil {
+			// Some of the sources of a MultiQueryer failed, so show the traces
+			// from the others along with the errors.
+			sourceErrs = merr
+		} else if err != nil {
+			return err
+		}
+		opts.Limit--
+		hasNext = len(traces) > opts.Limit
+		if hasNext {
+			traces = traces[:opts.Limit]
+		}
+	}
+
+	// Build the URLs to the previous and next pages, if any.
+	var prevURL, nextURL string
+	pageURL := func(offset int) string {
+		u := *r.URL
+		q := u.Query()
+		q.Set("offset", strconv.Itoa(offset))
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+	if opts.Offset > 0 {
+		prev := opts.Offset - opts.Limit
+		if prev < 0 {
+			prev = 0
+		}
+		prevURL = pageURL(prev)
+	}
+	if hasNext {
+		nextURL = pageURL(opts.Offset + opts.Limit)
+	}
 
 	return a.renderTemplate(w, r, "traces.html", http.StatusOK, &struct {
 		TemplateCommon
-		Traces  []*appdash.Trace
-		Visible func(*appdash.Trace) bool
+		Traces     []*appdash.Trace
+		Visible    func(*appdash.Trace) bool
+		Query      string
+		QueryErr   error
+		SourceErrs appdash.MultiError
+		Sort       string
+		Desc       bool
+		PrevURL    string
+		NextURL    string
 	}{
 		Traces: traces,
 		Visible: func(t *appdash.Trace) bool {
 			return true
 		},
+		Query:      opts.Query,
+		QueryErr:   queryErr,
+		SourceErrs: sourceErrs,
+		Sort:       opts.Sort.String(),
+		Desc:       opts.Desc,
+		PrevURL:    prevURL,
+		NextURL:    nextURL,
 	})
 }
 
+// defaultTracesLimit is the number of traces displayed per page on the traces
+// page, unless specified otherwise.
+const defaultTracesLimit = 100
+
+// parseTracesOpts parses the search, sorting and pagination options of the
+// traces page (and the traces API) from the given URL query, starting from the
+// given default options:
+//
+//	q=query (see appdash.Query)
+//	sort=start|duration|name
+//	order=asc|desc
+//	offset=N
+//	limit=N
+//	start=time, end=time (RFC 3339, see appdash.TracesOpts.Timespan)
+//
+// The traces page shows the most recent traces first, 100 per page, by
+// default.
+func parseTracesOpts(q url.Values, opts appdash.TracesOpts) (appdash.TracesOpts, error) {
+	if s := strings.TrimSpace(q.Get("q")); s != "" {
+		opts.Query = s
+	}
+	if s := q.Get("sort"); s != "" {
+		key, err := appdash.ParseTraceSortKey(s)
+		if err != nil {
+			return opts, err
+		}
+		opts.Sort = key
+	}
+	switch order := q.Get("order"); order {
+	case "":
+	case "desc":
+		opts.Desc = true
+	case "asc":
+		opts.Desc = false
+	default:
+		return opts, fmt.Errorf("invalid sort order: %q", order)
+	}
+	if s := q.Get("offset"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil || v < 0 {
+			return opts, fmt.Errorf("invalid offset: %q", s)
+		}
+		opts.Offset = v
+	}
+	if s := q.Get("limit"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil || v <= 0 {
+			return opts, fmt.Errorf("invalid limit: %q", s)
+		}
+		opts.Limit = v
+	}
+	for _, p := range []struct {
+		name string
+		t    *time.Time
+	}{{"start", &opts.Timespan.S}, {"end", &opts.Timespan.E}} {
+		if s := q.Get(p.name); s != "" {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return opts, fmt.Errorf("invalid %s time: %q", p.name, s)
+			}
+			*p.t = t
+		}
+	}
+	return opts, nil
+}
+
 func (a *App) serveAggregate(w http.ResponseWriter, r *http.Request) error {
-	// By default we display all traces.
-	traces, err := a.Queryer.Traces(appdash.TracesOpts{})
+	q := r.URL.Query()
+	traces, err := a.selectedTraces(q, "aggregate")
 	if err != nil {
 		return err
 	}
 
-	q := r.URL.Query()
+	// Perform the aggregation and render the data.
+	aggregated, err := a.aggregate(traces, parseAggMode(q.Get("view-mode")))
+	if err != nil {
+		return err
+	}
+
+	// The flame graph of the same traces.
+	flameGraph, err := a.URLTo(FlameGraphRoute)
+	if err != nil {
+		return err
+	}
+	if selection := q.Get("selection"); selection != "" {
+		flameGraph.RawQuery = url.Values{"selection": {selection}}.Encode()
+	}
+
+	return a.renderTemplate(w, r, "aggregate.html", http.StatusOK, &struct {
+		TemplateCommon
+		Aggregated    []*aggItem
+		FlameGraphURL string
+	}{
+		Aggregated:    aggregated,
+		FlameGraphURL: flameGraph.String(),
+	})
+}
+
+// selectedTraces returns the traces that are shown by the aggregate views:
+// all traces, or only those listed in the comma-separated "selection" URL
+// query parameter. The view name is used in the log message for traces that
+// could not be loaded.
+func (a *App) selectedTraces(q url.Values, view string) ([]*appdash.Trace, error) {
+	// By default we display all traces.
+	traces, err := a.Queryer.Traces(appdash.TracesOpts{})
+	if merr, ok := err.(appdash.MultiError); ok && traces != nil {
+		a.Log.Printf("%s: some traces could not be loaded: %s", view, merr)
+	} else if err != nil {
+		return nil, err
+	}
 
 	// If they specified a comma-separated list of specific trace IDs that they
 	// are interested in, then we only show those.
@@ -266,9 +473,9 @@ func (a *App) serveAggregate(w http.ResponseWriter, r *http.Request) error {
 	if len(selection) > 0 {
 		var selected []*appdash.Trace
 		for _, idStr := range strings.Split(selection, ",") {
-			var id appdash.ID
-			if id, err = appdash.ParseID(idStr); err != nil {
-				return err
+			id, err := appdash.ParseID(idStr)
+			if err != nil {
+				return nil, err
 			}
 			for _, t := range traces {
 				if t.Span.ID.Trace == id {
@@ -278,18 +485,7 @@ func (a *App) serveAggregate(w http.ResponseWriter, r *http.Request) error {
 		}
 		traces = selected
 	}
-
-	// Perform the aggregation and render the data.
-	aggregated, err := a.aggregate(traces, parseAggMode(q.Get("view-mode")))
-	if err != nil {
-		return err
-	}
-	return a.renderTemplate(w, r, "aggregate.html", http.StatusOK, &struct {
-		TemplateCommon
-		Aggregated []*aggItem
-	}{
-		Aggregated: aggregated,
-	})
+	return traces, nil
 }
 
 func (a *App) serveTraceUpload(w http.ResponseWriter, r *http.Request) error {
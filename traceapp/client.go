@@ -0,0 +1,161 @@
+package traceapp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// defaultClient is the HTTP client of a Client without an HTTPClient. Unlike
+// http.DefaultClient, it has a timeout, so that an unresponsive server doesn't
+// block the queries of an appdash.MultiQueryer (or MultiStore) that use it.
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// Client is an appdash.Store and appdash.Queryer that retrieves traces from a
+// remote traceapp application using its JSON API. It can be combined with
+// local stores using appdash.MultiStore and appdash.MultiQueryer to show the
+// traces of several Appdash servers in a single web UI.
+//
+// Client is read-only: its Collect method always returns an error. Spans are
+// sent to a remote Appdash server using appdash.NewRemoteCollector instead.
+type Client struct {
+	// HTTPClient is the HTTP client used to make requests. If nil, a client
+	// with a timeout of 10 seconds is used.
+	HTTPClient *http.Client
+
+	base   *url.URL
+	router *Router
+}
+
+// NewClient creates a new client for the traceapp application served under
+// the given base URL (see New).
+func NewClient(base *url.URL) *Client {
+	cpy := *base
+	return &Client{
+		base:   &cpy,
+		router: NewRouter(nil),
+	}
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	appdash.Store
+	appdash.Queryer
+} = (*Client)(nil)
+
+// errClientReadOnly is returned by Client.Collect.
+var errClientReadOnly = errors.New("traceapp: Client is read-only and cannot collect spans")
+
+// Collect implements the appdash.Collector interface by returning an error, as
+// Client is read-only.
+func (c *Client) Collect(id appdash.SpanID, anns ...appdash.Annotation) error {
+	return errClientReadOnly
+}
+
+// Trace implements the appdash.Store interface by retrieving the trace from
+// the remote application.
+func (c *Client) Trace(id appdash.ID) (*appdash.Trace, error) {
+	u, err := c.router.URLToTraceAPI(id)
+	if err != nil {
+		return nil, err
+	}
+	var trace *appdash.Trace
+	if err := c.get(u, &trace); err != nil {
+		return nil, err
+	}
+	return trace, nil
+}
+
+// Traces implements the appdash.Queryer interface by querying the remote
+// application for traces. All of the options are passed through, so the
+// remote application does the filtering, sorting and pagination.
+func (c *Client) Traces(opts appdash.TracesOpts) ([]*appdash.Trace, error) {
+	u, err := c.router.URLTo(TracesAPIRoute)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = encodeTracesOpts(opts).Encode()
+	var traces []*appdash.Trace
+	if err := c.get(u, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// String returns the base URL of the remote application.
+func (c *Client) String() string {
+	return c.base.String()
+}
+
+// get fetches the JSON data at the given route URL (relative to the base URL)
+// and decodes it into v. A 404 Not Found response is reported as
+// appdash.ErrTraceNotFound.
+func (c *Client) get(route *url.URL, v interface{}) error {
+	u := *c.base
+	u.Path = path.Join("/", c.base.Path, route.Path)
+	u.RawQuery = route.RawQuery
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = defaultClient
+	}
+	resp, err := hc.Get(u.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return json.NewDecoder(resp.Body).Decode(v)
+	case http.StatusNotFound:
+		return appdash.ErrTraceNotFound
+	default:
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("traceapp: GET %s: %s: %s", u.String(), resp.Status, strings.TrimSpace(string(body)))
+	}
+}
+
+// encodeTracesOpts encodes opts as the URL query parameters accepted by the
+// traces API (see serveTracesAPI).
+func encodeTracesOpts(opts appdash.TracesOpts) url.Values {
+	q := url.Values{}
+	if opts.Query != "" {
+		q.Set("q", opts.Query)
+	}
+	if opts.Sort != appdash.SortByStartTime {
+		q.Set("sort", opts.Sort.String())
+	}
+	if opts.Desc {
+		q.Set("order", "desc")
+	}
+	if opts.Offset > 0 {
+		q.Set("offset", strconv.Itoa(opts.Offset))
+	}
+	if opts.Limit > 0 {
+		q.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if !opts.Timespan.S.IsZero() {
+		q.Set("start", opts.Timespan.S.Format(time.RFC3339Nano))
+	}
+	if !opts.Timespan.E.IsZero() {
+		q.Set("end", opts.Timespan.E.Format(time.RFC3339Nano))
+	}
+	if len(opts.TraceIDs) > 0 {
+		ids := make([]string, len(opts.TraceIDs))
+		for i, id := range opts.TraceIDs {
+			ids[i] = id.String()
+		}
+		q.Set("trace", strings.Join(ids, ","))
+	}
+	return q
+}
@@ -0,0 +1,150 @@
+package traceapp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// newTestServer starts a traceapp application that serves the traces in ms,
+// and returns a client for it.
+func newTestServer(t *testing.T, ms *appdash.MemoryStore) (*httptest.Server, *Client) {
+	app, err := New(nil, &url.URL{Scheme: "http", Host: "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Store, app.Queryer = ms, ms
+	srv := httptest.NewServer(app)
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, NewClient(u)
+}
+
+// collectTestTrace records a root span named name in ms, starting at the given
+// time.
+func collectTestTrace(t *testing.T, ms *appdash.MemoryStore, id appdash.ID, name string, start time.Time) {
+	rec := appdash.NewRecorder(appdash.SpanID{Trace: id, Span: id}, ms)
+	rec.Name(name)
+	rec.Event(appdash.Timespan{S: start, E: start.Add(time.Second)})
+	rec.Finish()
+	if errs := rec.Errors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+}
+
+// traceIDs returns the IDs of the traces.
+func traceIDs(traces []*appdash.Trace) []appdash.ID {
+	ids := make([]appdash.ID, len(traces))
+	for i, t := range traces {
+		ids[i] = t.ID.Trace
+	}
+	return ids
+}
+
+func TestClient(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	collectTestTrace(t, ms, 1, "a", base)
+	collectTestTrace(t, ms, 2, "b", base.Add(time.Minute))
+	collectTestTrace(t, ms, 3, "a", base.Add(2*time.Minute))
+	srv, c := newTestServer(t, ms)
+	defer srv.Close()
+
+	want, err := ms.Trace(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.Trace(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Trace: got %v, want %v", got, want)
+	}
+	if _, err := c.Trace(4); err != appdash.ErrTraceNotFound {
+		t.Errorf("Trace(4): got err %v, want ErrTraceNotFound", err)
+	}
+
+	tests := []struct {
+		opts appdash.TracesOpts
+		want []appdash.ID
+	}{
+		{opts: appdash.TracesOpts{}, want: []appdash.ID{1, 2, 3}},
+		{opts: appdash.TracesOpts{Query: "name:a", Desc: true}, want: []appdash.ID{3, 1}},
+		{opts: appdash.TracesOpts{Offset: 1, Limit: 1}, want: []appdash.ID{2}},
+		{opts: appdash.TracesOpts{TraceIDs: []appdash.ID{3, 1}}, want: []appdash.ID{1, 3}},
+		{opts: appdash.TracesOpts{Timespan: appdash.Timespan{S: base.Add(30 * time.Second)}}, want: []appdash.ID{2, 3}},
+	}
+	for _, test := range tests {
+		traces, err := c.Traces(test.opts)
+		if err != nil {
+			t.Errorf("Traces(%+v): %s", test.opts, err)
+			continue
+		}
+		if got := traceIDs(traces); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Traces(%+v): got traces %v, want %v", test.opts, got, test.want)
+		}
+	}
+
+	// Invalid options are reported as a 400 Bad Request.
+	if _, err := c.Traces(appdash.TracesOpts{Query: "name:("}); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Errorf("Traces with invalid query: got err %v, want 400 Bad Request", err)
+	}
+	for _, path := range []string{"/api/traces?limit=x", "/api/traces?trace=x", "/api/traces/x"} {
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("GET %s: got status %d, want %d", path, resp.StatusCode, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestClient_MultiQueryer(t *testing.T) {
+	ms1, ms2 := appdash.NewMemoryStore(), appdash.NewMemoryStore()
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	collectTestTrace(t, ms1, 1, "a", base)
+	collectTestTrace(t, ms2, 2, "b", base.Add(time.Minute))
+	collectTestTrace(t, ms1, 3, "a", base.Add(2*time.Minute))
+	srv1, c1 := newTestServer(t, ms1)
+	defer srv1.Close()
+	srv2, c2 := newTestServer(t, ms2)
+	defer srv2.Close()
+
+	mq := appdash.MultiQueryer(c1, c2)
+	traces, err := mq.Traces(appdash.TracesOpts{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := traceIDs(traces), []appdash.ID{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+
+	// When a server is down, the traces of the others are returned along
+	// with its error.
+	srv2.Close()
+	traces, err = mq.Traces(appdash.TracesOpts{})
+	if got, want := traceIDs(traces), []appdash.ID{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+	merr, ok := err.(appdash.MultiError)
+	if !ok || len(merr) != 1 {
+		t.Fatalf("got err %v, want MultiError with 1 error", err)
+	}
+	if merr[0].Index != 1 || merr[0].Source != c2 {
+		t.Errorf("got error from source %d (%v), want source 1 (%v)", merr[0].Index, merr[0].Source, c2)
+	}
+	if !strings.Contains(merr[0].Error(), srv2.URL) {
+		t.Errorf("got error %q, want it to mention %s", merr[0], srv2.URL)
+	}
+}
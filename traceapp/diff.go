@@ -0,0 +1,151 @@
+package traceapp
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// diffRow is a row in the table of differences on the trace diff page.
+type diffRow struct {
+	*appdash.TraceDiff
+	Depth  int
+	Status string // "added", "removed", "slower", "faster" or empty
+}
+
+// defaultDiffMinDelta is the default minimum change in duration for a span
+// that is present in both traces to be marked as slower or faster on the trace
+// diff page, so that timing jitter doesn't mark every span as changed.
+const defaultDiffMinDelta = time.Millisecond
+
+// serveTraceDiff serves a side-by-side comparison of two traces, A and B (see
+// appdash.DiffTraces). The "min_delta" URL query parameter (a duration, 1ms by
+// default) is the minimum change in duration for a span to be marked as
+// slower or faster. If a trace ID or min_delta is invalid, a 400 Bad Request
+// response is sent, and if either trace does not exist, a 404 Not Found
+// response is sent.
+func (a *App) serveTraceDiff(w http.ResponseWriter, r *http.Request) error {
+	minDelta := defaultDiffMinDelta
+	if v := r.URL.Query().Get("min_delta"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			http.Error(w, fmt.Sprintf("invalid min_delta %q (want a non-negative duration)", v), http.StatusBadRequest)
+			return nil
+		}
+		minDelta = d
+	}
+
+	v := mux.Vars(r)
+	var traces [2]*appdash.Trace
+	for i, name := range []string{"A", "B"} {
+		id, err := appdash.ParseID(v[name])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return nil
+		}
+		traces[i], err = a.Store.Trace(id)
+		if err == appdash.ErrTraceNotFound {
+			http.Error(w, fmt.Sprintf("trace %s: %s", id, err), http.StatusNotFound)
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("trace %s: %s", id, err)
+		}
+	}
+	diff := appdash.DiffTraces(traces[0], traces[1])
+
+	// Changes reports the spans that were added, removed or got slower by
+	// more than minDelta; the spans that got faster by as much are marked,
+	// too.
+	changed := map[*appdash.TraceDiff]struct{}{}
+	for _, d := range diff.Changes(minDelta) {
+		changed[d] = struct{}{}
+	}
+
+	// Flatten the diff into table rows, and record the status of each span so
+	// that the changed spans can be marked on the timelines.
+	var (
+		rows   []*diffRow
+		status = [2]map[appdash.ID]string{{}, {}}
+	)
+	var walk func(d *appdash.TraceDiff, depth int)
+	walk = func(d *appdash.TraceDiff, depth int) {
+		row := &diffRow{TraceDiff: d, Depth: depth}
+		_, isChanged := changed[d]
+		switch {
+		case isChanged && d.Added():
+			row.Status = "added"
+		case isChanged && d.Removed():
+			row.Status = "removed"
+		case isChanged:
+			row.Status = "slower"
+		case d.Delta() < -minDelta:
+			row.Status = "faster"
+		}
+		rows = append(rows, row)
+		if row.Status != "" {
+			if d.A != nil {
+				status[0][d.A.Span.ID.Span] = row.Status
+			}
+			if d.B != nil {
+				status[1][d.B.Span.ID.Span] = row.Status
+			}
+		}
+		for _, sub := range d.Sub {
+			walk(sub, depth+1)
+		}
+	}
+	walk(diff, 0)
+
+	// Render both traces using the same timeline as the trace page.
+	var (
+		visData           [2][]timelineItem
+		showTimelineChart [2]bool
+	)
+	for i, t := range traces {
+		data, err := a.d3timeline(t)
+		switch err {
+		case errTimelineItemValidation:
+			continue
+		case nil:
+			break
+		default:
+			return err
+		}
+		for j := range data {
+			item := &data[j]
+			id, err := appdash.ParseID(item.SpanID)
+			if err != nil {
+				return err
+			}
+			if s := status[i][id]; s != "" {
+				item.FullLabel = fmt.Sprintf("%s [%s]", item.FullLabel, s)
+				for _, ts := range item.Times {
+					ts.Label = fmt.Sprintf("%s [%s]", ts.Label, s)
+				}
+			}
+		}
+		visData[i], showTimelineChart[i] = data, true
+	}
+
+	return a.renderTemplate(w, r, "diff.html", http.StatusOK, &struct {
+		TemplateCommon
+		A, B                                   *appdash.Trace
+		Rows                                   []*diffRow
+		MinDelta                               time.Duration
+		VisDataA, VisDataB                     []timelineItem
+		ShowTimelineChartA, ShowTimelineChartB bool
+	}{
+		A:                  traces[0],
+		B:                  traces[1],
+		Rows:               rows,
+		MinDelta:           minDelta,
+		VisDataA:           visData[0],
+		VisDataB:           visData[1],
+		ShowTimelineChartA: showTimelineChart[0],
+		ShowTimelineChartB: showTimelineChart[1],
+	})
+}
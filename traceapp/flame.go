@@ -0,0 +1,53 @@
+package traceapp
+
+import (
+	"net/http"
+	"net/url"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// serveFlameGraph serves the flame graph page, which shows the self time of
+// the spans of all (or the selected) traces, aggregated by their root-to-leaf
+// span name paths. The traces are selected as on the aggregate page (see
+// selectedTraces).
+func (a *App) serveFlameGraph(w http.ResponseWriter, r *http.Request) error {
+	q := r.URL.Query()
+	traces, err := a.selectedTraces(q, "flamegraph")
+	if err != nil {
+		return err
+	}
+
+	// The folded stacks download is for the same traces.
+	folded, err := a.URLTo(FlameGraphFoldedRoute)
+	if err != nil {
+		return err
+	}
+	if selection := q.Get("selection"); selection != "" {
+		folded.RawQuery = url.Values{"selection": {selection}}.Encode()
+	}
+
+	return a.renderTemplate(w, r, "flamegraph.html", http.StatusOK, &struct {
+		TemplateCommon
+		FlameGraph *appdash.FlameGraph
+		Traces     int
+		FoldedURL  string
+	}{
+		FlameGraph: appdash.NewFlameGraph(traces...),
+		Traces:     len(traces),
+		FoldedURL:  folded.String(),
+	})
+}
+
+// serveFlameGraphFolded serves the flame graph of the selected traces as a
+// download in the folded stack format, for use with flamegraph.pl and other
+// flame graph tools.
+func (a *App) serveFlameGraphFolded(w http.ResponseWriter, r *http.Request) error {
+	traces, err := a.selectedTraces(r.URL.Query(), "flamegraph")
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="appdash.folded"`)
+	return appdash.NewFlameGraph(traces...).WriteFolded(w)
+}
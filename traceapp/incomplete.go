@@ -0,0 +1,101 @@
+package traceapp
+
+import (
+	"net/http"
+	"sort"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// incompleteTrace is a trace that is missing spans, as listed on the
+// incomplete traces page.
+type incompleteTrace struct {
+	*appdash.Trace
+	Orphans []*appdash.Trace // spans whose parent span is missing
+	Missing []appdash.ID     // IDs of the missing parent spans
+}
+
+// orphanCount is the number of incomplete traces that contain an orphan span
+// with the given name.
+type orphanCount struct {
+	Name   string
+	Traces int
+}
+
+// serveIncomplete serves the incomplete traces page, which lists the traces
+// that are missing spans, most recent first. It also summarizes the names of
+// the orphan spans, as they point to the services that are called by a service
+// that does not propagate span IDs.
+func (a *App) serveIncomplete(w http.ResponseWriter, r *http.Request) error {
+	// Only the search query and timespan options are used, as all of the
+	// incomplete traces are listed.
+	opts, err := parseTracesOpts(r.URL.Query(), appdash.TracesOpts{})
+	if err != nil {
+		return err
+	}
+	traces, err := a.Queryer.Traces(appdash.TracesOpts{
+		Timespan: opts.Timespan,
+		Query:    opts.Query,
+		Desc:     true,
+	})
+	if merr, ok := err.(appdash.MultiError); ok && traces != nil {
+		a.Log.Printf("incomplete: some traces could not be loaded: %s", merr)
+	} else if err != nil {
+		return err
+	}
+
+	var (
+		incomplete []*incompleteTrace
+		counts     = map[string]int{}
+	)
+	for _, t := range traces {
+		orphans := t.Orphans()
+		if len(orphans) == 0 {
+			continue
+		}
+		incomplete = append(incomplete, &incompleteTrace{
+			Trace:   t,
+			Orphans: orphans,
+			Missing: t.MissingSpans(),
+		})
+
+		// Count each orphan span name once per trace.
+		names := map[string]struct{}{}
+		for _, o := range orphans {
+			names[o.Span.Name()] = struct{}{}
+		}
+		for name := range names {
+			counts[name]++
+		}
+	}
+
+	summary := make([]orphanCount, 0, len(counts))
+	for name, n := range counts {
+		summary = append(summary, orphanCount{Name: name, Traces: n})
+	}
+	sort.Sort(orphanCountsByTraces(summary))
+
+	return a.renderTemplate(w, r, "incomplete.html", http.StatusOK, &struct {
+		TemplateCommon
+		Traces  []*incompleteTrace
+		Summary []orphanCount
+		Total   int
+	}{
+		Traces:  incomplete,
+		Summary: summary,
+		Total:   len(traces),
+	})
+}
+
+// orphanCountsByTraces sorts orphan counts by decreasing number of traces, and
+// then by name.
+type orphanCountsByTraces []orphanCount
+
+func (o orphanCountsByTraces) Len() int      { return len(o) }
+func (o orphanCountsByTraces) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+func (o orphanCountsByTraces) Less(i, j int) bool {
+	if o[i].Traces != o[j].Traces {
+		return o[i].Traces > o[j].Traces
+	}
+	return o[i].Name < o[j].Name
+}
@@ -11,22 +11,32 @@ import (
 )
 
 type profile struct {
-	Name                        string
-	URL                         string
-	Time, TimeChildren, TimeCum int64
+	Name string
+	URL  string
+
+	// Time is the duration of the span (see appdash.Trace.Duration), from the
+	// earliest start to the latest end of its timespan events. For a span
+	// with several timespan events (e.g. both a client and a server event),
+	// this covers all of them, rather than only the longest one.
+	Time int64
+
+	TimeChildren, TimeCum int64
+
+	// TimeSelf is the time that the span spent without any of its children
+	// running (see appdash.Trace.SelfTime).
+	TimeSelf int64
+
+	// TimeCritical is the time that the span itself (excluding its children)
+	// was on the critical path of the trace (see appdash.Trace.CriticalPath).
+	TimeCritical int64
 }
 
 // calcProfile calculates a profile for the given trace and appends it to the
 // given buffer (buf), which is then returned (prof). If an error is returned,
 // all other returned values are nil. The childProf is literally the *profile
-// associated with the given trace (t).
-func (a *App) calcProfile(buf []*profile, t *appdash.Trace) (prof []*profile, childProf *profile, err error) {
-	// Unmarshal the trace's span events.
-	var events []appdash.Event
-	if err = appdash.UnmarshalEvents(t.Span.Annotations, &events); err != nil {
-		return nil, nil, err
-	}
-
+// associated with the given trace (t). The critical map holds the time that
+// each span was on the critical path.
+func (a *App) calcProfile(buf []*profile, t *appdash.Trace, critical map[appdash.ID]time.Duration) (prof []*profile, childProf *profile, err error) {
 	// Get the proper URL to the trace view.
 	var u *url.URL
 	if t.ID.Parent == 0 {
@@ -52,19 +62,9 @@ func (a *App) calcProfile(buf []*profile, t *appdash.Trace) (prof []*profile, ch
 	}
 	buf = append(buf, p)
 
-	// Store the time for the largest timespan event the span has.
-	for _, ev := range events {
-		ts, ok := ev.(appdash.TimespanEvent)
-		if !ok {
-			continue
-		}
-		// To match the timeline properly we use floats and round up.
-		msf := float64(ts.End().Sub(ts.Start())) / float64(time.Millisecond)
-		ms := int64(msf + 0.5)
-		if ms > p.Time {
-			p.Time = ms
-		}
-	}
+	p.Time = msec(t.Duration())
+	p.TimeSelf = msec(t.SelfTime())
+	p.TimeCritical = msec(critical[t.Span.ID.Span])
 
 	// TimeChildren is our time + the children's time.
 	p.TimeChildren = p.Time
@@ -75,7 +75,7 @@ func (a *App) calcProfile(buf []*profile, t *appdash.Trace) (prof []*profile, ch
 	// Descend recursively into each sub-trace and calculate the profile for
 	// each child span.
 	for _, child := range t.Sub {
-		buf, childProf, err = a.calcProfile(buf, child)
+		buf, childProf, err = a.calcProfile(buf, child, critical)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -94,7 +94,11 @@ func (a *App) calcProfile(buf []*profile, t *appdash.Trace) (prof []*profile, ch
 // profile generates and encodes the given trace as JSON to the given writer.
 func (a *App) profile(t *appdash.Trace, out io.Writer) error {
 	// Generate the profile.
-	prof, _, err := a.calcProfile(nil, t)
+	critical := map[appdash.ID]time.Duration{}
+	for _, s := range t.CriticalPath() {
+		critical[s.Span.Span.ID.Span] += s.Duration()
+	}
+	prof, _, err := a.calcProfile(nil, t, critical)
 	if err != nil {
 		return err
 	}
@@ -109,3 +113,10 @@ func (a *App) profile(t *appdash.Trace, out io.Writer) error {
 	_, err = io.Copy(out, bytes.NewReader(j))
 	return err
 }
+
+// msec returns d in milliseconds. To match the timeline properly we use floats
+// and round up.
+func msec(d time.Duration) int64 {
+	msf := float64(d) / float64(time.Millisecond)
+	return int64(msf + 0.5)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
 )
 
 // Traceapp's route names.
@@ -16,11 +17,22 @@ const (
 	TraceSpanRoute        = "traceapp.trace.span"         // route name for a single trace sub-span page
 	TraceProfileRoute     = "traceapp.trace.profile"      // route name for a JSON trace profile
 	TraceSpanProfileRoute = "traceapp.trace.span.profile" // route name for a JSON trace sub-span profile
+	TraceChromeRoute      = "traceapp.trace.chrome"       // route name for a trace in the Chrome trace event format
 	TraceUploadRoute      = "traceapp.trace.upload"       // route name for a JSON trace upload
+	TraceDiffRoute        = "traceapp.trace.diff"         // route name for a comparison of two traces
 	TracesRoute           = "traceapp.traces"             // route name for traces page
 	DashboardRoute        = "traceapp.dashboard"          // route name for dashboard page
 	DashboardDataRoute    = "traceapp.dashboard.data"     // route name for dashboard JSON data
 	AggregateRoute        = "traceapp.aggregate"          // route name for aggregate trace view
+	FlameGraphRoute       = "traceapp.flamegraph"         // route name for flame graph page
+	FlameGraphFoldedRoute = "traceapp.flamegraph.folded"  // route name for flame graph folded stacks download
+	IncompleteRoute       = "traceapp.incomplete"         // route name for incomplete traces page
+	StatsRoute            = "traceapp.stats"              // route name for store statistics page
+	TraceAPIRoute         = "traceapp.api.trace"          // route name for a single JSON trace
+	TracesAPIRoute        = "traceapp.api.traces"         // route name for a JSON list of traces
+	TracesWatchAPIRoute   = "traceapp.api.traces.watch"   // route name for a stream of updated traces
+	StatsAPIRoute         = "traceapp.api.stats"          // route name for JSON store statistics
+	ZipkinSpansRoute      = "traceapp.api.zipkin.spans"   // route name for Zipkin v2 span ingestion
 )
 
 // Router is a URL router for traceapp applications. It should be created via
@@ -37,12 +49,23 @@ func NewRouter(base *mux.Router) *Router {
 	base.Path("/traces/{Trace}").Methods("GET").Name(TraceRoute)
 	base.Path("/traces/{Trace}/profile").Methods("GET").Name(TraceProfileRoute)
 	base.Path("/traces/{Trace}/{Span}/profile").Methods("GET").Name(TraceSpanProfileRoute)
+	base.Path("/traces/{Trace}/chrome.json").Methods("GET").Name(TraceChromeRoute)
 	base.Path("/traces/upload").Methods("POST").Name(TraceUploadRoute)
+	base.Path("/traces/{A}/diff/{B}").Methods("GET").Name(TraceDiffRoute)
 	base.Path("/traces/{Trace}/{Span}").Methods("GET").Name(TraceSpanRoute)
 	base.Path("/traces").Methods("GET").Name(TracesRoute)
 	base.Path("/dashboard").Methods("GET").Name(DashboardRoute)
 	base.Path("/dashboard/data").Methods("GET").Name(DashboardDataRoute)
 	base.Path("/aggregate").Methods("GET").Name(AggregateRoute)
+	base.Path("/flamegraph").Methods("GET").Name(FlameGraphRoute)
+	base.Path("/flamegraph/folded").Methods("GET").Name(FlameGraphFoldedRoute)
+	base.Path("/incomplete").Methods("GET").Name(IncompleteRoute)
+	base.Path("/stats").Methods("GET").Name(StatsRoute)
+	base.Path("/api/traces/watch").Methods("GET").Name(TracesWatchAPIRoute)
+	base.Path("/api/traces/{Trace}").Methods("GET").Name(TraceAPIRoute)
+	base.Path("/api/traces").Methods("GET").Name(TracesAPIRoute)
+	base.Path("/api/stats").Methods("GET").Name(StatsAPIRoute)
+	base.Path(zipkin.SpansPath).Methods("POST").Name(ZipkinSpansRoute)
 	return &Router{base}
 }
 
@@ -75,3 +98,19 @@ func (r *Router) URLToTraceProfile(trace appdash.ID) (*url.URL, error) {
 func (r *Router) URLToTraceSpanProfile(trace, span appdash.ID) (*url.URL, error) {
 	return r.r.Get(TraceSpanProfileRoute).URL("Trace", trace.String(), "Span", span.String())
 }
+
+// URLToTraceChrome constructs a URL to a trace in the Chrome trace event
+// format.
+func (r *Router) URLToTraceChrome(trace appdash.ID) (*url.URL, error) {
+	return r.r.Get(TraceChromeRoute).URL("Trace", trace.String())
+}
+
+// URLToTraceDiff constructs a URL to a comparison of trace a with trace b.
+func (r *Router) URLToTraceDiff(a, b appdash.ID) (*url.URL, error) {
+	return r.r.Get(TraceDiffRoute).URL("A", a.String(), "B", b.String())
+}
+
+// URLToTraceAPI constructs a URL to a trace's JSON data.
+func (r *Router) URLToTraceAPI(id appdash.ID) (*url.URL, error) {
+	return r.r.Get(TraceAPIRoute).URL("Trace", id.String())
+}
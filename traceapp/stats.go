@@ -0,0 +1,142 @@
+package traceapp
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// statsRow is a row in the table on the stats page.
+type statsRow struct {
+	Name  string
+	Value string
+	Trace appdash.ID // trace to link to, if non-zero
+
+	// Limit is the limit that Value is subject to, if any, and Percent is
+	// how much of it is used.
+	Limit   string
+	Percent int
+}
+
+// Class returns the Bootstrap contextual class for the row's usage bar.
+func (r *statsRow) Class() string {
+	switch {
+	case r.Percent >= 90:
+		return "danger"
+	case r.Percent >= 75:
+		return "warning"
+	default:
+		return "success"
+	}
+}
+
+// stats returns the statistics of the app's store, or appdash.ErrNoStats if
+// the store doesn't report any.
+func (a *App) stats() (*appdash.StoreStats, error) {
+	ss, ok := a.Store.(appdash.StatsStore)
+	if !ok {
+		return nil, appdash.ErrNoStats
+	}
+	return ss.Stats()
+}
+
+// serveStats serves the store statistics page, which shows how close the
+// store is to its limits.
+func (a *App) serveStats(w http.ResponseWriter, r *http.Request) error {
+	st, err := a.stats()
+	if err != nil && err != appdash.ErrNoStats {
+		return err
+	}
+
+	var rows []*statsRow
+	if st != nil {
+		now := time.Now()
+		traces := &statsRow{Name: "Traces", Value: fmt.Sprint(st.Traces)}
+		if st.MaxTraces > 0 {
+			traces.Limit = fmt.Sprint(st.MaxTraces)
+			traces.Percent = percent(float64(st.Traces), float64(st.MaxTraces))
+		}
+		size := &statsRow{Name: "Span data", Value: formatBytes(st.AnnotationBytes)}
+		if st.MaxBytes > 0 {
+			size.Limit = formatBytes(st.MaxBytes)
+			size.Percent = percent(float64(st.AnnotationBytes), float64(st.MaxBytes))
+		}
+		rows = append(rows, traces, &statsRow{Name: "Spans", Value: fmt.Sprint(st.Spans)}, size)
+		if st.DiskBytes > 0 {
+			rows = append(rows, &statsRow{Name: "Disk usage", Value: formatBytes(st.DiskBytes)})
+		}
+		if st.Oldest != 0 {
+			age := now.Sub(st.OldestTime)
+			oldest := &statsRow{Name: "Oldest trace", Value: fmt.Sprintf("collected %s ago", roundDuration(age)), Trace: st.Oldest}
+			if st.MaxAge > 0 {
+				oldest.Limit = st.MaxAge.String()
+				oldest.Percent = percent(float64(age), float64(st.MaxAge))
+			}
+			rows = append(rows, oldest, &statsRow{
+				Name:  "Newest trace",
+				Value: fmt.Sprintf("collected %s ago", roundDuration(now.Sub(st.NewestTime))),
+				Trace: st.Newest,
+			})
+		}
+		rows = append(rows,
+			&statsRow{Name: "Evicted traces", Value: fmt.Sprint(st.Evicted)},
+			&statsRow{Name: "Collections", Value: fmt.Sprintf("%d (%.1f/s over the last minute)", st.Collections, st.CollectionRate)},
+		)
+	}
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	rows = append(rows, &statsRow{Name: "Process heap", Value: formatBytes(int64(mem.HeapAlloc))})
+
+	return a.renderTemplate(w, r, "stats.html", http.StatusOK, &struct {
+		TemplateCommon
+		Rows    []*statsRow
+		NoStats bool
+	}{
+		Rows:    rows,
+		NoStats: st == nil,
+	})
+}
+
+// serveStatsAPI serves the store statistics as JSON. If the store doesn't
+// report any, a 501 Not Implemented response is sent.
+func (a *App) serveStatsAPI(w http.ResponseWriter, r *http.Request) error {
+	st, err := a.stats()
+	if err == appdash.ErrNoStats {
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return writeJSON(w, st)
+}
+
+// percent returns v as a percentage of max, capped at 100.
+func percent(v, max float64) int {
+	p := int(100 * v / max)
+	if p > 100 {
+		p = 100
+	}
+	return p
+}
+
+// roundDuration rounds d to the second.
+func roundDuration(d time.Duration) time.Duration {
+	return (d + time.Second/2) / time.Second * time.Second
+}
+
+// formatBytes returns n (in bytes) as a human readable string.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -31,6 +31,10 @@ var templates = [][]string{
 	{"traces.html", "layout.html"},
 	{"dashboard.html", "layout.html"},
 	{"aggregate.html", "layout.html"},
+	{"flamegraph.html", "layout.html"},
+	{"incomplete.html", "layout.html"},
+	{"diff.html", "layout.html"},
+	{"stats.html", "layout.html"},
 }
 
 // TemplateCommon is data that is passed to (and available to) all templates.
@@ -39,6 +43,7 @@ type TemplateCommon struct {
 	CurrentURI    *url.URL
 	BaseURL       *url.URL
 	HaveDashboard bool
+	HaveStats     bool
 }
 
 func (a *App) renderTemplate(w http.ResponseWriter, r *http.Request, name string, status int, data interface{}) error {
@@ -61,12 +66,15 @@ func (a *App) renderTemplate(w http.ResponseWriter, r *http.Request, name string
 	}
 
 	if data != nil {
+		_, haveStats := a.Store.(appdash.StatsStore)
+
 		// Set TemplateCommon values.
 		reflect.ValueOf(data).Elem().FieldByName("TemplateCommon").Set(reflect.ValueOf(TemplateCommon{
 			CurrentRoute:  mux.CurrentRoute(r).GetName(),
 			CurrentURI:    r.URL,
 			BaseURL:       a.baseURL,
 			HaveDashboard: a.Aggregator != nil,
+			HaveStats:     haveStats,
 		}))
 	}
 
@@ -88,6 +96,7 @@ func (a *App) parseHTMLTemplates(sets [][]string) error {
 		t.Funcs(htmpl.FuncMap{
 			"urlTo":             a.URLTo,
 			"urlToTrace":        a.URLToTrace,
+			"urlToTraceSpan":    a.URLToTraceSpan,
 			"itoa":              strconv.Itoa,
 			"str":               func(v interface{}) string { return fmt.Sprintf("%s", v) },
 			"durationClass":     durationClass,
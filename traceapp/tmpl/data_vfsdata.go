@@ -29,13 +29,13 @@ var Data = func() http.FileSystem {
 	fs := _vfsgen_fs{
 		"/": &_vfsgen_dirInfo{
 			name:    "/",
-			modTime: mustUnmarshalTextTime("2016-05-31T20:47:03Z"),
+			modTime: mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
 		},
 		"/aggregate.html": &_vfsgen_compressedFileInfo{
 			name:              "aggregate.html",
-			modTime:           mustUnmarshalTextTime("2015-10-04T03:50:12Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x57\x6d\x73\x13\x39\x12\xfe\x8c\x7f\x45\xa3\x04\x62\x73\x9e\x99\x98\x2b\xee\xc0\xd8\x4e\x71\x70\xc5\x5d\xd5\x51\xb9\x4b\xb8\xe5\x03\xc5\x07\x79\xa6\x3d\x16\x91\xa5\x59\x49\xe3\xc4\xeb\x9d\xff\xbe\x2d\x8d\xe7\xc5\x24\x6c\xed\x16\x5b\xb5\x8e\xdd\x6a\x3d\xdd\xfd\xf4\x9b\x18\xec\xf7\x19\xae\x84\x42\x60\x1f\x85\x93\xc8\xaa\xea\x4d\x9e\x1b\xcc\xb9\x43\xf8\x49\xe0\x2d\x44\xc0\x8b\x22\xe3\x76\xbd\xdf\xa3\xca\xaa\xaa\x77\xe3\x03\x17\x8a\x2e\x0c\x06\x33\xeb\x76\x12\xc1\xed\x0a\x9c\x33\x87\x77\x2e\x49\xad\x65\x8b\x01\xc0\xda\x6d\xe4\x78\xa9\xb3\x1d\xec\xe9\x17\x40\xf2\x0c\xde\x09\xcb\x97\xa4\xbd\x45\xe3\x44\xca\x25\xd8\xd4\x68\x29\x97\xdc\x40\x56\x12\x88\x86\x94\xab\x2d\xb7\xb0\x44\xa1\x72\xaf\xb6\x03\xa9\x55\x1e\xc3\xb3\x24\x60\x68\x12\xad\xa4\xbe\x8d\x76\x53\x58\x8b\x2c\x43\xf5\x9a\xe4\x15\xfd\x7f\xe2\x74\x11\x19\x91\xaf\x5d\xb4\x74\xca\x1e\x6c\x6e\xb8\xc9\x85\x8a\xe8\x6c\x0a\xcf\x5f\x14\x77\xad\x76\x21\xf0\xed\x9a\x1b\x77\xd0\xbb\x15\x99\x5b\x4f\xe1\xe5\xf9\x79\xad\x43\xde\xa3\xc7\x9a\xc2\xdf\x3a\xd1\x01\x4c\xe2\x8a\xe4\xbc\x74\xfa\x48\x6c\x6a\xfd\x5a\x7e\x08\x38\xfc\x01\x78\x5b\x07\x25\x6c\x17\xd1\x18\xac\x86\x5b\x04\xbd\x5a\x59\x74\x20\x1c\x2c\x77\x30\xf1\xb6\x62\xf8\x84\x90\xea\x52\x66\xb0\xe6\x2a\x27\x56\xd6\x78\xa0\xe5\x00\x67\xc5\x2f\x08\xcb\x32\xdc\xba\x0d\x8a\xb8\x5a\x61\xea\xc4\x16\xe5\x0e\x88\xd1\x02\x34\x9d\x5a\xbd\xf1\xf8\xe1\xbe\xe4\x4b\x94\x16\x28\x3d\x94\x48\x4f\x2d\x29\xdc\x72\x93\x1d\x10\x57\x46\x6f\x6a\x3b\x9e\x93\x20\x3c\x10\x5e\x68\x2b\x9c\xd0\x6a\x0a\x06\x25\xf7\x26\xea\xa0\x03\xa3\xd1\xa4\x21\xa7\x1a\xcc\x92\x50\x08\x0b\xaa\x88\xc7\x51\x54\xd7\xcf\x07\x9d\x21\x6c\x50\x95\x10\x45\x8b\xc1\x2c\x13\x5b\x48\x25\xb7\x76\xce\x28\x45\x51\x6e\x74\x59\x40\x51\x4a\x59\x73\xc7\x80\x6a\x81\x8a\x28\xc8\x19\x70\x23\x78\x14\xfc\x9e\xb3\x38\x8e\x19\x88\x8c\x0a\xec\x28\xc9\xa1\xcc\x66\xc4\x84\xd3\xea\x50\x81\xf5\x0f\xd6\xb3\x03\xde\x16\x95\x2d\x2f\xa5\x83\x8c\xc8\xc9\xf4\xad\xaf\x88\x3c\xa7\x92\x87\x8c\x3b\x7e\xf8\x31\x67\xcd\xe9\xc1\x38\xde\x15\x5c\x65\x48\x66\x57\x5c\x5a\x52\x76\xbe\x4d\xe6\x2c\x5d\x6b\x6d\xeb\xbc\xf0\xa6\x61\xb2\x80\x04\x5b\x0a\xdb\xd3\xbb\xa1\xc8\x83\x77\xd0\x63\x62\x66\x09\xaf\xf1\x2c\xe5\x06\x1d\x5b\x10\x6d\x24\x0c\x71\x24\xb5\xef\xe1\x7b\x29\x1b\xbd\xd6\x63\xcf\x63\x43\x51\xf8\x5e\xc3\xcf\xa4\x58\xcc\x38\xac\x0d\xae\xe6\xec\xa4\x66\xc9\x7b\x11\x79\x17\x22\x67\x78\x8a\x91\x56\x72\xd7\x7a\xdf\xba\x0c\xe1\x90\x10\xb5\x8b\x82\x67\x8a\x6f\xd0\x42\x50\x5e\x5c\x91\x14\xae\x49\x6a\x67\x09\x27\x2f\xc9\xca\x1f\x30\xe7\x61\x7e\xdf\x9a\x2d\x97\xf7\x8d\x5d\x97\xcb\x3f\x6f\xab\x0e\x8d\x12\x14\xf0\x1e\x30\xc8\xa5\x6c\x8c\xb6\x06\xd9\xe2\x0d\x49\xef\xd9\x9a\x25\xa5\xa4\x02\x4d\xa8\x42\x7d\x01\xaf\x27\x8b\x37\x5d\x62\x7d\x02\x67\x09\xc9\x06\x75\x09\x7b\x37\x9a\xf1\xe1\x13\x78\xb8\x44\xa3\x4c\x14\xd4\x75\x26\x9d\xb3\xfd\x3e\xfe\x07\xb7\xf8\xff\xab\xff\x54\x95\x75\xd4\x36\x69\xb2\x44\x75\x83\xa8\x92\xec\xaf\x74\xb7\xfe\x8c\x37\x42\xc5\x5f\x6d\x28\x82\x70\x79\xd1\xa2\xf4\xc6\xe9\x57\x4e\x9d\x1f\xa4\x21\xe3\xa7\x43\x2a\x30\x2a\x88\x51\x2c\x35\xcf\x86\xab\x52\xa5\xbe\x3f\x87\xa3\x66\xca\x26\xf0\xde\xf0\xe5\x83\xd5\xd9\xb6\xb9\xc3\x4d\x21\x3d\x47\x05\x37\x44\x8b\x43\x33\x06\x6d\x40\x84\x61\x61\x90\x26\xd5\xe0\xd1\x23\x42\x52\x5a\xe1\x18\x56\xfc\x86\xb0\x68\xec\x28\xea\x92\x1a\x09\x25\x52\x0d\x3a\x58\xd1\x2d\x0f\x48\xd1\xd4\xb3\xc3\xcf\x70\xeb\x04\x91\x6c\x68\xd2\xa0\x89\x83\x53\x5b\x3f\xe0\xfd\xbd\x39\x10\x35\x1d\xb7\x55\x55\x4f\x13\xb1\x1a\xfa\xe3\x58\xa2\xca\xdd\x1a\xe6\x73\x38\x6f\xe2\x81\xe6\xe2\xe7\x3d\x0b\xd3\x80\x4d\x81\x29\x5d\x67\xd6\x7a\x73\x6d\x94\x6c\x0c\x6c\xcb\x65\x89\xa4\x32\xa9\xbe\xd4\xd0\xb4\xa2\x42\x28\x6f\x0d\x86\x2a\xec\x3b\xdb\x39\xe7\x45\x73\x50\xd4\xa9\x21\x33\xc3\x2e\xc1\xe3\xd6\x0f\xe6\xe7\x2e\x41\x37\xbf\x49\x52\x8f\xe5\x7f\x85\x55\xc1\xc2\xfa\x18\x7f\x7b\xf8\xc9\x6f\x96\x7b\x67\x04\xff\x6f\xa5\xd0\x5c\xf1\x4c\x94\xd6\x87\xf4\xf2\xfc\x09\x6b\x14\xaa\xe6\x0b\xcd\x3b\x2d\x9d\x28\xec\xb1\x59\x54\x7e\x8b\x66\x24\x74\xa6\xc4\x1e\xac\x2f\x1b\x0f\x46\xc9\x4d\x71\xad\x25\x25\x80\xf5\x8e\xad\x33\x94\x43\xaf\xb0\x0f\x54\x56\xb4\xe1\xf7\x81\xb1\x6a\x63\xfd\xf7\x02\x4d\x4a\x69\xe5\x39\x56\x4f\x8e\x2f\xd2\x68\x3f\x76\x82\xa4\x4b\x9e\xde\xf8\x61\xad\xb2\xcb\x82\xa7\xc2\xed\x48\xe1\x3c\xfe\xfb\x8b\x56\xa7\xba\x17\x8f\xcf\xe5\x71\x2c\x56\x1b\x77\x69\xbc\xa3\xd3\x43\xf6\x68\x58\xdb\xf4\xc8\xfa\x86\x5a\xf9\x1a\x73\x5f\x71\xef\xfd\x72\xa8\x83\x38\xf2\xe5\x7b\x94\x40\xaf\x24\xee\x4b\x3f\x36\x7c\xb5\x71\xb3\x23\xa5\xb6\xe0\x2e\x7d\x5f\xc0\x90\x48\xa0\x92\xa3\xb5\x0c\x93\x27\xa3\x63\xd5\x54\x4b\x1d\x62\x38\x49\xc3\x7f\xac\x63\xa1\x5f\x13\x5a\xd1\x06\xf6\xb5\xe2\x99\x18\xf4\x69\xaa\x46\x61\x91\x8e\xc2\xe3\x81\x0a\xf6\x1a\x91\x9e\x50\xae\x98\x26\xb4\x59\x89\xe9\xe6\xe9\x13\xa7\x7a\x93\xfc\x5c\xa2\xf5\x6d\x6f\x93\x17\xaf\x5e\xbd\x9a\x4c\x5e\x26\x3c\xcb\x22\x6d\xa2\x92\xde\x6b\x0e\x23\x3a\x37\xbb\xa8\xce\x77\xd4\x36\x39\x01\x37\xf3\x02\x6a\xc5\xff\x79\xbd\xeb\xa0\xf6\xdf\x46\x6b\x58\x1a\x31\x86\x1b\xdc\x8d\x21\x90\xd4\x74\xa2\xef\x13\xd3\xb4\xc9\x15\xe6\xff\xbc\x2b\x86\x6c\xf8\xf9\xe2\xe9\x97\x11\x83\xbf\xf8\x0b\xf4\xc9\xe6\xf1\xb3\x8b\xe1\xd3\x5f\x4f\x47\xbe\x1b\x05\x1b\xbd\x6e\xef\x5a\xf4\x8e\x38\x9a\x18\x73\x20\x13\x31\x8d\x31\xbc\xbb\x5c\x0d\xcf\x2e\xce\x46\xf0\x98\x7a\x3e\x9a\xc0\x05\xb0\xa7\x0c\x88\xc5\x0b\xd6\x4c\x06\xf0\xfe\xc4\x1b\xee\xd2\xf5\xd0\xe0\xa8\x9b\x0b\xb4\x3f\x4b\xa3\x02\x94\xc1\x50\xf1\x74\x3e\x86\xb3\xd3\xc9\x59\xcf\x1d\xef\x5a\x08\x83\xfe\x9e\x9d\x3e\x3f\x1b\x35\x63\xc1\x7f\xd2\x9b\x08\x1f\xc0\x23\xd5\xce\xd9\x07\xa0\x3a\x88\x6a\xd0\xe7\xd4\x2f\x27\xbf\xe9\x87\x7e\xd7\xf4\x69\x2b\x7d\xc8\xdf\x27\xbc\x9e\xe8\x34\xd0\x53\xee\x71\x62\xbf\xf1\xfc\x2c\x6b\x76\x1d\x51\x19\x10\x3b\x4a\x1e\xba\x41\x1c\x42\xd9\xd1\xf3\xa0\x0a\x69\x7c\xe3\xfc\xe9\x90\x9d\x3c\xf8\x5e\x18\xc5\xa9\x14\xe9\x4d\xb7\x61\xda\x88\x30\x2e\x0c\x6e\xa9\x8c\xdf\xd5\xaf\xaa\x61\x93\xe4\x26\x7c\xd6\x87\xa9\xab\xfa\x9e\xa5\xee\xa9\xf0\x23\x86\x7a\x28\xdf\xb1\xf3\xcd\x33\xe1\xc7\xa3\xea\xa0\x0e\x16\xbb\xed\x3d\x68\xfe\x9d\xf4\x5b\x00\x00\x00\xff\xff\x5c\xee\xf8\x38\x5b\x0d\x00\x00"),
-			uncompressedSize:  3419,
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x57\xdf\x93\xdb\xb6\x11\x7e\x36\xff\x8a\xcf\xf0\x8f\x93\x6c\x91\x3c\xa5\xe3\xd6\x96\x45\x79\x5c\xa7\x4d\x3b\xd3\x8c\x5b\x5f\xda\x3c\x64\xf2\x00\x11\x2b\x11\x39\x08\x60\x00\x50\x3a\x45\xe5\xff\xde\x01\x28\x52\x92\xef\x9c\x69\x26\xf7\x70\x47\x82\x8b\x6f\xbf\xfd\x76\x17\x8b\x4b\x0e\x07\x41\x2b\xa9\x09\xec\x3b\xe9\x15\xb1\xb6\x7d\xbf\x5e\x5b\x5a\x73\x4f\xf8\x8f\xa4\x1d\x52\xf0\xba\x16\xdc\x55\x87\x03\x69\xd1\xb6\x67\x3b\xbe\xe5\x52\xb3\xb6\x4d\x92\xb9\xf3\x7b\x45\xf0\xfb\x9a\x0a\xe6\xe9\xce\xe7\xa5\x73\x6c\x91\x00\x95\xdf\xa8\xc9\xd2\x88\x3d\x0e\x09\x00\xe4\x2f\xf0\xb5\x74\x7c\xa9\x08\x5b\xb2\x5e\x96\x5c\xc1\x95\xd6\x28\xb5\xe4\x16\xa2\x21\x78\x83\x92\xeb\x2d\x77\x58\x92\xd4\xeb\x60\xb6\x87\x32\x7a\x9d\xe1\x45\x1e\x31\xcc\x96\xec\x4a\x99\x5d\xba\x9f\xa1\x92\x42\x90\x7e\x9b\x00\x6d\x02\x3c\xf1\xa6\x4e\xad\x5c\x57\x3e\x5d\x7a\xed\x8e\x3e\x37\xdc\xae\xa5\x4e\xbd\xa9\x67\xf8\xea\x55\x7d\x37\x58\xd7\x92\x3e\x54\xdc\xfa\xa3\xdd\x4e\x0a\x5f\xcd\xf0\xfa\xfa\xba\xb3\x01\x2a\x0a\x58\x33\xfc\xf1\xb4\x74\x04\x53\xb4\xf2\x33\xf0\xc6\x9b\x8b\x65\xdb\xd9\x77\xeb\xc7\x80\xe3\x1f\xe0\x43\x17\x94\x74\xa7\x88\x26\x70\x06\x3b\x82\x59\xad\x1c\x79\x48\x8f\xe5\x1e\xd3\xe0\x2b\xc3\xf7\x84\xd2\x34\x4a\xa0\xe2\x7a\x4d\xf0\x15\x1d\x65\x39\xc2\x39\xf9\x0b\x61\xd9\xc4\x5d\xbb\x68\x48\xab\x15\x95\x5e\x6e\x49\xed\x51\x5a\x53\xc3\x34\x1e\xce\x6c\x02\x7e\xdc\xaf\xf8\x92\x94\x03\xdd\x79\xd2\x22\x48\x6b\x1a\xbf\xe3\x56\x1c\x11\x57\xd6\x6c\x3a\x3f\x41\x93\xb8\x78\x14\xbc\x36\x4e\x7a\x69\xf4\x0c\x96\x14\x0f\x2e\xba\xa0\xa3\xa2\xe9\xf4\xfa\xfa\xa4\x69\x56\x2a\x59\xde\x86\xfc\xa6\xbf\xa4\x52\x0b\xba\x3b\x8a\x7b\x7c\x9b\x61\xfa\xf6\x4b\x98\x8f\x22\xc6\x3c\x8f\xc5\xb4\x48\x92\xf9\xe3\x34\xed\x6a\xf0\x5b\x23\x08\x1b\xd2\x0d\xd2\x74\x91\xcc\x85\xdc\xa2\x54\xdc\xb9\x82\x2d\xbd\x4e\xd7\xd6\x34\x35\xea\x46\xa9\x4e\x7f\xdc\xe3\xc0\x60\x8d\xa2\x82\x45\x4b\x06\x6e\x25\x4f\xa3\x1a\x05\xcb\xb2\x8c\x41\x8a\x82\x5d\x96\x4e\x2c\xde\x39\x3f\x73\x83\xe0\x4a\xd0\x8a\x37\xca\x33\x54\x96\x56\x05\x3b\x1c\xb2\xbf\x2a\xbe\xa1\x6f\x2c\xaf\xab\x7f\x7f\xfa\x47\xdb\x32\xf8\xd0\x46\x05\xdb\x06\xde\x41\x4d\x47\x6a\x05\x2f\x4f\x69\x70\x35\xd7\x0e\xbc\xef\x32\x11\x92\xee\x2b\x92\x36\x7e\x81\xe6\x1b\x42\xcd\x7d\xe5\xd8\x22\x82\x23\xa2\xcf\x73\x1e\x29\x2d\x1b\xef\x8d\x3e\xb6\x5a\xf7\xc2\xbe\xc0\x12\xc2\x9a\x5a\x98\x5d\x28\xfd\xf5\x5a\x11\x83\xe0\x9e\x1f\x5f\x0a\xd6\x7f\x3d\xea\x41\x77\x35\xd7\x82\x44\xc1\x56\x5c\x39\x1a\x02\x29\x2b\x63\x5c\x57\x80\x67\x9c\x03\x12\x42\x8c\xa1\x8e\x36\x46\x50\x14\x0c\x67\xe9\x9a\xc7\x68\x8e\xcc\x4a\x6e\xc9\xb3\xc5\x3c\x0f\x8b\x31\x8e\xbc\xe3\x1e\x9f\x1b\xd5\xdb\x0d\x8c\x43\xb2\xfb\xac\xc5\xe7\x0e\x7e\xae\xe4\x62\xce\x8f\xea\x3f\xe9\x12\x17\x58\xa4\x81\x42\xea\x2d\x2f\x29\x35\x5a\xed\x07\xf6\x03\x65\xc4\x8f\xb0\xc6\xf8\x74\xd0\xd9\x21\x1a\x2f\x3e\x19\xe3\x71\x13\xf2\x12\x64\x9e\xe7\x4a\xfe\x3f\xee\x02\xcc\xaf\x7b\x73\xcd\xf2\xbe\xb3\x9b\x66\xf9\xdb\x7d\x75\xa1\x71\x2d\x22\xde\x03\x0e\xb9\x52\xbd\xd3\xc1\x21\x5b\xbc\x57\xea\xbe\xaf\x79\xde\xa8\x45\x32\xcf\x85\xdc\x86\x2e\xab\xa6\x8b\xf7\xa7\xc4\x86\x04\xce\xf3\x6a\xba\x48\xba\x3e\x0b\x34\xfa\x73\x92\x2d\x86\x4d\xae\xb4\xb2\xf6\x70\xb6\x8c\x5d\xf0\x67\xee\x28\xd6\xbf\xf3\xdc\xcb\x32\x5f\x92\xbe\x25\xd2\xb9\xf8\x43\x2d\xa9\xfb\x9d\x6d\xa4\xce\x7e\x72\xb1\x08\xe2\xe6\xc5\x80\x72\x36\x37\x7e\xe2\x5b\xde\xad\xc6\x8c\x3f\x1d\xed\xa4\x16\x66\x37\xce\x94\xe1\x62\xb4\x6a\x74\x19\x0e\x8d\xd1\xb8\x1f\x27\x79\xe8\x8e\xe5\x83\xd5\x39\x9c\x67\x9e\x36\xb5\xe2\x3e\x74\x95\xe5\x1b\xf2\x64\x27\x30\x16\x32\xb6\xa3\x25\x48\x97\x3c\x7a\x94\xe7\xd0\x46\xd3\x04\x2b\x7e\x4b\xe0\x70\x52\xaf\x15\x75\x48\xa4\x68\x43\xda\x63\x65\x6c\x04\xac\xe5\xf1\x90\x84\x37\x70\x5e\x2a\x05\x4b\x5a\x90\xcd\x12\x00\xd8\x72\xdb\xed\x2b\x70\x38\x64\x27\x6d\xdb\xb6\x3b\xfa\xe4\x6a\x14\x3e\x67\x8a\xf4\xda\x57\x28\x0a\x5c\xf7\xf1\xa0\xdf\xf8\xc3\x81\xc5\x03\x8a\xcd\xc0\xb4\xe9\x32\xeb\x82\xbb\x21\x4a\x36\x01\xdb\x72\xd5\x10\x9b\x61\xda\xfe\xd8\x41\xb7\x49\x17\xca\x07\x4b\xb1\x0a\xcf\xc9\x9e\xc8\x85\xa5\x02\x9a\x76\x88\x99\x19\x9d\x12\x3c\x19\x78\xb0\x30\x60\xd8\x6c\x78\x07\x58\x37\x7f\xfe\x16\x67\x22\x8b\x73\x72\xf2\xf9\xc7\xef\xc3\x08\xbd\xf7\xad\x96\xf4\x77\xad\xc9\x7e\xe2\x42\x36\x2e\x84\xf4\xfa\xfa\x19\xeb\x0d\xda\xfe\x81\x79\x63\x94\x97\xb5\xbb\x74\x4b\x3a\x1c\xe5\x82\xcd\xe0\x6d\x43\x67\xb0\xa1\x6c\x02\x58\xad\x78\x49\x95\x51\x82\x2c\x3b\xfb\xec\xbc\x95\x7a\x1d\x0c\x0e\x51\xca\x16\x29\x0e\x51\xb1\x76\xe3\xc2\x73\x4d\xb6\x24\xed\xf9\x9a\xda\x67\x97\x1b\xf7\x8a\x2e\x49\x00\x6c\xc9\xcb\xdb\x30\x3f\xb4\xf8\x58\xf3\x52\xfa\x3d\x9b\xe1\x3a\xfb\xd3\xab\xc1\xa6\xbd\x17\x4f\xc8\xe5\x65\x2c\xce\x58\xff\xd1\x06\xa2\xb3\x63\xf6\x52\x41\xae\xbc\xf0\xbe\xe1\x4a\xdd\xd0\x3a\x54\xdc\x37\x61\x5e\x75\x41\x5c\x70\xf9\x92\x24\x38\x2b\x89\xfb\xab\xdf\xf5\x7a\x0d\x71\xb3\x0b\xa3\xa1\xe0\x3e\x86\xbe\xc0\x48\x91\x73\xf0\x15\xd7\x98\x3e\x1b\x5f\x9a\x96\x46\x99\x18\xc3\x93\x32\xfe\xb0\x93\x0a\xe7\x35\x61\xb4\x27\x1d\x6a\x25\x28\x91\x9c\xcb\xd4\x8e\xe3\x8d\x61\x1c\x6f\x49\x79\x8e\x1b\x22\x54\xde\xd7\xb3\x3c\x77\x9e\x97\xb7\xfd\x1d\x2f\x2b\xcd\x26\xff\xb9\x21\x17\xda\xde\xe5\xaf\xde\xbc\x79\x33\x9d\xbe\xce\xb9\x10\xa9\xb1\x69\x53\x0b\xee\x29\xfd\xb9\x21\xbb\x4f\xbb\x7c\xa7\x43\x93\x27\x40\x7f\x5e\xa0\x33\xfc\x57\xb0\xbb\x89\x66\xff\xec\xad\x46\x8d\x95\x13\xdc\xd2\x7e\x82\x28\x52\xdf\x89\xa1\x4f\x6c\xdf\x26\x9f\x68\xfd\x97\xbb\x7a\xc4\x46\x3f\xbc\x7b\xfe\xe3\x98\xe1\x65\xd8\x80\x97\x60\x45\xf6\xe2\xdd\xe8\xf9\x7f\x9f\x8e\x43\x37\x4a\x36\x7e\x3b\xec\x75\x14\x88\x78\x63\x51\xa0\xb1\x32\x8b\xf7\x90\x8f\xab\xd1\xd5\xbb\xab\x31\x1e\x17\x05\xd2\x29\xde\x81\x3d\x67\x98\x81\xbd\x63\xfd\xc9\x80\xc0\x27\xdb\x70\x5f\x56\x23\x4b\xe3\xd3\xb9\x60\xc9\x37\x56\x47\x28\x4b\xb1\xe2\x47\x96\x26\xb8\x7a\x3a\xbd\x3a\xa3\x13\xa8\xc5\x30\xf0\x12\x57\x4f\xbf\xba\x1a\xf7\xc7\x02\x00\x90\x72\xf4\x00\x1e\x5e\x9e\x91\x7d\x00\xea\x04\xd1\x26\xe7\x9a\x86\xe1\x14\x26\xfd\x28\xcc\x9a\x73\xd9\x1a\x14\xbf\x26\x78\x77\xa2\x67\xca\x94\x3c\xe0\x64\x61\xe2\x4d\x70\x9a\x75\x6c\x12\xa7\xd7\xf8\x24\xc9\x43\x3b\xf0\xb8\x40\x73\x92\xe7\x41\x93\x02\xcd\x67\xe4\x9f\x8e\xd8\x93\x07\xef\x0b\xe3\xee\xe2\x7a\x9a\x30\x43\x44\x94\xd5\x96\xb6\xa4\xfd\xd7\xdd\xad\x6a\xd4\x27\xb9\x0f\x9f\x9d\xc3\x74\x55\x7d\xcf\xd3\xe9\xaa\xf0\x7b\x1c\x9d\xa1\x7c\xc1\xcf\x67\xd7\x84\xdf\x1f\xd5\x09\xea\xe8\xf1\x34\xbd\x93\xfe\x1f\xc2\xff\x0d\x00\xa3\xb6\xae\x29\x44\x0e\x00\x00"),
+			uncompressedSize:  3652,
 		},
 		"/dashboard.html": &_vfsgen_compressedFileInfo{
 			name:              "dashboard.html",
@@ -43,11 +43,29 @@ var Data = func() http.FileSystem {
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x57\x5b\x6f\xdb\xbe\x15\x7f\xf7\xa7\x38\xe3\xbf\x43\xec\xce\x92\x9c\x04\x45\x80\x34\xf6\xd0\x2d\x1b\x56\x60\xbd\x60\x71\xf7\x52\xf4\x81\x96\x8e\x25\x36\x92\xa8\x91\x94\x2f\x33\xfc\xdd\xf7\x23\x25\x5f\x92\x74\x0f\x5b\x8a\x22\x11\x0f\x0f\xcf\xe5\x77\xae\xd9\xed\x32\x5e\xaa\x9a\x49\xcc\x95\x2b\x59\xec\xf7\xf7\xd2\x16\x0b\x2d\x4d\x46\x11\xc9\xa6\xc9\x70\xdc\xed\xb8\xce\xf6\xfb\xc1\xe0\xc4\xfd\x49\xaa\x5a\x78\xd2\x9d\x4d\x8d\x6a\x1c\x59\x93\x4e\xc5\x6e\x17\xff\x49\x5a\xfe\xf6\x8f\xbf\xef\xf7\xd6\x49\xa7\xd2\xc4\xb2\xda\x1a\x25\x93\x85\xd6\xce\x3a\x23\x9b\xc8\x96\x2a\x63\xf3\x82\x10\x57\xaa\x8e\x7f\x5a\x31\xbb\x4b\x3a\x91\xb3\xc1\x5d\xa9\xea\x47\x2a\x0c\x2f\x5f\x29\x3a\xb5\x56\x90\xe1\x72\x2a\xac\xdb\x96\x6c\x0b\x66\x27\x66\x03\x6f\xbd\x3f\xcf\x06\xbf\x65\xd2\xc9\xb9\x5c\xe0\xdb\xf9\x9f\xb4\x1b\x10\x25\x6f\xe9\xc1\x19\x76\x69\x41\x32\x35\xda\x5a\x4a\x75\xed\xe0\x38\x1b\x7a\x9b\x80\xa1\xd1\x56\x39\xa5\xeb\x5b\x92\x0b\xab\xcb\xd6\xf1\x7b\x50\x9d\x6e\x6e\x69\xe2\xbf\x16\xda\x39\x5d\xf5\x87\x92\x97\xae\xff\x34\x2a\x2f\xba\x6f\x1c\x2a\x69\x72\x55\xf7\x37\x8d\xcc\x32\x55\xe7\xe1\x04\x70\x93\xb7\xa0\xfd\x55\x6d\xd8\x92\xb2\xb6\x65\x5a\x17\x6c\x98\xd2\x52\xa5\x8f\x60\x23\x5d\x93\x84\x51\x65\x5b\x41\x2b\x59\x6d\x1c\x2d\xb6\xa4\x1c\xad\x75\x5b\x66\x94\xca\xd6\x32\xb9\x82\x3b\x9e\x1a\xc2\xd6\x2a\x73\x85\x67\xfe\xd9\x56\x0d\x65\x10\x89\x6f\x69\x8c\x5e\x53\xa6\xd7\x75\xd2\x36\xa4\xe0\x25\x15\x72\xe5\x15\xc8\xee\xc1\x00\xee\x9e\x20\x22\xdb\xc8\x3a\xd6\x06\xc8\x06\x9c\x32\x65\x9b\x52\x6e\x6f\x49\xd5\x08\x18\x47\x8b\x52\xa7\x8f\xef\x0f\xca\x0e\xbe\x9c\xbd\xdf\x3d\xc1\x0e\x71\x41\x38\x57\x27\xec\xa2\xeb\x77\xcd\x26\xbc\x89\x9d\xaa\xd8\xcb\x0c\x4f\x1c\x6f\x5c\x24\x4b\x95\xe3\x51\xca\xb5\x63\xe3\x99\x4e\x3c\x71\x17\xed\xc0\xdb\xab\xbe\x9c\x4c\x7e\xef\x99\x90\x53\x5d\xa0\x07\x77\xbf\x8b\x22\xc0\x9c\xc3\x71\x9f\xf0\x14\x45\xc8\xb3\xe2\x72\x76\x4c\xfb\xbb\x04\x27\xf0\x65\x6a\x05\xa0\xa5\xb5\x53\x71\xd0\x80\x94\x21\xf2\x02\xf0\x8b\x68\xfe\xe5\xfe\xcb\x10\x2a\x2b\x2b\xf3\xd1\x2d\x7d\xc8\x73\xc3\xb9\x74\xfc\xe0\x34\x62\xa4\x2c\xd5\xda\xc1\x39\x24\xa2\x4a\x1d\x67\x1e\xe8\x9b\xab\xa8\xd0\xad\xb1\x63\xc4\x0a\x71\x51\x36\x08\xb2\x85\x8f\x56\x7d\x81\xe0\x31\xb1\x42\xbc\x4c\x8c\x0b\x6f\x19\xd4\x15\xd7\xb3\x79\xaf\x1f\x50\x46\x37\x57\x14\x44\x90\xcc\x35\x4c\xbd\x0e\x3c\xaa\x6e\x5a\x47\x2a\x43\x7e\x07\x08\x04\xb9\x6d\xc3\x30\x1c\x88\x89\x83\x17\x3e\x6a\x57\x82\x56\xb2\x6c\x71\x25\xc8\xc7\xa3\x2f\x90\x08\xb5\x37\x15\x93\x67\x34\xb9\x99\x8a\x9b\xab\xa7\x44\xeb\xb8\x99\x8a\xcb\xa7\xc4\x5e\xe4\xf7\xc9\xf8\xe6\xea\x87\x48\x00\x68\x02\xf4\x3c\x88\x85\x49\x0e\x58\x7a\xeb\x8e\x29\xd0\x21\xd9\x95\x5a\x90\xe4\x74\x9e\x97\xde\xe2\x70\xdb\xd1\x5a\x53\x86\xba\xbf\xc7\x21\xd4\xfd\xd1\x95\xee\x61\xf8\x19\x21\x5b\x33\xae\x2d\x67\x22\x80\xd9\xd9\x85\x4a\x88\x6a\x59\x41\xe0\x87\x15\x1b\x44\xfb\xec\xf2\x5f\x2d\x9b\x6d\xd4\x48\x23\x2b\x88\x0a\xa7\xaf\xe1\x70\x2e\x80\xa5\x49\x0b\x28\x32\xed\xf9\x53\x44\x6a\x1d\xa1\x1d\x21\xaa\xff\xe5\xb2\x2b\x34\xfb\xf2\xd2\x5b\x14\x4a\x06\x28\xb0\x4d\x7b\x00\x0a\x96\xd9\x2c\x70\xdd\x39\xd3\x7d\x04\xf2\xe9\x91\xf7\xb1\x97\xd6\x11\x97\x8a\x4b\x40\xf9\x19\xde\xa1\x57\xfa\xa0\x76\x99\xdc\x91\x48\x2f\x43\xc1\x1b\xb4\xc0\x50\xa7\x34\xe4\x38\x8f\x69\xa9\x0d\xfd\x6d\x3e\xff\x8a\x84\x84\xc7\xd6\xd9\x9e\x0b\x3d\x6b\x24\x66\xfe\x25\x4a\x04\xec\xe8\xbe\xae\xf8\x9f\x0c\x39\x00\xfc\xd4\x96\x9e\x9a\x54\x1c\x68\x15\x87\xcb\x92\xeb\xdc\x15\x62\xd6\xdf\xd2\xb0\xb2\xa3\xff\x57\xef\x27\x0c\xa0\xa7\x3a\x41\x51\x55\x5b\x25\xb6\x92\x25\x9a\xbc\x7b\xa9\x17\x1c\xaf\xd3\x29\x37\xcf\x75\xca\x4d\xd0\x59\xa2\x8b\xff\x5a\xa5\xdc\xbc\x4a\xe5\x83\xcb\xee\x79\xf5\x4c\xeb\x83\x93\x75\xe6\x47\x74\xc6\x2b\x25\x7d\x1b\x0d\x71\x7f\xae\x1b\x6f\x63\xba\x3f\xb2\xbc\xc6\x8c\x79\x2f\xdb\x3e\xcf\xb9\xb6\x5a\xa0\xe5\x9e\x69\xf7\x9d\xa9\xef\x84\x99\x98\x1d\xdf\xbd\x50\x8c\xaf\x90\xf1\x9e\xd2\x57\xc1\x9d\x5b\xe8\x6c\x7b\xb4\xcb\x77\xea\xbf\x6c\x64\xd5\xf4\x4d\x82\x30\xcd\x96\x6d\x19\x72\xb9\x31\x70\x8b\xd7\x7e\x46\xf9\x44\xee\x1a\xc2\xb7\x8f\x47\x97\x8e\xc5\xe4\x0f\xd9\x2c\x71\x55\xf3\xc7\xa5\xd6\x53\xef\x19\x34\x66\x4f\xaf\x2f\x27\xef\x26\x2f\xa9\xd7\x93\xc9\x2f\xa8\x57\xcf\xc9\x07\x47\xfc\xbf\xbe\x67\x27\x47\x47\xf0\x19\xb6\x8a\x53\x4b\x3c\x2c\x37\x44\xcb\xb6\x4e\x43\x60\xce\x7a\xd0\x70\x14\xc6\x17\xa1\x4d\x1b\x72\x34\xa5\x37\x43\xf1\x5b\xdf\xd4\x47\xfd\x80\x1b\x5e\xe4\xec\xfe\xe9\x7b\xee\xc5\xe8\x7d\x60\xc6\x92\xd2\x9a\x9a\x76\x58\x6f\xa4\x71\xe2\x96\xdc\xf7\xc9\x8f\x31\x09\xac\x6d\xe1\x70\xf9\x63\xef\x19\xf7\x7e\xdf\x48\x12\xfa\x58\x63\xf4\x62\x90\xfe\x1b\xa3\xbc\x5b\x90\x06\x27\x85\x75\x5b\x96\x9e\xf9\x57\x8a\x77\x4e\xeb\xd2\x29\xcc\x68\x51\x80\x20\xf6\xa3\x58\xd7\x43\x91\x16\xb2\x46\x07\x18\x1f\x3d\x1a\xf2\xb9\x1b\x2b\x48\xe5\x38\x0c\x89\xb8\xe6\x75\xb0\xbc\xb3\x1b\x3a\x4e\xe3\xbb\xb8\x86\xa2\xc2\x55\xe5\x50\x9c\x06\x9e\xa0\x3f\xd0\x0a\xce\xe0\x97\x88\xba\xc3\x65\x38\x9c\xa6\xa0\x18\x0d\x82\x30\x38\xe6\xb7\x52\xbf\xa0\x61\x6f\xf1\x62\xd1\xdd\x08\xb9\xc2\x25\xb6\xa0\x35\xfe\xab\xb2\xf4\x23\x56\xda\xc7\x2e\x6f\xa4\x0b\xc9\x63\xd9\xa0\x1f\x61\xff\xa1\xaa\x4d\x8b\x83\xac\xca\x4f\x71\xf0\xd4\x7e\x9f\xaa\x99\x33\xb4\x4c\x1d\x87\xdb\xb4\xc4\x78\x98\x77\x0a\x86\xae\x8f\x81\xc7\xce\xb2\x3b\x90\x8f\x50\x1c\x90\xf0\xde\x5e\x9c\x2d\x41\x21\x2f\x2e\x46\xf1\x71\x59\x0d\xe4\xa1\xe8\xc7\x0b\xd0\xdc\x1d\xf3\xce\x2a\x94\x34\x36\x46\x9f\xbd\xe3\x9e\xba\xef\xf5\xee\xc7\x7e\xcb\x09\x07\x4f\x0a\x91\x7b\xa1\x3c\x2c\x92\xfe\xdf\x9f\x9f\xac\x8e\xa1\xaa\xba\xda\xf1\x1b\x60\x00\xc8\x70\xa6\x0c\xa7\x8e\xb6\xba\xf5\x3b\x4b\x28\x2f\x23\x53\xec\xa0\x7e\x6d\x1a\xfb\x75\xc5\xd7\x5d\x2f\xf0\x67\x6b\x3b\x14\x3f\x63\x1b\xd0\xeb\xd0\x03\x3b\x6e\x5f\xa6\x01\xe3\x1c\x63\xa6\xe9\x90\x0b\x6b\x73\x97\x5b\xcf\x90\x10\x21\x95\x2e\xc2\x6a\x1b\xc1\x98\x78\x61\xe3\x0e\xa2\x53\x5a\x61\x96\x8d\xbd\xa1\x63\x7a\xc3\x25\x57\x80\xe4\x04\x2e\x4c\xc2\x02\x1b\x63\xf5\x0c\xcd\x2e\xf6\x7f\x35\x20\x22\x5e\x12\x76\x87\x1e\xaa\x1e\xa5\xc1\xe9\x4f\x8c\xc1\xe1\x6f\x9b\xff\x04\x00\x00\xff\xff\x44\x93\x97\x2b\x09\x0d\x00\x00"),
 			uncompressedSize:  3337,
 		},
+		"/diff.html": &_vfsgen_compressedFileInfo{
+			name:              "diff.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\x4f\x73\xdb\xb6\x12\xbf\xeb\x53\xec\x20\x39\x50\xf3\x24\xd2\xb1\x33\x79\xef\x49\x94\x3a\x56\xdc\x43\x67\x9a\x1c\x12\x4f\xaf\x1d\x90\x58\x8a\x88\x41\x80\x05\x20\x31\x2e\x83\xef\xde\x01\xf8\x47\xb4\x63\x69\x6a\x1d\x44\x62\x81\xfd\xed\xbf\xdf\x2e\xd8\xb6\x0c\x0b\x2e\x11\xc8\x3d\xb7\x02\x89\x73\x56\xd3\x1c\xa1\x6d\xe3\xdb\xf8\xb7\xbb\xf8\xde\xaf\x9c\x83\xa3\xf1\xa2\xdd\x54\xb4\x04\x5a\xd7\x8c\x9a\xb2\x6d\x51\x32\xe7\x66\xb3\x13\xd8\x27\xca\x25\xf1\xa2\xd4\xd8\x47\x81\x60\x1f\x6b\xdc\x10\x8b\xdf\x6d\x92\x1b\x43\xb6\x33\x80\x98\x7e\xe7\x06\x6a\x6a\xcb\xc5\xb8\x12\x5e\xb9\x9d\x01\x00\x14\x5c\x88\x15\x48\x25\x71\x1d\xd6\xc6\x6a\xf5\x80\x2b\xc8\x04\xcd\x1f\x7a\x51\x49\x6b\x5c\x6a\x94\x0c\x35\x97\xfb\x15\xe4\x9a\x9b\xfa\x57\xb6\x47\xe3\x0f\xb8\x11\xd6\xdb\x1d\x60\x95\xb4\xcb\x82\x56\x5c\x3c\xae\xc0\x50\x69\x96\x06\x35\x2f\xd6\xa7\x4d\xc3\xff\xc6\x15\xbc\xbb\xaa\xbf\x8f\x20\x96\x57\xe8\x5d\x5b\x0a\x9a\xa1\x78\x2d\xd2\xf5\x04\x89\xf1\xa2\x58\x96\xea\x88\xba\x47\xa9\xb8\x5c\x96\xc8\xf7\xa5\x5d\xc1\xf5\xd5\xf3\x93\x96\x66\x3e\x79\x2c\x96\xb4\x1a\x12\xd3\x94\xdc\xe2\xd2\xd4\x34\x47\x9f\x9f\x46\xd3\xfa\x45\x25\x1d\x53\xc6\x90\x81\x65\xd0\x42\x46\xf3\x87\xbd\x56\x07\xc9\x96\xb9\x12\x4a\xaf\xe0\x0d\x2b\x8a\x2b\xf6\xbf\xf5\x4b\x8a\x1a\x2b\x75\xbc\xa0\x5a\x5c\x33\x64\xf8\xa2\xaa\x11\xaa\x41\xed\x5d\x66\x28\x2c\x85\x16\x06\x25\xfa\xff\xf7\xef\xdf\x5f\xaf\xbb\xd4\x34\x7d\xc8\x99\x12\xec\x45\x9c\x82\x1a\xfb\x32\xce\x4d\xfe\xdf\x0f\x37\x41\x29\x4d\x02\xb9\xb6\xb3\x59\x5a\xbe\xdb\x06\x5a\x42\xae\xaa\x9a\x6a\x6e\x94\x4c\x93\xf2\x9d\xdf\x62\xfc\x08\xb9\xa0\xc6\x6c\x88\x56\x4d\xa0\xde\x54\x96\x2b\xb1\xac\xd8\xf2\x43\xd8\x00\x48\xcb\x9b\xed\xed\x0a\x52\x0a\xa5\xc6\x62\x43\xda\xf6\xa0\xc5\xbd\xea\xc0\x9f\xb4\x04\xd9\x3e\xeb\x91\x34\xa1\xdb\x34\x29\x6f\x7a\x20\x6f\x83\xb3\x0d\x19\xc9\x43\xc9\x60\xb3\x0b\xb5\x97\x93\x6d\x9a\x30\x7e\x7c\xa6\x15\x38\xf2\x5c\x25\x08\x03\x9b\x97\xd5\xc1\x22\x9b\xa8\x9e\x5e\x2e\xc7\xb6\x3b\x1b\xdb\xee\x79\x6c\xbb\x57\xc4\x96\xbd\x3e\xb6\xec\xb5\xb1\xf5\x8f\x99\x0f\xe3\x6b\x4d\xa5\xe9\x1c\x4a\xeb\x01\x67\xaa\x1c\x0e\x40\x53\x2a\x83\xc0\x0e\x9a\x5a\xae\x24\xe4\x25\x95\x7b\x64\x90\x3d\xfa\x61\xf6\x89\xcb\x3b\xcf\x2d\xe7\x40\x69\x10\x68\x0c\x50\x8d\x20\x95\x85\x8a\xea\x07\x64\x40\x0d\xf4\x7c\x56\x1a\x7a\x46\x46\x06\x2d\xa4\xb9\x62\xb8\xfd\xa5\xe2\xf2\xcf\x40\xcf\x4d\x9a\x04\x09\x58\xd5\x1b\x01\x5b\x72\x33\x8f\xd3\xa4\xde\xce\xd2\x8e\xd5\x83\x97\x61\x11\xfe\x97\xb9\x92\x0c\xa5\x41\x06\x27\xf6\x77\x0c\xb5\x7a\x9b\xda\x32\x84\x91\x26\xb6\x0c\x8b\xdb\xf1\x6d\x37\xbe\x7d\x0c\xd6\xba\x65\x62\xb5\xd7\x6d\x5b\xed\x65\x10\x7f\x51\x8d\x71\xae\xcb\xbd\xd5\x83\xfd\xb6\x8d\xbf\x5a\x6a\x0f\xc6\x97\x39\x6c\xfa\x6d\x36\x6c\xfb\x49\x43\x20\x34\xd6\x86\xd4\x94\x31\x2e\xf7\x4b\x81\x85\x5d\xf9\x9c\xdd\x61\x6d\x4b\xe7\xb0\x5a\x7b\x8a\xf0\x02\xe2\xcf\xb4\x42\xe7\xda\x76\x7c\x41\x61\x3c\x61\xb0\xda\x1e\xa4\x07\x63\x69\x82\xd5\xb6\xbf\x23\xd2\xc4\xb2\x89\xd1\x1e\xe3\xd6\xb9\x17\x49\xe9\xa3\xf7\x4d\xe7\x9f\x23\x19\xa7\x02\xff\xec\xd9\x7a\xd7\x17\xf9\xb6\x23\xeb\x45\x7b\xbb\x8b\xf6\x76\xcf\xed\xed\x2e\xd9\xdb\x5d\xb2\x37\x32\xdc\x93\x64\xc8\xd8\xad\x1f\xcb\xce\x85\xe9\xdc\xa5\x0b\xbc\xf8\x4b\x37\x74\x9d\xeb\xa7\xef\x64\x6b\xa8\x57\xd0\xc7\xbf\x06\x01\x90\x8e\x9d\xc4\xb9\xff\xf4\xf6\x43\x89\x02\xa7\x7f\x72\xe8\x44\x8f\xb0\x31\x4b\x93\x40\x37\xdf\x50\x26\xd7\xbc\xb6\xd3\x4b\xfa\x1b\x3d\xd2\x4e\x1a\x38\x12\x15\x07\x99\xfb\x68\xa3\x79\x7f\x0b\x25\x09\x74\x17\x2f\x30\x4d\x1b\x03\xb6\x44\x18\xba\x1e\x54\x11\xd6\x7b\x7e\x44\x09\xdd\xf7\x04\xa3\x96\xfa\xde\x41\x38\x72\x13\xef\xd5\x1c\xb8\xb4\xca\x1f\x1b\xe0\xba\xd3\x28\xb0\x42\x69\x17\x60\x4a\xd5\x70\xb9\x0f\x40\xc5\x41\x08\xe8\xee\x5e\x55\x40\x98\x14\xc8\xc0\x84\x16\xe7\xb2\x13\x7c\x45\x11\x77\x57\x6f\xef\x6a\xef\x5f\x64\x50\x2c\xc6\x23\x8b\xe0\xc8\x10\x04\x00\x2f\x22\x2f\x80\xcd\x06\xa4\x37\xf2\xe3\x47\x38\x10\x0b\x94\x7b\x5b\x7a\xf1\xd5\xe9\x30\xc0\x5b\x8f\x36\x8f\x7d\x8a\x22\xf2\x59\x9d\x22\xa6\x47\xca\x45\x68\xec\x42\xe9\xd0\xfb\x5d\xdc\x31\x99\xaf\x47\x6d\x8d\xf6\xa0\xe5\xb0\x76\xfd\xf3\x48\xb5\xcf\x09\xcf\x04\xde\x05\x57\xe0\x6d\xbc\xd7\x58\x07\xc7\x16\x63\x38\x91\xca\xbe\xcd\xa1\xed\x41\x40\x65\xdf\xe2\x5e\x6b\x0d\x6e\xbe\x9e\x80\xe5\x25\xd5\x16\x36\xc0\x6e\xc6\x0f\x97\x68\x3e\x3a\x31\xfd\xc5\x0d\x67\xb6\x8c\xfa\xa8\xba\xc5\xfc\xcc\x51\x63\x69\xfe\x70\x0e\xa7\xa2\x7a\xcf\x65\xd4\x86\x39\x71\xb5\x00\x1d\x6e\xf7\xab\x05\x58\x55\xfb\x47\xa6\xac\x55\xd5\xea\xca\x9d\xd1\x0f\xe5\x39\x91\x8c\x2d\x80\x87\x4a\x1d\x2a\x1f\xf2\xdb\x68\x28\x5f\x9f\xfa\xb0\x13\x7b\x56\xfc\xee\x49\x31\x5f\xc3\x39\xe0\x4a\x1d\x0c\xaa\x83\x7d\x42\xe0\x9f\x01\x09\xb9\x80\x91\x0b\x9e\x3f\x9c\x75\xae\xe1\x92\xa9\x26\x16\x2a\x0f\xe3\x20\xf6\x53\x05\x36\xdd\x7e\x7c\xd0\x62\x5a\x1d\x76\x13\x1b\x14\x98\xdb\x2e\xe1\xb4\xae\x51\xb2\x88\x98\xe3\x9e\xcc\x63\x6a\xad\x8e\x48\x28\x02\x59\xc0\xb9\x9a\xc4\x01\x38\x9a\x10\x66\x1e\xe7\x54\x88\x28\x54\x7d\x72\x2e\x4c\xf0\x88\xf8\x38\x0b\xa1\x1a\xb2\x00\xd2\x2b\x0d\x94\xec\x08\xc8\x8b\xc8\xdf\x08\xa5\x6a\xee\x7b\xb2\x7c\xf4\x48\xb7\xce\x9d\x88\xdf\x77\x12\x79\x33\xf9\x96\x59\x00\x79\x33\x7c\xa4\x2c\xfc\xd5\xf0\x07\x37\xde\x1d\xaf\xf8\x2f\xf0\x77\x97\xf1\xb3\x09\x7e\xf6\x04\x7f\xf7\x04\xdf\xcd\xa3\xf9\xda\x7f\x08\x86\x59\xb5\x9d\xcd\xfa\xe9\x36\xfb\x67\x00\x8e\xdf\x3e\x2b\xd8\x0c\x00\x00"),
+			uncompressedSize:  3288,
+		},
+		"/flamegraph.html": &_vfsgen_compressedFileInfo{
+			name:              "flamegraph.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x58\x4f\x73\xdb\x36\x16\xbf\xeb\x53\xfc\x16\x69\x1a\x32\xa6\x28\x29\x1e\xb7\x1d\x49\x74\x26\xd3\x4e\x76\x0f\xed\x65\x37\x3b\x3d\xec\xec\x01\x26\x41\x11\x1b\x10\xe0\x00\x90\x2d\xd7\xd1\x77\xdf\x79\x00\x49\x51\xb2\x9d\x8b\x29\xbe\xff\x7f\x7e\x78\x78\xf4\xd3\x53\x25\x6a\xa9\x05\xd8\x17\xe9\x95\x60\xc7\xe3\x67\xc5\x5b\x81\xbf\x5b\xde\x35\x98\x83\x77\x5d\xc5\x5d\xf3\xf4\x24\x74\x75\x3c\xce\x4e\xe2\x7f\x70\xa9\xd9\xf1\x38\x9b\x6d\x9d\x7f\x54\x02\xfe\xb1\x13\x05\xf3\xe2\xe0\x17\xa5\x73\xec\x76\x06\xbc\xf1\xa6\x9b\x5b\xb9\x6b\xfc\xfc\xce\x6b\x87\xa7\x19\x00\xb4\xdc\xee\xa4\x9e\x7b\xd3\xad\xf1\xe1\xa6\x3b\x6c\x66\xc0\x91\xa4\x6b\x72\xbc\x0b\x7e\xa3\xe4\x83\xac\x7c\xb3\xc6\x6a\xb9\x7c\xbb\x09\x04\x73\x2f\x6c\xad\xcc\xc3\x1a\x8d\xac\x2a\xa1\x5f\x52\xdd\xe5\xb5\xa5\x04\xa2\x89\x72\x6f\x9d\xb1\x6b\x74\x46\x6a\x2f\xec\xf7\x14\x28\xf4\x5e\xab\x36\xda\xcf\x9d\xfc\x4b\xac\xb1\x5a\xc5\x08\x81\x5a\x2a\xb5\xc6\x9d\xe2\xe5\xd7\x48\xe8\x6d\xce\xc5\xbd\xd0\xde\xad\xa1\x8d\x16\xdf\x71\xb0\x6e\x28\x7c\x58\x51\x0e\x6e\x9c\xb7\xe6\xab\x58\xe3\xcd\xf5\xf5\xf5\x66\x42\x99\x0f\x89\x5f\x16\x67\x5e\x09\xcf\xa5\x1a\x2a\xd9\x08\xaa\xed\x1a\x1f\x44\xbb\x39\xc5\x5d\xf3\x56\xaa\xc7\x35\x5a\xa3\x8d\xeb\x78\xd9\xc7\xb4\x5d\x84\x3e\xdd\xce\x66\xdb\x4a\xde\xa3\x54\xdc\xb9\x82\xdd\x79\x3d\xdf\x59\xb3\xef\xd0\xed\x95\x8a\xcd\x62\xb0\x46\x89\x82\x05\x3a\x83\xac\x0a\x76\xde\xc8\xd0\xdc\x2d\x9f\xd8\x00\xd9\xa9\x44\xcd\xf7\xca\x33\x34\x56\xd4\x05\x7b\x7a\xca\x3f\x1b\x55\x89\xea\xdf\xff\xfc\xfd\x78\x64\xf0\x84\xaf\x82\x55\xe6\x41\x2b\xc3\x2b\xf8\x46\x20\x64\x85\x58\x26\xa9\x23\x29\xe8\xc0\x79\x5e\x7e\x45\x6d\x6c\xcb\x7d\x46\x4f\xec\x9d\xc0\x83\xf4\x0d\x4e\xa5\xcd\x3b\x05\xae\x2b\x18\xdf\x08\x7b\x66\xcc\x1b\xa3\x1c\xbb\xfd\x6d\x70\x16\x23\xc1\xbf\xc8\xaa\xdb\x2e\xf8\xed\x6c\xbb\xa8\xe4\x3d\x55\xa3\x59\xdd\x4e\x20\xbf\x5d\x34\xab\xdb\xd9\xb6\x1b\x92\x23\x54\xcc\xdb\xbd\x17\x55\xc8\xfa\x4b\x23\xe0\x84\xaa\xe1\x65\x2b\x60\xea\x10\xb2\xeb\xb8\x76\xf4\xf2\xf4\x94\x7f\xb1\xbc\x14\xee\x78\x84\xa7\x1f\x89\x4b\x33\xf0\xdd\xce\x8a\x1d\xf7\xa2\xc2\xdd\x23\x29\x48\x8b\x8e\xfb\x86\x34\x48\x15\x9a\xb7\xc2\xa1\xb6\xa6\x25\x2e\xac\x31\x3e\x30\xf2\xde\x61\x80\x03\x49\x0b\x5e\x36\x88\x68\x95\x0e\xd2\x3b\x78\xe3\xb9\x0a\xc1\xe4\xf8\x55\xc9\xf2\x2b\x78\x2f\xe0\x0d\xfe\x32\xa6\x85\xd4\x59\xa8\x11\x59\xbe\x33\xde\x9b\xf6\x42\xc0\xec\x7d\x3e\xdb\x2e\xba\x01\x19\xd4\xef\x33\xb4\xb1\xdb\xbe\x56\xe7\xec\x50\xe8\x91\x37\xdb\xba\xd2\xca\xce\x4f\xe7\xc0\xff\xf8\x3d\x8f\xd4\x50\xbb\x1f\x92\x07\xa9\x2b\xf3\x90\xe6\xd4\x92\xa4\xde\xeb\xd2\x4b\xa3\x93\xb4\x87\xf3\x3d\xb7\xa8\xb8\xe7\x28\xa8\x90\xa1\x27\xa1\x25\xc7\x63\x44\xb7\xac\x93\xbf\x11\x3f\x0f\x49\x0f\x5a\x64\x97\x4d\x8e\x1b\x4b\xf3\xc6\xb7\x2a\x79\x37\x85\x39\x57\xc2\x7a\x84\xbf\x73\xa9\x6b\x33\x40\x3c\x50\xd8\xed\x97\x46\x58\x01\x6e\x05\xb4\x89\x2d\xf1\xb2\x95\x7a\x47\xf5\x85\x6b\xcc\x43\x1e\xb3\x7c\x97\x6e\x7a\x9f\x56\xf8\xbd\xd5\xf1\xed\x38\x0b\x8f\xc5\x02\xbf\xf3\x47\x2a\x67\xc4\x31\x15\xd9\xad\x71\x80\x74\x81\x60\xea\xda\x09\x3f\x60\x26\xb0\x91\x48\xea\xbe\x36\x4e\x94\x46\x57\x2e\x0d\x20\x18\xac\x91\x98\x12\xf5\xa8\x12\x21\x8f\x4f\x51\xf7\x9d\x43\xd9\x48\x55\x59\xa1\x43\xe4\x8a\xcb\x2a\x38\x0f\x2a\xde\x20\x9c\xd7\x6c\x30\x46\x10\x20\xc0\x9c\xc0\xdb\x87\x15\x46\x04\xc9\xd3\x4b\xd0\xe9\xfd\xb5\xf9\xd8\x95\x98\x0b\x0a\xfc\xe7\xbf\x19\x5a\x7e\xf8\x4d\x74\xbe\x41\x81\x65\x3f\x76\xfa\x4e\x42\xf1\x47\xb3\xf7\x49\x9d\xa1\xe3\x56\x68\x9f\xa1\x22\xc9\x0c\x87\x53\xb7\xea\x3c\xf2\x50\xf4\x42\x9b\x91\x51\xf5\x66\xc3\xf3\x44\x3e\xa0\xc0\x61\x7c\x0d\xa1\xe4\xdd\xde\x35\x49\x3d\xb6\x63\x12\xd3\x1f\xdc\x37\x79\xcb\x0f\xc9\x40\xeb\x83\x18\x65\x7f\xc8\xe9\x18\x25\x75\x3e\x96\xef\xdb\xb7\x90\xd8\x08\x48\x99\xa1\x3c\x05\x8c\x21\xad\x32\x43\xdd\x1b\xc3\x15\x56\x94\xd5\x66\x94\x39\xe0\xaa\x40\x19\xa1\x39\x50\x8f\xe9\x00\x90\x89\x15\x42\x70\x06\xbd\x57\x2a\xc3\x32\xc3\x32\xdd\xcc\xc6\x3a\x5b\xf3\xf0\x8f\x30\xd3\x51\x60\xf5\x4b\x36\x1a\x8f\x03\xa0\x78\x8e\xf4\xc0\x48\xd2\x93\x64\x33\xa8\x8f\xe9\x53\xa8\x29\xde\x9f\x6c\x6f\x46\x77\xee\x7e\x47\xd5\xbe\xce\x9d\x50\xa2\xf4\x97\xc6\x79\xd7\x09\x5d\x25\xcc\xdd\xef\x58\xda\x7b\xc8\xb9\xf7\x36\x61\xc1\x2f\xcb\x62\x60\x17\xbc\x18\x02\xcb\xfa\x58\x86\xfc\x16\x0b\xfc\xc9\x6d\x8b\xd2\x28\x63\x1d\xd5\xd1\xca\x7b\x51\x9d\xc6\x1e\x4d\x41\x38\xc2\x21\x0f\x27\xe8\x31\xc0\xda\x79\x7e\xa7\x04\xee\x84\x7f\x10\x42\x0f\x96\x3a\xbe\x13\xa0\x29\xe2\x90\x70\x07\xa9\xcf\x2f\x85\x34\x3f\xc7\x65\xf0\x99\x90\x83\x53\x5b\xa9\x00\x13\x0c\x83\xee\x98\x84\x88\x32\x10\x21\xb1\x0d\x21\xe5\x4a\xe8\x9d\x6f\x36\x90\x57\x57\x27\x6d\x04\xdd\xa4\xc1\x7b\x5c\xaf\x70\x15\x25\xcb\x86\xdb\x5f\x4d\x25\x3e\xf9\x44\xa6\x29\xde\xd2\xe6\x32\x9a\x3f\x9e\x4d\x0e\x30\xbb\xbb\x4b\x18\xae\x90\x7c\x58\xde\xe0\x0a\x0d\xde\xe2\x66\x99\xe2\x0a\x2c\x0b\xe4\x5f\x96\xb8\x8a\x0e\x7e\x0e\xa6\xce\x98\x44\x5e\x5d\x13\xfd\x86\x94\x59\xca\xce\x46\xd1\x98\x78\xeb\x12\xed\x4e\x51\xf7\xbe\x13\xed\xb0\xc0\x4a\xfc\x94\xe6\xde\x7c\x96\x07\x51\x25\x1f\x82\xf1\xd6\xbd\x62\xa7\x12\x34\xc7\xef\x44\x52\x3f\x33\x56\xe7\xa1\x71\x57\x60\x98\xf7\xd7\x11\x85\xd8\xba\xa4\x1e\x06\x35\xf1\x62\xae\xab\xe5\x12\xef\xd1\x33\xb0\xc0\x64\x9c\x5f\x84\xf2\x36\x65\x63\xa9\x29\xed\x38\xb8\x46\xcb\xf4\x16\xeb\x11\x68\x75\x5e\x9a\xbd\xf6\x44\x08\xf3\x3b\x71\x17\x15\x59\x2c\x20\xdd\x27\x5d\x0a\xe7\x8d\x85\x15\x9d\xb1\xde\xe1\xa1\x11\x61\x73\xe0\x90\x0e\x35\x8c\x85\xd1\xe1\x56\x97\xde\x81\xf7\xd2\xee\x02\x4c\x27\x3b\x09\xcf\x30\x29\x08\x01\x68\x83\x7a\x83\x1a\xc5\x38\xe6\x4e\xec\x70\x81\xd5\x28\x8a\x02\x7c\x4a\x1d\x2b\xe9\xed\x5e\x9c\x06\xca\xf1\x65\xdc\xd4\x5c\x39\x71\x96\xda\x38\xa0\x51\xd0\x89\xee\x0f\xf3\x27\xa5\x12\xd6\x6f\x9e\x2c\xcd\xa9\xd0\x49\x78\x71\x69\x2e\x68\x6d\x4d\x4e\x07\xfc\xd9\xf1\x0e\xb7\x26\x95\xb6\x57\x1f\xb8\x46\x13\x4f\x96\x5f\xd9\x64\x5a\x52\x05\xc2\x26\x41\xf3\x18\xc7\x33\xe1\xd6\xec\x9d\xa0\xb5\xf7\x99\xc2\x38\xca\xc6\x2d\x23\xcd\x69\x69\x48\x26\x50\x7b\xc5\xdc\xde\x4f\xad\x7d\xd7\x18\x63\xc1\x46\x7f\x4d\x51\x32\x63\xd2\xb4\x87\xb3\xd7\x46\xd7\x69\x10\xcf\xb1\xba\x10\xa2\xef\x80\x67\xe9\x8c\x87\x21\x76\x1d\x1f\xfb\x99\x13\x4f\x47\x8a\x35\xd8\x9b\xb2\x2c\xd9\x6b\xd1\x50\xb4\x97\xd1\x1c\x58\x86\xeb\x0b\xda\xe3\x45\x70\x37\x2f\x5b\x0b\x9f\x72\x17\x05\x9d\x0c\x62\xea\x56\xd8\x6b\xe2\x0a\xb0\x93\xf7\x42\x0f\x1b\xc9\xb0\x28\x8c\xf0\x4f\xc1\xfb\x8d\x66\xaf\x54\x9c\xf8\x17\x27\x22\x36\xdf\x94\x7b\x77\x3e\x5e\x5d\xc9\x95\x40\x11\x75\xb0\x40\x10\x39\xbf\x22\x47\x1b\xc1\xfb\x9f\xe1\x3a\x9b\x1c\xa9\x11\xf6\x93\x43\x57\x67\xe8\x7d\x7d\xec\x2d\xaf\xc7\x89\xf2\x3e\xfa\xbc\x9c\xb9\xc1\xf8\x68\x32\x0f\x1f\x42\x09\xab\xa4\xeb\x14\x7f\xbc\xec\xe5\xf3\x83\xf9\xa2\xf3\x6f\xdf\x90\x4c\x19\x44\x0d\xe3\xe0\xc7\x1f\x2f\x92\xb9\x2d\xb0\xcc\x6f\x28\x5c\xba\xf5\x09\x0a\xf4\xa5\xc8\x26\x47\x3d\x3d\xc5\x16\xdb\xec\x2d\xd7\x8e\x3e\x7e\xbe\x13\x1c\x55\x98\x56\xa3\x57\x4a\xb3\xc4\x1a\x09\x2d\x4f\xf3\xbe\xee\x87\xf4\xb2\x3c\xd3\xeb\x28\x78\x54\xdc\x8b\x30\xa8\x0f\x93\xab\x66\x80\x5a\x32\x2c\x68\x97\x0b\x45\x3a\xbd\x81\xa6\x7b\xcf\x00\xcb\x61\xbd\x88\x47\xee\x62\x85\x38\xd5\xea\x15\xad\x78\x34\x22\x96\x5f\xae\x05\x55\x82\xee\x5e\x37\xac\x7f\xb5\x32\xc6\x26\xc9\x79\x1b\xe6\xf8\x29\xc5\x02\x3f\xa7\x9b\xe9\x40\x8e\x7a\x5b\x5c\xbf\xd8\x79\xc6\x9e\x0f\xe4\x8b\x9b\xaf\xdf\x0f\xb0\x2d\xfa\x18\x3e\xf6\x0c\xac\xfb\x1f\xb9\xdb\xdf\x39\x6f\xa5\xde\x25\xcb\xac\x17\x9a\x23\x5e\x73\x79\xce\x5e\xde\x16\xc3\x99\xa2\xa9\x1d\xa8\xc4\xdb\x2e\xe2\xb7\xd4\xed\x6c\x36\xfc\x37\xe6\xff\x03\x00\x95\x4d\x72\x7e\xbd\x11\x00\x00"),
+			uncompressedSize:  4541,
+		},
+		"/incomplete.html": &_vfsgen_compressedFileInfo{
+			name:              "incomplete.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x54\xcd\x6e\xdb\x30\x0c\xbe\xfb\x29\x08\x23\x87\x0d\x68\x6c\x14\xbd\x15\xaa\x81\x01\xbd\xf4\xd0\x6d\x40\xf3\x02\xac\xc5\x44\x02\x64\xd9\x93\xe8\x65\x81\xa0\x77\x1f\x24\xff\xd4\x4d\xb7\xf6\x50\x93\x34\x7f\x3e\x7e\xfc\x9c\x10\x24\x1d\xb5\x25\x28\x0f\x9a\x0d\x95\x31\x3e\xd9\xb6\xef\x06\x43\x4c\x70\x70\xd8\x92\x87\x3d\xe0\x30\x48\xf4\x2a\x04\xb2\x32\xc6\xa2\x78\xab\x7a\x46\x6d\xcb\x14\x12\xea\xb6\xf9\x50\x2a\x6a\x75\xdb\x14\x85\x18\xa0\x35\xe8\xfd\x43\xc9\xf4\x87\xf7\xdd\xc8\x24\xcb\xa6\x00\x08\xc1\x90\x85\x6a\x4a\x8e\x11\xfa\x23\x84\x50\x1d\x7a\x46\x13\x23\xf0\x34\x1e\x1d\x41\xa7\xbd\xd7\xf6\x04\x7e\x40\xeb\x2b\xf8\x96\x0d\xd0\x7e\x7d\x71\x56\x64\x0b\x80\xde\x52\x6a\xa2\xd9\x43\xab\xb4\x91\x53\x01\x9c\xd1\x43\xdb\x1b\x43\x2d\x93\x84\xb3\x66\xd5\x8f\x0c\x9a\x6f\xe0\xac\x74\xab\x60\xf4\x23\x1a\x73\x81\x8e\x52\x36\x2b\x64\xc0\x02\xc0\x93\xfb\xad\x5b\x02\xa9\x25\xd8\x9e\x61\x70\xfd\x80\x27\x64\x02\x56\x04\x2f\x03\xda\xfd\xd3\x23\x28\x42\x49\x2e\x8d\x4d\x51\x47\xbf\x46\xf2\xa9\x79\x9e\xaa\xd0\x4a\xa3\xed\xa9\x2a\x00\x0e\x8a\xc0\x62\x47\x7e\xce\xf5\x04\xbd\x1b\x14\xda\x19\xe5\xd0\x6b\xcb\xc0\x7d\xee\x33\xcf\xf6\xd0\xa2\x31\x24\xe1\xf5\x02\x7e\x6c\xd5\x16\x57\x55\x88\x7a\x68\xd2\x35\xf4\x11\xaa\x97\xb1\xeb\xd0\x5d\x62\x2c\x84\xba\x6b\x7e\x6c\x1a\x8b\x5a\xdd\x35\x85\x60\x7c\x35\xb4\x1e\x22\x3b\xf9\xff\xbe\xed\xad\x24\xeb\x49\xce\xbe\x67\xa7\x87\xf9\x42\x82\x5d\x23\x58\x35\x69\xd9\x0c\x5e\xd4\xac\x72\x64\xb9\x70\x72\x6b\x76\xd3\x39\x1d\xda\x13\x6d\xb1\x00\x2c\x3d\x64\x33\xe1\xfc\x8e\x1d\xc5\x18\xc2\x6a\x90\xf1\x14\xa3\xa0\xae\x19\x6d\x9a\x20\x45\x4d\x5d\x33\x6b\x4d\xd4\x2c\xe7\xe2\x55\x26\x53\x6c\x9d\x39\x69\x52\xd4\x19\x7c\x52\x9b\xba\x5b\xc1\xe5\xc5\x47\xb3\x6c\x6d\xb4\xe7\xfd\x68\x3d\x5f\xcc\xaa\xc0\x19\xf2\xd2\x7c\x42\x6c\x74\x93\x0d\x00\x81\xa0\x1c\x1d\x1f\xca\x10\x46\x67\x0e\x7d\xce\x83\x2a\xf1\x51\x3d\x3d\x4e\x65\x31\x96\x09\xdf\x55\x4c\xd4\xb8\x34\xd9\x6e\x2e\x3c\xbb\xde\x9e\x9a\x95\x01\x51\xaf\x91\x69\x95\x79\x70\x96\xf8\x3f\xbe\x9b\x2f\xef\x3e\x86\xfb\x75\x85\x9d\xbe\x81\x9d\x96\x70\xff\x00\xd5\xf3\x94\x92\xe8\xd5\x47\xd8\xe9\x18\x6f\x16\xa6\x42\xd8\xe9\xfc\xc8\xde\x57\x51\xa7\x36\xeb\xb6\xa3\x59\xcc\x0d\x37\x93\x98\xfc\x0a\xed\x8a\xa2\x4f\x88\xca\xb2\x79\x4f\xcc\x9b\x9b\x9e\x13\x75\x9f\xe8\x22\x84\xab\xfc\x55\x18\x78\x35\xff\xbf\x7c\x0d\xe8\xc8\x32\x6c\x3a\xfd\xcc\x91\x0f\xdb\xa7\x3f\x51\x6f\x17\xbb\x3a\x49\xbd\xd0\xb3\x64\xbd\xa9\x2f\xbd\xd9\xfc\x3e\x4e\xc6\xdf\x01\x00\xc7\xff\xc5\x81\x5e\x05\x00\x00"),
+			uncompressedSize:  1374,
+		},
 		"/layout.html": &_vfsgen_compressedFileInfo{
 			name:              "layout.html",
-			modTime:           mustUnmarshalTextTime("2016-05-31T20:45:42Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x5a\x7b\x73\xdb\x36\x12\xff\x3f\x9f\x02\x61\xda\xb1\xdd\x33\x49\xbf\xf2\xb2\x25\xf5\x72\x49\xaf\xc9\xcd\xb5\xce\x34\x6e\x67\xee\x3a\x99\x0e\x44\x82\x22\x6c\x90\xe0\x01\xa0\x65\x55\xf5\x77\xbf\x05\x40\x90\x20\x45\xd9\xea\xb5\x73\xd6\x4c\x44\x02\x8b\xdd\xdf\x3e\xb0\xbb\x80\xb2\x5e\xa7\x24\xa3\x25\x41\xc1\x0f\x97\x97\x57\xc1\xfd\xfd\x93\xc9\xd3\x77\x97\x6f\xaf\xfe\xf5\xf1\x1b\x94\xab\x82\xcd\x9e\x4c\xec\x17\x42\x93\x9c\xe0\x54\x3f\xc0\xe3\x1c\x4b\x82\x72\x41\xb2\x69\xb0\x5e\x47\x7f\x83\xb7\x1f\x7f\xf8\xe7\xfd\x7d\xd0\x4c\x2b\xaa\x18\x99\xad\xd7\x8a\x14\x15\xc3\x0a\xb8\x5f\xe9\x91\x00\x7d\x71\x7f\x3f\x89\xed\xac\xa5\x2c\x88\xc2\x28\xc9\xb1\x90\x44\x4d\x83\x5a\x65\xe1\xab\xc0\x9f\x2a\x71\x41\xa6\xc1\x2d\x25\xcb\x8a\x0b\x15\xa0\x84\x97\x8a\x94\x40\xba\xa4\xa9\xca\xa7\x29\xb9\xa5\x09\x09\xcd\xcb\x21\xa2\x25\x55\x14\xb3\x50\x26\x98\x91\xe9\xb1\x63\xc4\x68\x79\x83\x04\x61\xd3\x80\xc2\xf2\x00\xa9\x55\x05\x3c\x69\x81\x17\x24\xae\xca\x45\xd0\x28\x12\x4b\x85\x15\x4d\xe2\x0c\xdf\x6a\xba\xc8\x4c\xc5\x3e\x8f\x86\x2e\x2e\x89\x4a\x4b\x1c\xcd\x39\x57\x52\x09\x5c\x25\x69\x19\x25\xbc\x88\xdb\x81\xf8\x34\x3a\x8d\x8e\xe3\x44\xca\x6e\x2c\x2a\x28\x50\x49\x19\x58\x28\x52\xad\x18\x91\x39\x21\x6a\x13\xa6\x37\xd7\xca\x04\x19\xd7\x32\x4a\x18\xaf\xd3\x8c\x61\x41\x8c\x40\x7c\x8d\xef\x62\x46\xe7\x9e\x98\x50\xe1\x39\x23\xf1\x71\xf4\x22\x3a\x1a\x8e\xb6\x10\x36\x94\xda\xcb\x95\xaa\xce\xe3\x38\x03\xf3\xca\x68\xc1\xf9\x82\x11\x5c\x51\x69\xa4\xc0\x8a\xaf\x33\x5c\x50\xb6\x9a\x5e\x56\xa4\xfc\xcb\x27\x5c\xca\x3d\x83\x74\xaf\x43\xba\x67\xcd\xba\xa7\xc8\x9d\xd2\x2b\xf6\x76\xd2\xaa\xc0\x77\xda\x78\x1b\x96\xd4\x38\x42\xbc\x24\x92\x17\x24\x3e\x03\x63\x1e\x19\x63\xfa\xc3\x43\x65\x0c\x7b\xfb\x6c\x22\x17\xad\xed\x33\x42\x15\x97\x10\x17\xbc\x3c\xd7\x38\xc0\xc5\xb7\xe4\xc2\x4d\x01\x8f\x30\x27\x74\x91\xab\x73\x74\x7c\x74\xf4\x65\x33\x71\x6f\xbf\xe6\x3c\x5d\x79\x6c\x70\x9a\xd2\x72\x11\x2a\x5e\x9d\xa3\xe7\x47\xd5\x5d\xcb\x65\x8e\x93\x9b\x85\xe0\x75\x99\x86\x09\x67\x5c\x9c\xa3\x67\xd9\x89\xfe\xb4\x14\x6e\xf8\xd4\xfc\xb5\xc3\x46\x1f\x6b\xda\x73\xb4\xa7\x8d\x8b\x8c\x71\x0f\x91\x84\xaf\x50\x12\x41\xb3\x8b\x27\x8e\x3a\xfe\x0a\x7d\x87\xc5\x82\x96\x00\x4c\x29\x5e\xa0\xf9\x0a\x38\x70\x45\x04\xb2\x3a\xa0\xaf\xe2\x56\x31\x43\x18\x5a\xc2\x73\xf4\xa2\x83\xdb\xe8\x16\xa5\x47\x68\x3d\x86\x7c\x3e\x9f\x5f\x74\x44\xc7\xe3\x44\x49\x32\x7f\x39\x7f\xe9\xd1\x9d\x6c\xa3\xc3\x2f\xb1\x4f\x77\xba\x8d\xee\x35\xfc\x79\x74\x67\xdb\xe8\x5e\x9d\xbc\x3a\xf1\xe8\x9e\x6f\xa3\x7b\xf9\xe2\xe5\x0b\x8f\xee\xc5\x36\xba\xb3\xec\x2c\xf3\xe8\x5e\x6e\xa3\x3b\x7d\x7d\xea\xe3\x7b\xb5\x8d\xee\x04\x9f\x60\x8f\xee\xf5\x36\xba\x63\xf8\xf8\x76\xde\xe2\x8d\x24\x39\x22\x47\x44\x13\x3e\x71\x31\xf0\x3d\xe4\xa7\x05\xd6\x01\x0d\x0b\x84\x0d\x2d\xd9\xba\x3e\x2a\xf1\x2d\x0c\x87\xb4\xbc\x25\x90\x54\xbb\xf0\x1d\x61\x3e\x88\xc6\x39\x17\x29\x81\xf1\x92\x97\xa4\x0d\x96\x6d\x62\xcd\xbe\x7e\x44\xb6\x7b\x87\xaf\x19\xa3\x33\xdc\x81\x19\xdd\x26\xf7\xbb\x71\x39\xcf\x39\x4c\x1c\x3e\x4e\x97\xf1\xa4\x96\x9b\x32\xd3\x34\xdd\x5d\x60\x84\x13\x9d\x30\x66\xf8\x61\x79\x2d\xd9\x2e\xe0\x3a\xe2\x71\x84\x73\x06\xae\xda\x39\xb9\x34\x4a\x3c\x63\x7c\xc1\x3b\x56\xa6\x22\x9e\x23\x5c\x2b\xde\x72\x72\x89\xee\xcc\xcf\x5d\x36\x51\x40\x42\xdb\x18\x0b\x45\x93\x17\x49\x31\x88\x86\x08\xea\x63\x68\x15\xea\x24\x9a\x5c\x26\xe9\xaf\xe4\x1c\x9d\xfa\x02\x1e\xc8\xbe\x26\x93\x86\x67\x1e\x71\xc6\x38\x06\x91\x8c\x64\xea\x62\x98\x77\x1b\x38\xd1\xe9\x26\x9e\x26\x0b\x8e\x64\x7c\x3c\x97\x9c\xd5\x8a\x78\x41\x6e\x33\xe2\xd1\xc5\xc0\x54\x5e\xfa\x37\xf1\xfe\x89\x28\xa4\x72\x82\x32\x7a\x47\x52\x97\x60\x79\x66\xc7\x5c\xd6\x15\xc4\xcb\xb9\xce\xbe\x2f\x1e\xab\x0d\xcf\xf5\xa7\xb3\x02\x54\xcc\x10\x33\xba\x00\xb8\x09\xf4\x36\x44\x0c\xc2\xb3\x91\xe6\x6d\x1f\xb3\x24\x25\x09\x17\xd8\xaa\x09\xec\x89\x80\x2d\x49\x7a\x4b\x27\xb1\x57\x14\x27\x32\x11\xb4\x52\x48\x8a\xc4\xf4\x13\x3c\x25\xd1\xf5\x7f\x6a\x22\x56\xa6\xe2\xda\xc7\xf0\x24\x3a\x86\xcf\x35\x54\x55\x58\x6c\x16\x8c\xae\xde\xb5\x03\xba\x1e\x36\x40\x8f\x72\xfe\xd3\xfa\x9c\x3f\x2a\x29\x3d\x05\x1d\xce\xa2\x33\x78\x78\x8c\x97\xdf\x02\x37\x4d\xe4\x35\xc5\x79\x8d\xcb\x05\xac\x0e\x15\x2d\x88\xf6\x8d\xff\xfc\x3f\xb0\xbc\x11\x54\xde\xc4\x59\x2d\x89\xf9\x67\x17\x25\x47\xb8\xfc\x4a\x04\x4f\x18\xad\xe6\x1c\x8b\x74\xf0\xf6\x6f\x78\x7b\xeb\xde\x46\xf9\x4f\x62\x77\x08\x98\xe8\xe6\xa8\x11\x09\xa9\x00\x25\x0c\x4b\x39\x0d\x9a\xac\x30\xc8\x7e\xcd\xab\xd9\x4a\xba\x7f\x82\x1e\x98\x43\x87\xae\xa9\x9b\x9a\xd2\x74\x71\xc0\x2c\xa5\x2d\x33\xdd\xec\x63\x30\x96\x68\x67\xfb\xf3\x0d\x5b\x0d\xa9\x47\xa3\xd1\xd5\xb0\xcd\xcb\xa6\xd5\xb7\x2f\xc1\x60\x99\xe2\x0b\xe8\x72\x75\xd2\x65\xb8\x92\x24\x0d\x50\x8a\x15\x6e\x86\xb5\x70\x3b\xee\x86\x21\x27\xea\x23\xca\x33\xbb\x3a\x40\x58\x50\x1c\x92\xbb\x0a\xc3\xd6\x4b\xa7\x41\x86\x99\xa6\x35\xa3\x1a\x37\x28\xd8\x8a\xea\x41\xd3\x2e\x82\x45\x0e\x8c\x14\x21\x2f\xd9\x2a\x98\x5d\x59\x38\x9d\x49\xc0\xee\x40\xf7\xc0\x52\x7d\x4a\x09\x0d\xfb\xff\x17\xe9\x24\xb6\xa6\xec\x8d\xe1\xb1\x83\xa0\xe3\x56\xd5\x8c\x85\x3a\x9d\x03\x3b\x5a\x2c\x10\x05\x53\xe9\x4a\x15\x34\xbb\xb0\x89\x4a\x3d\xf4\xcb\x32\xa7\x8a\x98\x63\x17\x88\xc6\x9e\xcb\x63\xf0\xf9\x20\x02\x34\x1f\xe7\x89\x36\x5a\xac\xc3\x5c\xb4\xb5\xef\x06\x83\xa9\x1e\xfd\x18\xa9\x99\x17\x11\xa8\x2b\xd0\x40\xd5\x33\xcf\x7a\x4d\x33\x14\xbd\xc7\xb7\xe4\x1d\x96\xb9\xd9\x1c\xf7\xf7\x3d\x0a\x60\xf6\x34\x0c\xd1\x95\xc0\x09\x91\x28\x15\xbc\x4a\xf9\xb2\x44\x05\x29\x6b\x14\x86\xb3\x21\x2d\xa3\x4e\xb0\x23\x0d\x86\x34\xbe\x61\x9f\x05\x43\xf2\x26\x48\x07\x11\xdb\x32\x6b\x76\x97\x0b\xfb\xd1\x48\x05\x77\x38\xae\x19\x46\x19\x0e\x21\x03\x42\xe4\xc2\x49\x5c\xa1\xae\xbc\x6b\x4f\xd0\x99\x53\xac\x17\x27\x09\x2c\x50\x6d\x90\xf4\x1c\x36\x66\xe2\x16\xba\x36\x8a\x43\x68\x9e\x47\xd6\x19\x1b\xcd\x5a\x03\xa4\xce\xec\x70\x76\xd7\x77\x07\xb0\x6b\x72\xbe\x94\xa6\x14\x77\x73\xb3\xd6\x3b\x1a\xcc\x04\xb2\xf8\xe3\x9c\x95\x51\x6c\xc0\x16\x33\x86\xec\xc4\x21\x22\x77\x09\xab\x75\xfb\x81\xf0\x62\x21\x08\xec\x4c\x68\x06\xa0\x3f\x86\xbc\xf8\x06\xc8\xac\x61\x1e\x90\x37\x89\x6b\xb6\x31\xbc\x49\xbb\x5e\x13\x70\xca\x66\x54\x8d\xf1\xdc\x15\xfc\xa8\x61\x7f\xbf\xd7\x37\xe5\x6f\xba\x7a\x54\xa1\x52\xef\x92\x27\x8f\xa8\xd3\x2a\xa3\x2f\x20\xe4\x79\x1c\x2f\x78\xca\x93\x88\x8b\x45\x2c\x79\x2d\x12\xb2\x80\xaa\x9e\x9b\xd2\xec\xbd\xc7\xb8\xaa\xb4\xe3\x41\xf7\x26\x2d\xff\x02\x4d\x73\x79\x33\xa2\xf2\x50\x61\x68\x15\x6e\x36\x55\x7d\xc7\x93\xa1\xa2\x1b\x6a\x6e\x2a\xb9\x93\x3e\x54\xe5\xf5\xfc\x4f\x54\xc0\x32\xdc\x54\xe1\x5b\xaa\xde\xd7\xf3\xdf\xab\x44\x3f\x3e\x6d\xa6\xd5\xb9\x2c\xd6\x07\x96\x2e\x85\x76\x49\xcc\x4b\xc6\x93\x58\x9f\x66\xac\x8b\x1f\x2e\xdb\xfe\x4d\xe0\x77\x30\x65\x2e\x02\x9f\x78\xec\xec\x73\xd3\xe8\x3a\x75\xcd\xdb\xee\x8d\x41\xe5\x66\x4d\x77\x5c\x40\xd3\x0f\x39\xe1\x8d\xb5\x33\xa2\xb0\x33\x4a\xc4\x2b\x52\x86\xd6\x0d\xa8\x12\xfc\x9a\x24\x0a\x25\xb0\x03\xf4\xa6\x9e\xaf\x36\x9d\x37\x0c\xc1\x60\xf6\xa9\x1b\xd1\xb6\x8d\x26\x71\x35\x6a\x19\x0b\xde\x29\xe6\x37\x68\x08\xed\x67\x75\x99\xe8\x0a\xbf\x7f\xd0\xb5\xf4\x28\x8e\xd1\xdf\xa1\x8f\xf1\xcf\x17\xb4\x74\x57\x9a\x6c\x15\xb5\x84\x5f\xec\x07\xee\x48\x50\x05\x07\x51\x4e\x53\xb2\x7f\x10\x65\xb0\xf6\x03\x70\xec\xae\x8b\xd0\x2d\x34\x62\x39\x2d\x95\x44\x53\xf4\xb3\xe7\xf3\x3d\x67\x14\x30\xc1\x2d\xac\x06\xd3\xa0\x6f\x39\x7a\x7f\x75\xf5\x11\x15\x34\x4d\x19\x59\x42\x5a\xd0\xd2\x35\x16\x30\xca\x20\x46\xff\xc8\x8e\x8d\xf5\x2a\x93\x9f\x82\xd9\xc6\x90\xb6\x28\x1c\xf7\x92\x1b\xbc\x20\x4f\xf7\x0e\x7d\xc8\x1f\xc0\x51\xe0\xc0\x39\x41\xd0\xfa\xa6\x28\x13\xbc\x78\x1c\xd9\x03\x78\xb6\xe1\xfb\x6b\x81\x25\x58\x36\x8e\x94\x20\x24\xae\x56\x2a\xd7\xbd\xe9\x12\xf6\x1c\x98\xe3\xa3\x79\x45\x40\xca\x68\x62\x7a\x34\x93\xfa\xe1\x1c\xcb\x9f\xa2\x37\xf6\x5a\x72\x80\xfb\x3d\xd8\xff\x1c\x41\x53\x9d\xdc\x58\x6b\xc2\x31\x85\x97\x8b\xd9\x5b\x5e\xad\x10\x96\xe8\x1f\x9f\x2e\xbf\xd7\xc7\x34\x33\x88\x5c\xd7\xca\xa1\xee\xe8\xfb\x6e\x70\x8c\x31\x8c\xa3\x84\x18\x4e\x91\xcc\x8d\x77\x14\xd2\xa8\xd0\x0a\x74\x00\x73\x50\x48\xb7\x72\x54\xf6\x95\x27\xf5\x27\x22\xe6\x1c\xb6\xf3\x3b\x68\x1b\xd0\x4f\x94\x2c\x3b\xd1\x70\x70\x42\x5d\x05\x69\xce\xb9\xba\xbd\x00\xd9\x92\x27\xd4\xec\x11\x23\x51\x4f\x24\xb5\x10\x70\x54\x45\xba\xf6\x47\x8f\x49\xfd\x28\x78\x46\xa1\xb5\xdd\x14\xc8\x08\x04\x27\x68\x80\x24\x21\xad\xae\xd7\xb5\x54\x88\xd1\x1b\x13\x81\x58\x07\xa9\x5e\x2d\x1e\x30\xac\xf6\x49\xb9\x32\x60\xd0\xbe\x81\xa7\x4f\xda\x80\x57\xc0\x06\x87\x43\x18\x23\xf2\x40\x1b\xb5\xc4\x42\xf0\xa5\x66\x0b\x2b\xc0\x80\xc6\x9a\x44\xdb\x52\x22\xfd\x6b\x43\xa8\xf5\x1d\x95\x73\x59\xf6\xbc\xe7\xca\xbe\xd3\xa5\x82\x80\x35\x7a\xe8\x18\x95\x84\xe9\xbc\xa2\x99\x37\x5e\x2c\x6a\xa6\x68\x05\x16\xb0\x85\xd9\x79\x73\x54\xd2\x87\xa2\x71\xbc\xf1\xb7\xb5\x88\x89\x76\x5c\x72\x80\x20\x50\x9b\xd1\x4a\xe8\x9d\x4b\x98\x85\xc4\x95\x68\x33\xe8\x26\xc5\x01\x6c\xb8\x8c\x46\x17\x7f\x5c\x97\xa7\x7b\x2d\xb0\xcf\x5e\x42\x81\x14\x55\x42\x6e\xd5\x40\xc1\xb4\xaa\x16\xa5\xed\xc1\xf4\xa0\xc9\x34\x2e\x67\xd8\xac\x03\xd6\xc6\x2b\x78\xc7\x30\x09\x2a\x03\x7e\xd8\xbf\xa4\xf4\xd9\x55\x82\xdc\x52\x5e\x4b\xb6\x42\x29\x95\x50\x21\x56\x24\x8d\x7a\x09\x4c\x6f\xf7\xf7\x2e\x89\x7d\xbe\xe8\xcd\x41\xba\xb7\x60\xa6\xa8\x84\x1e\xbf\x9b\x74\x09\xb6\x85\xbb\x4f\xfd\x54\x6b\x57\x97\xb0\xec\x3b\xac\xf2\x28\x63\x9c\x8b\xfd\x7d\xf3\x2c\xc0\x6d\xbc\x80\xc4\xfc\x15\x3a\x26\xaf\x0f\xd0\x97\x56\x97\x88\x91\x72\xa1\x72\x3f\xbb\xda\x8c\x4d\x05\x04\x2b\x1c\x5b\xec\x45\xcc\xd7\xe8\x93\xd2\xad\x94\xd9\x27\x18\xa4\x2f\x91\x65\x08\xf8\x8a\x39\x38\x0f\x8c\x53\x46\x1e\x0b\x9a\xed\x53\x34\xb5\xf0\xd1\x6f\xbf\x21\xf3\xe2\xd4\xea\x43\x46\x8d\xc9\x3b\x9d\xca\x83\x0b\x6f\xfe\x7e\x00\xed\x2d\x23\x3d\xf3\x59\x6f\x2c\x01\x80\x0e\x7d\xa8\x87\x19\xc8\x1c\x60\x69\xa9\x1b\x7d\xd1\x6c\xda\xd7\x7f\x80\x68\x9b\x73\x36\xd1\xbc\x23\x60\xa3\x42\xff\xd0\x48\xb3\x36\x44\x40\x5d\x1d\x18\x3a\x28\x6c\x5a\xc7\x0c\x8a\x71\xba\xf2\x51\x7d\x11\x11\x9c\xe4\x1d\xb2\xc3\xd6\xb9\xfb\x75\xa9\x47\xa1\x47\x1f\xc2\x02\x4d\x88\x36\x24\x1d\x4e\x18\x28\x3f\x7a\x92\x0e\x61\x87\xad\xba\x18\xee\x39\x2b\x1a\x2c\x7d\xd8\xfc\xed\x75\x9d\x7d\xee\x47\x8a\x17\xa8\xd4\x5f\xd4\xd9\xbb\xaa\x65\x0e\x41\xea\x4f\x36\xf2\xbc\x05\x9e\x51\xdb\x08\x6f\xb6\x8d\x41\x74\xd0\x4e\xfb\x6a\x0f\xfa\x05\xdd\x28\x5c\xd6\x6a\xbc\x09\xb1\xf4\x50\xec\x24\x34\x16\xaa\x60\xfb\xc6\xfb\x3f\x77\x3a\x43\xcc\x1c\x7c\xf6\xbb\x8d\xbe\xce\x9b\xb6\x90\x44\x7d\xd0\x77\x9a\xb7\x98\xed\x7b\x58\x0f\xf5\x7d\xeb\xd1\x51\xbb\xe4\xfe\xc0\x31\xeb\x5f\x37\xd9\x5b\xa6\x49\x6c\x7f\x84\x6e\x4f\x15\xdd\xcf\xd6\x36\xd3\x7d\x63\x12\x6d\x60\x9a\x4a\x73\x14\xa7\x66\x38\x74\x09\xb8\x3b\x88\xb7\xb7\x07\xeb\x75\xf4\xe1\x9d\x77\x53\xd1\xde\xf7\x34\x2d\x9c\x39\xd0\x83\xde\x6f\x20\x50\xda\xfe\x17\x5a\x54\x51\x34\x87\x57\xfd\x38\xda\x9d\xea\x89\x50\xdf\xf9\x56\x5e\x7b\xba\x5e\x9b\xa4\x10\x99\x1f\xc2\x7b\x07\xbd\x09\xc3\x73\xc2\xa0\xe7\x13\x1a\x44\x01\x58\x55\x0b\xca\x1c\xed\x82\x19\x60\xd5\x3f\x9c\x1b\x42\x9f\xa5\xb5\x46\xcb\x48\x37\xbe\xfa\x40\x67\xae\xfd\x81\x15\x94\x1c\x05\x40\x79\x96\x05\x3d\x6c\xcd\xfd\x14\x72\xf4\xfa\x38\xbe\x84\xc9\xe7\x5a\x92\x85\xf9\x13\x66\x35\xc0\x34\x82\x1b\x39\x93\xd8\xd1\x6f\xe9\x78\x45\xe1\xfe\x77\x80\x88\x3d\x23\xbe\xb1\x81\x0a\x19\x49\x97\x2b\xd6\x94\x21\xd9\xd9\xd4\x33\xdd\x5c\xe9\xbb\x0d\xce\xfc\x4b\x33\x07\xc9\xf2\xf1\xf5\x6d\x0a\x5a\xb7\x16\xe9\xf5\x10\x19\x18\x0a\xae\x77\xed\x83\xb0\x59\x1a\xf4\xaf\x03\x9d\x55\xfb\x57\x5a\x7d\xa3\xee\x2e\x22\x31\xca\x0d\x44\xec\x76\xa3\xe8\x22\x71\x66\x0d\xd4\x47\xe4\xdb\x38\xb7\x76\x6d\x86\x1c\xd2\xff\x06\x00\x00\xff\xff\x74\x15\xd6\xfd\xc3\x21\x00\x00"),
-			uncompressedSize:  8643,
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x5a\xff\x6f\xdc\xb6\x92\xff\xdd\x7f\xc5\x44\x69\xe1\x75\xcf\x92\xec\xd8\xf9\xe6\xec\x6e\x2f\x97\xf4\x9a\x1c\xae\x4d\xd0\xb8\x05\xee\x8a\xa2\x18\x49\xa3\x15\x6d\x8a\xd4\x23\xa9\xb5\xb7\x5b\xff\xef\x0f\x24\x25\x2d\xf7\x5b\xec\xbc\x16\x2f\x06\x62\x89\x1c\xce\x7c\xe6\xc3\xe1\x70\x48\x79\xb9\x2c\xa8\x64\x82\x20\xfa\xe9\xc3\x87\xcb\xe8\xee\xee\x60\xfc\xe8\xed\x87\x37\x97\xff\xf7\xf1\x3b\xa8\x4c\xcd\xa7\x07\x63\xff\x0b\x60\x5c\x11\x16\xf6\x01\x60\x9c\xa1\x26\xa8\x14\x95\x93\x68\xb9\x4c\xfe\x0b\x35\xfd\xfc\xd3\xff\xde\xdd\x45\x5d\xb7\x61\x86\xd3\x74\xb9\x34\x54\x37\x1c\x0d\x41\x74\x69\x5b\x22\xf8\xea\xee\x6e\x9c\xfa\x5e\x2f\x59\x93\x41\xc8\x2b\x54\x9a\xcc\x24\x6a\x4d\x19\xbf\x88\xc2\x2e\x81\x35\x4d\xa2\x39\xa3\x9b\x46\x2a\x13\x41\x2e\x85\x21\x61\x26\xd1\x0d\x2b\x4c\x35\x29\x68\xce\x72\x8a\xdd\xcb\x31\x30\xc1\x0c\x43\x1e\xeb\x1c\x39\x4d\x4e\x7b\x45\x9c\x89\x6b\x50\xc4\x27\x11\xcb\xa5\x88\xc0\x2c\x1a\x9a\x44\xac\xc6\x19\xa5\x8d\x98\x45\x9d\x23\xa9\x36\x68\x58\x9e\x96\x38\xb7\x72\x89\xeb\x4a\x43\x1d\x9d\x5c\x2a\xc8\x14\x02\x93\x4c\x4a\xa3\x8d\xc2\x26\x2f\x44\x92\xcb\x3a\x1d\x1a\xd2\xb3\xe4\x2c\x39\x4d\x73\xad\x57\x6d\x49\xcd\x44\x92\x6b\x1d\x79\x28\xda\x2c\x38\xe9\x8a\xc8\x6c\xc3\x0c\xfa\x06\x9b\x79\x21\xae\x74\x92\x73\xd9\x16\x25\x47\x45\xce\x20\x5e\xe1\x6d\xca\x59\x16\x98\x89\x0d\x66\x9c\xd2\xd3\xe4\x59\x72\xb2\xd9\x3a\x40\xd8\x72\xea\x30\x4d\x4b\x29\x8c\x4e\x66\x52\xce\x38\x61\xc3\xb4\x33\x90\x6b\xfd\x6d\x89\x35\xe3\x8b\xc9\x87\x86\xc4\x7f\x7c\x42\xa1\x0f\x1d\xc8\xc3\x15\xc8\x43\xcf\xe8\xa1\xa1\x5b\x63\x47\x1c\x3e\xc8\xa1\x1a\x6f\x2d\x6f\x5b\x24\x5a\x1c\x31\xde\x90\x96\x35\xa5\xe7\xc9\x59\x72\xe2\x78\x0c\x9b\x37\xfd\x70\xea\xfd\xb3\x0b\x5a\x58\xfa\x67\x80\x46\x6a\x66\x98\x14\x17\x16\x07\x1a\x36\xa7\x57\x7d\x57\xcd\x44\x5c\x11\x9b\x55\xe6\x02\x4e\x4f\x4e\xbe\xee\x3a\xee\xfc\xaf\x4c\x16\x8b\x40\x0d\x16\x05\x13\xb3\xd8\xc8\xe6\x02\x9e\x9e\x34\xb7\x83\x96\x0c\xf3\xeb\x99\x92\xad\x28\xe2\x5c\x72\xa9\x2e\xe0\x71\xf9\xc4\xfe\x0c\x12\x7d\xf3\x99\xfb\x37\x34\x3b\x7f\x3c\xb5\x17\x70\x68\xc9\x05\x47\xee\x31\x68\x14\x3a\xd6\xa4\x58\xf9\xea\xa0\x97\x4e\xbf\x81\x1f\x50\xcd\x98\x80\x4c\x1a\x23\x6b\xc8\x16\x50\x4a\x69\x48\x81\xf7\x01\xbe\x49\x07\xc7\x9c\x60\xec\x05\x2f\xe0\xd9\x0a\x6e\xe7\x5b\x52\x9c\xc0\x72\x17\xf2\x2c\xcb\x5e\xad\x84\x4e\x77\x0b\xe5\x79\xf6\x3c\x7b\x1e\xc8\x3d\xd9\x27\x87\xcf\x31\x94\x3b\xdb\x27\xf7\xf2\xe5\xcb\x97\x81\xdc\xf9\x3e\xb9\x17\x4f\x5e\x3c\x09\xe4\x9e\xee\x93\x7b\xfe\xec\xf9\xb3\x40\xee\xd9\x3e\xb9\xf3\xf2\xbc\x0c\xe4\x9e\xef\x93\x3b\x7b\x79\x16\xe2\x7b\xb1\x4f\xee\x09\x3e\xc1\x40\xee\xe5\x3e\xb9\xd3\xfc\x34\x0f\x79\x3e\xd9\x27\x78\x42\x27\x64\x05\x0f\xfa\x18\xf8\x11\xe7\x6c\x86\x36\xa0\x21\x43\xe5\x43\x4b\x0f\x53\x9f\x08\x9c\x67\xa8\x62\x26\xe6\xa4\x34\xad\xc2\x77\x87\xf2\x8d\x68\xcc\xa4\x2a\x48\x5d\x80\x90\x82\x86\x60\xd9\x67\xd6\xad\xeb\x7b\x6c\xf7\xef\x02\xe7\x53\xce\xa6\xb8\x02\xb3\x73\x99\xdc\x3d\x4c\xcb\x45\x25\xe7\xa4\x8e\xef\x97\x2b\x65\xde\xea\x6d\x9b\x45\x51\x3c\xdc\x60\x82\xb9\x4d\x18\x53\x3c\x7e\x98\xd8\x43\xc0\xad\x84\x77\x23\xcc\x38\xe6\xd7\x0f\x4e\x2e\x9d\x13\x8f\xb9\x9c\xc9\x95\x2a\xb7\x19\x5e\x00\xb6\x46\x0e\x9a\xfa\x44\x77\x1e\xe6\x2e\x9f\x28\x2e\xe0\xe9\x56\x5b\xac\xba\xbc\x48\xf5\x46\x34\x24\x2c\x97\xb1\x77\x08\x96\x6b\xb9\x4c\xb3\x3f\xe8\x02\xce\x42\x03\x9f\xc9\xbe\x2e\x93\xc6\xe7\x81\x70\xc9\x25\x9a\x0b\xe0\x54\x9a\x57\x9b\x79\xb7\x83\x93\x9c\x6d\xe3\xe9\xb2\xe0\x8e\x8c\x8f\x99\x96\xbc\x35\x14\x04\xb9\xcf\x88\x27\xaf\x36\xa8\x0a\xd2\xbf\x8b\xf7\x4f\x64\xc0\x54\x04\x25\xbb\xa5\xa2\xe3\x0e\x64\xe9\xdb\xfa\xac\xab\x28\xc8\xb9\x3d\xbf\xcf\xee\xdb\x1b\x9e\xda\x9f\x15\x0b\x74\x6b\x62\xe4\x6c\x26\x2e\x20\x27\x61\x48\x6d\x84\x67\x67\x2d\x58\x3e\x6e\x48\x41\xb9\x54\xe8\xdd\x6c\x45\x41\x8a\xb3\x60\xdd\x02\x00\x8c\xd3\x60\x53\x1c\xeb\x5c\xb1\xc6\x80\x56\xb9\x2b\x25\x64\x41\xc9\xd5\x3f\x5a\x52\x0b\xb7\xe3\xfa\xc7\xf8\x49\x72\x9a\x9c\x26\x57\x3a\x9a\x8e\x53\x3f\x60\xe7\xe8\x87\x16\x3f\x57\x9b\xb5\xcf\xbd\x9a\xff\xb6\x12\xe7\xaf\x5a\x2a\xce\xd2\xb3\xe4\x3c\x39\x4f\x8b\xb3\xfb\x74\x85\xd5\x6f\x57\x3f\x5e\x31\xac\x5a\x14\xb3\xb4\x38\x8b\x0d\xab\xc9\xce\x4d\xf8\xfc\x2f\xa8\xbc\x56\x4c\x5f\xa7\x65\xab\xc9\xfd\xf7\x10\x27\x77\x68\xf9\x83\x94\xcc\x39\x6b\x32\x89\xaa\xd8\x78\xfb\x7f\x52\xf2\x4d\xff\xb6\x53\xff\x38\xed\xeb\xff\xb1\x2d\x8e\x3a\x93\x02\xe7\x90\x73\xd4\x7a\x12\x75\x59\x61\x23\xfb\x75\xaf\x6e\x29\xd9\xfa\x29\x02\x25\x39\x39\xe9\x6e\x4f\xe9\xaa\x38\x80\x71\xc1\x06\x65\xb6\xce\x47\x26\x48\x0d\xbd\xeb\xfd\x9d\x5a\x0b\x69\x4d\xc6\xa2\x6b\x8d\x91\xa2\xab\xf2\xfd\x4b\xb4\x31\xcc\xc8\xd9\x8c\x93\x4d\xba\x1c\x1b\x4d\x45\x04\x05\x1a\xec\x9a\x27\x51\xdf\xde\x37\xa3\x9a\xd9\xd3\xc9\x63\x3f\x3a\x02\x54\x0c\x63\xba\x6d\x50\x14\x54\x4c\xa2\x12\xb9\xa6\xae\xd5\xe2\x56\x92\x0f\xa6\xd6\xa0\xd9\x29\x6a\x50\xf4\x60\xb4\x8a\xa5\xe0\x8b\x68\x7a\xe9\xe1\xac\x28\x19\xa7\x56\xee\x33\x43\xed\x01\x25\x76\xea\xff\x5d\xa2\xe3\xd4\x53\xb9\xd6\x86\xbb\xce\x80\xbd\xb6\xa6\xe5\x3c\xb6\xe9\x3c\x9a\x8e\x59\x3d\x03\x56\x4c\x22\xbb\x53\x45\xdd\x2a\xec\xa2\xd2\x36\xfd\x7e\x53\x31\x43\xee\xc4\x35\x1d\xa7\x18\x4c\x79\x5a\xb0\xf9\x46\x04\xb0\x62\x20\x77\x15\x2d\x7e\xc2\xfa\x68\x1b\xde\x1d\x06\xb7\x7b\xac\xc7\x48\xcb\x83\x88\x80\xd5\x06\x1d\x4d\x0f\xd6\xe8\x59\x2e\x59\x09\xc9\x3b\x9c\xd3\x5b\xd4\x95\x5b\x1c\x77\x77\x6b\x12\x00\xe3\x47\x71\x0c\x97\x0a\x73\xd2\x50\x28\xd9\x14\xf2\x46\x40\x4d\xa2\x85\x38\x9e\x6e\xca\x72\xd6\x1b\xee\x45\xa3\x4d\x99\x90\xd8\xc7\xd1\xa6\x78\x17\xa4\x1b\x11\x3b\x28\xeb\x56\x57\x1f\xf6\x3b\x23\x75\x3a\x1e\x40\x94\x08\x25\xc6\xa8\x08\x63\x7b\x08\x37\xb0\xda\xde\xed\x4c\xb0\x69\xef\xd8\x5a\x9c\xe4\xa8\xc8\x0c\x41\xb2\x36\x61\xbb\x28\x1e\xa0\x5b\x52\x7a\x84\xee\x79\xc7\x38\xc7\xd1\x74\x20\xa0\xe8\x69\x8f\xc0\x5d\x1b\x4c\x22\x5d\xc9\x1b\xed\xb6\xe2\x55\xdf\x74\x98\x1d\x0b\x66\x9c\x72\x76\xbf\x66\xe3\x1c\xdb\x50\x8b\x9c\x83\xef\x38\x06\xba\xcd\x79\x6b\xcb\x0f\xc0\xd9\x4c\xd1\x0c\x0d\x15\x20\x05\xe9\x68\xfa\x9a\xf3\x8e\x98\xcf\xd8\x1b\xa7\x2d\xdf\x6a\xde\x96\x5d\x2e\x89\x6b\xda\x8e\xaa\x5d\x3a\x1f\x0a\x7e\x27\xb1\x5f\x3e\xeb\xdb\xf6\xb7\xa7\x7a\xa7\x43\xc2\xae\x92\x3d\x0b\xe9\x93\x41\xa3\xbf\xd0\x5d\x9b\x2a\x36\xbd\xb5\x6d\x4c\x1b\x96\x6b\xc0\x4c\xb6\xbe\x62\xd3\x46\x2a\x2a\xbe\x84\x05\x83\x79\x25\x6b\x32\xa4\xb6\x59\x70\x50\xff\x46\x12\x76\x38\x39\xb8\x58\x19\xd3\xe8\x8b\x34\x9d\xc9\x42\xe6\x89\x54\xb3\x54\xcb\x56\xe5\x34\x53\xd8\x54\xae\x3e\x09\xde\x53\x6c\x1a\x1b\xfd\x11\xf4\x7b\xd3\xef\x19\x47\x71\xbd\xc3\xe3\x4d\x7f\x33\x29\xaf\xb7\x3d\x7d\x2b\x73\x7d\x70\x8f\x9b\xdb\x4e\x3e\xc8\x1f\x66\xaa\x36\xfb\x1b\x1d\xf0\x0a\xb7\x5d\xf8\x9e\x99\x77\x6d\xf6\xa5\x4e\xac\x2f\x52\xbf\xdd\xd8\x84\x9e\xda\x53\xdb\x6a\x1f\x59\x65\xf2\x60\x47\x1a\xa7\xf6\x48\x77\x70\x30\xec\x4c\x7b\x6b\x97\xf0\x26\xf4\x07\x64\xc2\x5d\x84\x1e\x04\xea\xfc\x73\x57\xed\xf7\xee\xba\xb7\x87\x57\x47\x4d\xdf\xeb\x8e\x08\x75\x6b\xa8\x88\xa6\xaf\x3d\xcf\xc0\x34\xa0\x00\xd9\x90\x88\xfd\x34\x40\xa3\xe4\x15\xe5\x06\x72\x45\x2e\xb3\x65\x8b\xed\xc9\xdb\x0c\xc1\x68\xfa\x69\xd5\x62\xb9\x4d\xc6\x69\xb3\x93\x19\x0f\xbe\x77\x2c\xac\x52\x01\x46\x65\x2b\x72\x5b\xe6\x8c\x8e\x56\xe7\x1a\x48\x53\xf8\x6f\x2c\x28\x3c\x64\x31\xd1\x5f\xe9\xf2\x45\x32\x08\x7e\x35\x8a\xfa\x73\x51\x13\x1d\x25\x15\x2b\x68\x74\x94\x94\x58\xd0\x7b\x31\x3a\x5a\xdd\x99\xc1\x1c\x15\x54\x4c\x18\x0d\x13\xf8\x75\x68\x05\x38\xec\x49\x69\x94\x9c\xb3\x82\x34\x20\x7c\x2f\xe1\xdd\xe5\xe5\x47\xa8\x59\x51\x70\xba\x41\x45\xd6\xba\xc5\x32\xc6\xcd\x18\xfd\x2b\x2b\x36\xb5\xa3\x5c\x7a\x8a\xa6\x5b\x4d\x96\x51\x68\x30\xbf\xc6\x19\x3d\x3a\x3c\x0e\x21\xbf\x37\x90\xa3\x80\x8c\xa0\xd5\x54\x40\xa9\x64\x7d\x3f\xb2\xcf\xe0\xd9\x87\xef\x3f\x6b\xd4\x86\x54\x9a\x18\x45\x94\x36\x0b\x53\xd9\x02\xfd\x86\x99\x8a\x09\xf8\xe8\x5e\x01\x9b\x86\xb3\xdc\x15\xaa\x6e\xff\x03\x23\xe5\x23\x78\xed\xef\x66\x37\x70\xbf\x63\xc2\x5c\xc0\x1b\xce\xf2\x6b\xcf\xa6\x36\x4a\x8a\xd9\xf4\x8d\x6c\x16\x80\x1a\xfe\xe7\xd3\x87\x1f\xc7\x69\xd7\x08\x7d\xe9\x2e\x81\x6e\xed\x7d\x3f\xa0\x4f\xe5\xbd\x24\xa0\x28\x40\x57\x6e\x76\x0c\x58\x54\xb0\x90\xad\x82\x52\x31\x12\x85\xde\x69\xfb\x32\xb0\xfa\x0b\xa9\x4c\x6a\x82\xb7\x68\x10\x7e\x61\x74\xb3\x32\x6d\x30\x83\xd5\x36\xda\x1d\xf6\x6d\x8d\x05\xa8\xb5\xcc\x99\x5b\x23\xce\xa2\xed\xc8\x5b\xa5\x48\x18\xb0\x05\x50\x72\x9f\xd5\x8f\x4a\x96\x8c\xd3\x0e\x83\x9c\x8c\xb6\x1e\x80\x26\x1a\x7c\xbd\x6a\xb5\x01\xce\xae\x5d\x04\x22\x34\x7e\xb4\xfa\x0c\xb1\x76\x4e\xc4\xc2\x81\x81\x91\x83\x67\xaf\x1b\xa8\x00\x45\xb9\x41\x31\xe3\xa4\x8f\xc0\x48\x10\xa8\x94\xbc\xb1\x6a\xa5\x00\x66\x3c\x9b\x64\xb9\xd4\x60\xbf\xb6\xc4\xd6\xdf\x9d\x76\x3e\x88\xb5\xd9\xeb\x6b\x9f\xee\x15\x1a\x9c\x91\xf3\xc3\xc6\xa8\x26\x4e\xb9\x57\xde\xcd\x62\xdd\x72\xc3\x1a\x4e\xdd\xbe\xdc\xcf\xe6\x4e\x4b\xef\xeb\x6e\xe2\xad\x84\x1f\xe0\xa3\x1d\x85\x34\x15\x29\x18\x32\x9a\xd0\x06\x45\x4e\x36\x71\xe5\x96\x06\x5b\xa9\xf5\x00\x3b\x2d\x3b\xa3\x4b\xde\xef\xcb\xa3\xc3\x01\xd8\x6f\x41\x42\x49\x53\x10\x74\x6b\x2c\x50\x50\x64\x5a\x25\x7c\x21\x6a\x1b\x5d\xa6\xe9\x73\x46\xe5\xb2\x0e\x2a\x85\x0b\x30\x15\x1a\xa8\x50\x83\x90\x06\x32\x22\x11\xaa\x6b\x14\xcd\x99\x6c\x35\x5f\x40\xc1\x74\xc3\x71\x41\x45\xb2\x96\xc0\xec\x72\x7f\xd7\x27\xb1\xdf\x5e\xad\xf5\x71\xd4\x1e\xcc\x04\x44\xcb\xf9\xaa\xb3\x4f\xb0\x03\xdc\x11\x0b\x53\xad\x1f\x2d\x60\x02\x3f\xa0\xa9\x92\x92\x4b\xa9\x46\x23\xf7\xac\x50\x14\xb2\x1e\x1d\xc1\x37\x70\x4a\x2f\x8f\xe0\x6b\xef\x4b\xc2\x49\xcc\x4c\x15\x66\x57\x9f\xb1\x99\xd2\x06\x98\x21\x7f\x1b\xf5\xad\x2d\x99\x54\xb7\x32\x11\x04\xdd\x80\x57\x08\xa2\xad\x33\x52\x96\x1c\x91\x04\x2a\x58\x39\x62\x30\xf1\xf0\xe1\xcf\x3f\xc1\xbd\xf4\x6e\xad\x43\x86\x8e\xf2\x95\x4f\xe2\xe8\x55\xd0\x7f\xb7\x01\xed\x0d\xa7\x35\xfa\xfc\x6c\xdc\x54\xe4\x42\x9f\x69\x28\x5b\xce\x37\xb0\x0c\xd2\x9d\xbf\x30\x9d\xac\xfb\xbf\x81\x68\xdf\xe4\x6c\xa3\x79\x6b\x2b\xcc\x9a\x09\x02\x56\x0e\x21\x02\xcc\x05\x86\x0d\x0a\xa7\x0a\x90\x2b\xc2\x62\x11\xa2\xfa\x2a\x21\xcc\xab\x15\xb2\xe3\x61\x72\x47\xad\xb0\xad\xc7\x40\x9b\xb0\x58\x39\x22\x4b\x24\xdb\xec\x70\x50\x7e\x0e\x2c\x1d\x83\x51\x8b\x55\x0c\xaf\x4d\x56\xb2\x31\xf4\xf3\xf4\x0f\x77\x96\xfe\x79\x3d\x52\x82\x40\x65\xe1\xa0\x15\xdf\x4d\xab\xab\x11\x5b\xd3\xd8\xd9\x0b\x06\x04\xa4\x0e\x11\xde\x2d\x1b\x87\xe8\x68\xe8\x0e\xdd\xde\xa8\x17\x6c\xa1\xf0\xa1\x35\xbb\x8b\x10\x2f\x6f\x2a\xa6\x8f\x12\xfb\x25\x71\xe4\x66\xff\xd7\x95\xcf\x2d\xe7\x47\xbf\x85\xd5\xc6\xba\xcf\xdb\x5c\x68\x32\xef\xed\xc5\xee\x1c\xf9\x28\xc0\x7a\x6c\x2f\x9d\x4f\x4e\x86\x21\x77\x47\xbd\xb2\xf5\x3b\x37\x7f\xd5\x36\x4e\xfd\x47\xf8\xe1\x54\xb1\xfa\x6c\xef\x33\xdd\x77\x2e\xd1\x46\xae\xa8\x74\xf7\x11\xcc\x35\xc7\x7d\x02\x5e\xdd\x46\x0c\x57\x28\xcb\x65\xf2\xfe\x6d\x70\x5d\x33\x5c\x7a\x75\x25\x9c\xbb\xd5\xa0\x5b\xf3\x5a\x11\x76\x63\x5d\x89\xaa\xea\xee\x04\x6f\x1f\x77\x56\xa7\xb6\x23\xb6\x17\xdf\xcd\xd0\x6d\x4b\x60\x97\x14\x12\xf7\x87\x00\x6b\xc7\xbf\x31\xc7\x8c\x38\x94\x52\x59\x10\x75\x4d\xc2\x0c\xa0\xdc\x89\x2f\x9a\x2e\x97\x89\xfd\xc3\x01\x27\x18\xaa\xf4\x6c\x0c\x8a\x6c\xe1\x6b\x4f\xb5\xee\xdb\x47\x2e\xeb\x86\x93\xa1\x49\x24\xcb\x32\x5a\xc3\xd6\x5d\xd2\x41\x2f\x1f\x81\x92\x37\x7a\x12\x3d\x8d\xa6\x3d\xcc\x5f\x90\xb7\x74\x77\xe7\x0c\x77\x76\xc6\x69\x2f\xbf\xa7\xe2\x55\x75\xf7\x9c\xa9\x34\x20\xf1\xb5\x0f\xd4\x14\xde\xd8\xed\x8a\x77\xdb\x90\x5e\x71\x1a\x50\x97\x19\x7b\xc1\x23\x79\x78\x73\xd8\x43\xf2\x7a\x42\x7f\xbd\xa6\x60\x2c\xd8\xf1\x05\x95\xd8\x72\x13\xdc\x7d\x01\xba\xa1\xd1\xfa\x9d\x68\xcf\xea\xfa\xbd\xde\x3a\xa9\x0f\x37\x91\x3b\xe7\x36\x4c\x3c\xec\x5a\xb5\x8f\xc4\xa9\x27\x68\x1d\x51\xc8\x71\xe5\x79\xed\x9a\x7a\xa4\xff\x1c\x00\x10\x18\xc3\xb4\xc3\x22\x00\x00"),
+			uncompressedSize:  8899,
 		},
 		"/root.html": &_vfsgen_compressedFileInfo{
 			name:              "root.html",
@@ -55,25 +73,35 @@ var Data = func() http.FileSystem {
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x53\xbd\x6e\xdb\x30\x10\xde\xf5\x14\x07\x76\x0b\x40\x2b\xad\x91\x0e\x8e\x2c\xa0\x53\xa7\x6e\x41\xd7\x80\x16\x4f\xd2\xa1\x34\x49\x90\xe7\xfc\xd4\xf0\xbb\xf7\x48\xcb\x69\x8d\x64\xe8\x60\x58\x3a\x7d\x7f\xfc\x0e\x3c\x1e\x2d\x8e\xe4\x11\xd4\x03\xb1\x43\x75\x3a\x99\x18\xad\xc9\xf3\xf1\x88\xde\x9e\x4e\x4d\xf3\x17\xf1\xc3\x90\x57\x65\xd4\x65\x7e\x75\xd8\x37\xab\x7c\xd8\xc1\xb1\x01\xd8\x9b\x34\x91\xd7\x0e\x47\xde\xc0\x67\xdc\xdf\xcb\x6c\x0c\x9e\x75\xa6\xdf\x28\x93\xaf\xf1\xe5\xbe\x39\x35\xab\x31\x04\xc6\x54\x29\x8c\x2f\xac\x8d\xa3\xc9\x6f\x60\x40\x2f\xe3\x8a\x48\x68\xb5\x0b\x53\xa8\x98\x68\xac\x25\x3f\x69\x0e\x71\x03\x5f\x56\x77\x45\x78\xc1\xe4\x48\xde\x2f\x52\x1f\xc3\x3e\xed\x68\xd2\x3b\xf6\xff\x04\xdc\x80\x39\x70\x28\xe1\x9e\xc9\xf2\x2c\xe0\x25\xeb\x95\xc2\xdd\x3b\x05\xaa\x1a\x4e\x4a\xd0\x33\xd2\x34\xcb\x21\x6f\xef\xa1\xbd\x01\x1b\xc0\x07\x06\x1c\x47\x1c\x18\x78\x46\x38\x7f\x87\x30\xd6\xb7\xdd\x81\x39\x78\xb8\x69\xaf\xfb\x58\xdf\x96\x3e\xc4\x36\x64\x62\x0a\x92\x2b\xa1\x33\x4c\x4f\x58\xa6\x35\xc4\xfa\xdc\x58\xd7\x2e\x55\x37\x1d\xed\x27\x18\x9c\xc9\x79\xab\xde\x4a\x92\x99\x4e\x98\x63\xf0\x59\xc8\x4b\x8f\x7a\xe7\xc2\xf0\x4b\x41\x4e\xc3\x56\x09\x5f\x84\x87\xb6\xc0\x1f\x85\xb7\x8a\x7e\x52\x7d\xd7\x0a\xb3\x6f\xba\x79\x0d\x55\x7f\xab\x3e\x58\x87\xea\xbf\xc5\xe8\x68\x30\x25\x22\x70\x32\x83\x54\x04\xf9\x35\x33\xee\xe5\x34\x09\xbe\x87\x55\xd7\xce\xeb\xfe\x5d\xb6\xcb\x72\xfe\x3f\x9e\xb0\x1e\x17\xd6\x55\xc2\xa6\xb3\xf4\x04\x64\xb7\x6a\xd9\x85\xea\xa5\xa1\xce\xc0\x9c\x70\x14\x7a\x49\x85\x59\x5d\xcc\xcb\xb2\xe4\x77\xb6\xa8\x4f\x6e\xaa\x7f\xd6\xf8\x09\x53\x25\x0b\x9d\x2e\xf8\xd1\xc0\x68\xb4\x49\x68\xf4\x30\x9b\xc4\xf0\xe6\x22\xfe\x3d\xfc\x24\x7c\x86\x87\x6a\x51\x5c\x5b\x23\x47\x6d\x25\x50\x5f\x6e\xc5\xf9\x7a\xfc\x09\x00\x00\xff\xff\x24\x24\x3d\xdd\x40\x03\x00\x00"),
 			uncompressedSize:  832,
 		},
+		"/stats.html": &_vfsgen_compressedFileInfo{
+			name:              "stats.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x53\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x08\x01\x3d\xda\x6e\xb7\x5b\xa3\xea\xb2\xdb\xb0\x65\xc3\x92\xed\xce\x44\x4c\x2c\xc0\x96\x0c\x89\x49\x37\x08\xfa\xf7\x41\x8a\xed\x3a\x5b\x2f\x86\xf8\xde\x13\x29\x92\xcf\x31\x6a\x3a\x19\x4b\x20\xf6\x86\x7b\x12\x29\xed\x18\x39\x40\x0d\x38\x8e\x1a\x43\x17\x23\x59\x9d\x52\x55\xbd\x29\xbf\xa2\xb1\x22\x43\xb2\x7b\x52\x45\x2e\xdb\xee\x49\x65\x89\x39\x41\xb3\x75\x05\x4b\xa9\x92\xda\x5c\xe1\xd8\x63\x08\x2f\x02\x7b\xf2\x0c\xe5\x5b\x1b\x7b\x72\x02\xbc\xeb\x69\xc2\x85\xaa\x00\xf6\x1d\x01\x7b\x3c\x12\x04\x76\x9e\x40\x3b\x0a\x60\x1d\x83\xa7\xd1\x79\x86\xc0\xc8\x26\xb0\x39\x86\xa6\x92\xad\x36\x57\x55\x2d\x8f\x93\x8c\x87\x9e\xe6\x5a\xb7\xa0\x7c\xeb\xa3\xb3\x9a\x6c\x20\x5d\x6a\x48\xf6\x4a\x72\xa7\x76\x73\x2e\xd9\x72\x57\x90\x5f\xd8\x5f\x68\x89\xbe\x98\xc1\xf0\x1c\x41\xe0\x3f\xf9\xa9\xaf\x46\x73\xf7\x0c\x1f\x1f\x1f\x36\x42\xfd\x0c\x78\x9e\xf4\x2d\xfb\x9c\x3b\x46\x8f\xf6\x4c\xd0\xfc\x70\xaf\xb9\x7b\x80\x5b\xbd\x72\xc8\x47\xad\x62\x6c\xb6\x38\x50\x4a\xb2\x65\x7d\x4f\xe4\xc9\xed\x73\xf7\x29\x49\x84\xce\xd3\xe9\x45\xc4\x78\xf1\xfd\xde\x15\x78\x61\x45\xce\x32\x2b\x5b\x54\x30\x0d\x21\xc6\xa6\xf4\xf0\x5e\xf2\xa6\xf4\xf3\x3f\x33\x1d\x01\x6e\xf5\x27\xd5\x82\x02\xac\x37\x38\x7a\x77\xf6\x14\x82\x98\xe7\x31\xa0\x3f\x1b\x5b\x1f\x1c\xb3\x1b\x9e\xe1\x71\x23\xd4\xea\xea\xfb\x97\xeb\x03\x7a\x58\x07\x75\x8c\xcd\xa7\xac\x49\x69\xb6\xc4\x4c\x1f\xd0\x8b\x7f\x66\x1f\x63\xf3\x9d\xfc\x91\x2c\xa7\xf4\xb0\x81\xc1\xd8\x7a\x62\x3e\xd0\xb0\x11\xea\x8e\x9f\x4c\xb2\x7a\xd0\x3d\x30\xbb\x67\x26\xe7\x81\xbc\x2d\xf4\xc6\xcb\xb6\x58\x49\x55\x95\x1c\x17\x8b\xd1\x6f\xae\x87\x0b\x4f\xbe\xda\x77\x14\x68\xe5\x50\x40\x4f\x80\x7d\x70\x80\x57\x34\x7d\xbe\x0e\x18\x60\xd9\x2c\x8e\xa6\xcd\xea\x20\xd4\xe7\xdd\xb7\x6d\xde\x63\x36\xf5\x58\x7e\xa2\x5b\xd5\xbf\x03\x00\xa6\xc7\x70\x79\x9a\x03\x00\x00"),
+			uncompressedSize:  922,
+		},
 		"/trace.html": &_vfsgen_compressedFileInfo{
 			name:              "trace.html",
-			modTime:           mustUnmarshalTextTime("2016-04-28T10:40:52Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x3c\xfd\x77\xe3\x36\x72\xbf\xfb\xaf\x40\xb8\xe9\x89\x4a\x24\xda\xde\xcd\xb5\x3d\xd9\x56\x5f\x6e\x3f\x9a\xbd\x4b\xb2\x79\xf1\x66\xaf\xad\xb3\x2f\x0f\x12\x21\x89\x6b\x8a\xe4\x11\x90\x65\x65\x4f\xff\x7b\x67\x06\x1f\x04\x28\xca\x96\x7d\x49\xda\xd7\xeb\xbe\x44\x96\x40\x60\x30\x18\xcc\x37\x06\xfc\xf8\x31\x15\xb3\xac\x10\x2c\x7a\x9b\xa9\x5c\x44\xdb\xed\xc7\x8f\xd9\x8c\x25\x6f\x6b\x3e\x15\xc9\xeb\x17\xc9\x77\xbc\x16\x85\xda\x6e\x65\xc5\x0b\xf6\xf1\x63\xf3\xe0\x12\x1a\xb6\x5b\x36\x84\x46\x51\xa4\xf0\x4d\xe1\x93\xa0\x0b\x7d\xa1\x3e\xbc\xaa\x52\x2e\x17\xa6\xeb\xd1\x51\x33\xed\x37\x3c\x2b\x22\x6c\x3a\x97\xd3\x3a\xab\x14\x93\xf5\xf4\x22\x02\x28\x7f\xe4\x52\xfc\xf0\xfd\xd7\x30\xb3\xe2\x2a\x9b\x1e\x3f\xe7\x73\x91\x1e\xa7\xcf\x86\x2a\xab\x8e\xb3\x22\x15\xb7\xc9\x07\x19\x8d\xcf\x8f\xf5\xb8\xf1\xd1\x79\x9e\x15\xd7\xac\x16\xf9\x45\x24\xd5\x26\x17\x72\x21\x84\x8a\xd8\xa2\x16\xb3\xfb\x01\x8a\x5b\xbe\xac\x72\x31\xd4\x23\x93\xa9\x04\xd0\x88\x13\xfe\x1c\x1f\x31\xf6\x64\x5a\x56\x9b\xe1\x07\x59\x16\xa3\x45\x79\x23\x6a\xf6\x11\x1a\x19\x9b\xae\x6a\x59\xd6\x23\x56\x95\x59\xa1\x44\x7d\x06\x8d\xdb\x23\x40\x49\x0f\x3b\x3a\x5f\x9c\x8e\xdf\xee\x23\x0b\xf4\x25\x5a\x17\xa5\xea\xa0\x37\x81\x3f\x27\xaa\x13\xb4\x8b\x68\x56\x16\x6a\x28\xb3\x9f\xc5\x88\x9d\x3e\xad\x6e\xcf\x18\xa0\x01\xeb\xe0\xf9\x90\xe7\xd9\xbc\x18\xb1\x65\x96\xa6\xb9\x38\x8b\xc6\x34\x96\xb1\xd8\xfc\xd5\x50\xb2\xf4\x22\xa2\x45\x54\xa2\x5e\x72\xa4\xd5\x70\x9a\x67\x95\xeb\x0d\xfd\x78\x47\xa7\x88\xa5\x5c\x71\xea\x3a\x29\x79\x9d\x0e\x95\xb8\x55\x44\xcf\xef\x6c\x97\xed\xd6\xa3\xb2\xdf\x3a\x76\x3f\xce\x8f\xb9\x9d\x07\xa8\x03\xe8\xd8\x5f\x7f\xeb\xc6\x11\x09\x6d\xd0\xf3\xb1\xc2\xe6\xfd\x08\xfd\xe9\xf2\xcd\xb7\x86\xb6\xd1\xf8\xe5\x6d\x55\xd6\x8a\x71\xc9\xb0\x19\xe7\x0f\x27\xee\x1f\xb5\x91\xb1\xcc\x79\x7e\x0c\xbb\x06\x7b\xf7\xc9\x70\xc8\xde\x02\xec\x2f\x6b\xc1\x59\x5c\x00\x42\xaf\x72\xe0\xe1\x3e\x9b\xf1\x3c\x9f\xf0\xe9\x35\x9b\x95\x35\x7b\x0e\x78\x7d\xfe\x1d\x97\x4a\xb0\x72\x46\x73\x69\x41\x90\x6c\x38\x1c\x03\x9f\x2b\x01\x7c\xc5\xe1\x69\xf4\x7a\x89\x18\x69\xbc\x60\x11\xd9\x54\x41\xdb\x8b\x88\x79\x2b\xc6\xa5\x44\x56\x14\x59\xf4\x83\x14\x6c\xaa\xea\xfc\xf3\x29\x83\x99\xa6\xe5\x72\xc9\x8b\x14\x7e\xa8\x92\xe1\x18\xa6\x16\xc2\x9b\x91\x4d\x44\x5e\xae\x47\x30\xee\x1d\xcf\x57\x30\x3e\xae\x6a\xe0\xca\x19\x8b\xae\xfe\x49\xbe\x8f\x2c\x8f\x5d\x2a\x68\x9d\xf7\x7d\x91\x53\x9b\x0a\xf8\x0b\x27\x3f\xfe\xc0\x6f\xb8\x6e\x25\xc6\x88\x67\xab\x62\xaa\xb2\xb2\x88\xfb\x86\xe3\x6f\x38\x60\x92\x67\xc0\xa3\xec\x82\x15\x62\xcd\xfe\x4b\xd4\xe5\x73\xbb\x19\x31\x4b\xcb\xe9\x6a\x09\x4f\x93\xb9\x50\x2f\x73\x81\x5f\xff\xb8\x79\x9d\xc6\xde\x06\xf6\x59\xff\xec\x48\x8b\x0f\x01\x4a\x00\x7c\x04\x44\x4e\x37\xd1\x80\xb9\x09\x19\xb5\xbc\xbc\xc1\x99\xec\xe4\xc1\x08\x3e\x03\x89\x43\xa8\xc1\x28\xd1\x1a\xc0\x18\xcf\x41\x4e\xe2\x88\x08\xa5\x85\x11\x06\x65\x22\x25\x32\x5a\xc4\x93\xa8\x7f\x66\x46\x6c\xcd\xb7\xad\xc5\xf2\xf8\x98\xbd\x29\x18\x2f\x36\xe1\x5a\x99\xa8\x6b\xd8\x16\xa4\xf2\x92\xd7\x59\xbe\x61\xeb\x85\x28\x18\x31\x09\xcb\x24\xc9\x35\x50\x33\xcb\xf9\x24\x17\x7d\xb6\x16\x16\x98\xe3\x1f\x40\x60\x25\x61\x33\x68\x23\x41\x2b\x15\x29\x82\xc5\x7d\x00\x25\xc0\x93\x36\x89\x68\x3e\x7f\xb1\x62\x87\x2e\xa9\x90\xaa\x2e\x37\x71\xdf\x34\x7f\x1a\x47\x4f\x3c\xc2\x27\xd0\x6d\x7a\xbd\xbb\xa9\x3b\x5d\xb5\xec\xf5\x93\x45\x96\x8a\xd8\x51\xa6\xdd\x89\xd8\x15\x80\x96\x79\xce\x2b\x29\xe2\x48\x2e\xca\x75\x74\x67\x77\x96\xd8\xe5\xc1\xc0\x19\xf0\x8a\x8c\xfb\x89\x14\xb9\x98\xaa\xf8\xce\x1d\xf8\xb6\x6c\xe8\x86\xc4\x15\x22\x85\x2d\x44\x09\x44\xe2\x39\x75\xc5\xe2\x89\x98\xf2\x15\x08\x0e\x36\x53\x4b\xa6\x00\xfe\x0c\x07\x61\x93\x05\xd2\x4f\x1c\x3b\xbb\xc1\xcf\x1f\xcd\xd7\x8d\xba\x24\xe6\x46\xc8\x2d\xa8\x0f\x61\x72\x47\x36\x0f\x6c\x7b\xeb\x84\xbf\x77\x22\xa9\x6a\x62\xfc\x17\x62\xc6\x57\x79\x07\x29\xbb\xf1\x79\xa0\x08\x39\x75\xde\x29\x41\x3f\x16\x3f\x16\x6f\x81\xc2\x60\x63\x2d\xcd\xa7\x60\xb2\xc0\xbe\x6b\xca\x03\xd0\xac\x16\x5a\x57\x0d\x58\x59\x80\xc0\xc8\x05\x70\x02\x74\x66\xeb\x4c\x2d\xd8\xac\x06\xc4\x52\xf9\x49\xb7\x28\xe2\x27\xae\xab\x31\xf8\x47\xe7\x69\x76\x33\xa6\x4f\x32\x11\x4f\x08\xf4\xb0\xc3\xd4\x46\x80\x26\x97\x12\x74\x1c\xf5\x50\xd9\x52\xc0\x32\x04\x7a\x0f\x21\x08\xb2\xed\xdf\x0b\x49\xca\x8f\x5a\xcd\x40\xe0\xf1\xb2\x16\xe9\x8b\xec\xc6\x0d\x32\x1d\x70\x58\xc1\x97\xa2\xab\x1d\x30\x05\xd9\x10\xe9\x4f\x60\xb2\xbc\xd9\x82\x3f\x47\xcd\xec\x48\x2e\x90\x8e\x6f\x44\xb1\x72\x18\xa7\x75\x59\xa5\xe5\xba\x80\xdf\x82\xd7\xb3\xec\x56\xa3\xb6\xca\xdb\x1d\x86\x4b\x1a\x06\x13\x82\x2e\xd7\xdf\x41\x2b\xf1\x21\xa8\x1f\x81\x38\x4c\x36\x4d\x5f\x3d\x83\xf1\x2b\xd2\x4c\x82\x85\xda\x8c\x26\x79\x39\xbd\x3e\xab\x4a\x99\x21\x1b\x8c\xb4\x97\x74\x06\xaa\x6d\x9e\x15\xc3\x49\xa9\x54\xb9\x1c\xfd\x1e\xfc\x0e\xe3\x31\x80\xbb\x65\x26\x03\xde\x93\xb0\xb9\x1c\x87\x39\xbc\x91\x24\xcc\xe1\xb6\x00\x36\x17\x35\x52\x20\xcf\xc6\x47\x76\x3c\xda\x76\xc5\x27\xe4\xcc\x5d\x44\xc3\x53\x63\xda\x39\xf1\xe1\x05\x69\x93\xe1\x74\x91\xe5\x29\xb8\x44\xd6\xc5\x78\x62\x3a\xa9\x72\x3e\xc7\xc9\x55\x59\xe6\xe0\xc0\x99\x56\x58\xc8\x94\x64\xf3\x22\xaa\xb3\xf9\x02\x8c\xa9\x42\x5b\xaa\x61\x01\x1b\x03\xd5\x0c\x3c\x6d\x2d\x81\x31\x41\x2f\xa0\x0f\x10\x8d\x2f\xb1\xcb\x73\xf3\x58\x3b\x0c\x88\xec\x61\xb8\xa2\xa2\xfc\xa5\x70\x45\x58\xf7\xe0\xfa\x15\x76\x79\x2c\xae\xb3\x2c\x07\xb1\xff\x05\x08\x7a\xdc\x81\x29\x38\xd9\x29\x08\x37\xe3\xcc\x4c\x33\x7e\x45\x7f\x1b\x24\xf7\x63\x19\x22\x64\xd1\x9d\xe6\xa5\x04\xe1\x79\x8e\x7f\xfc\xa5\x9e\x1f\xaf\xf2\x3b\xa4\x48\x4f\xfb\x7f\x42\x96\x76\xc5\x88\xbc\x65\x4f\xd2\x22\xeb\xdd\x8e\x98\x25\x77\x48\xea\xac\xa8\x56\xbe\xa3\xe7\x60\xeb\x5d\x42\x43\xba\x1c\x22\xe5\x00\x8d\xc7\x31\x04\xc2\x86\x5d\xbf\x16\x9b\xd1\x0d\xfa\x9f\xac\xe2\x59\x0d\x5e\x53\xca\x70\x4d\x12\xd5\x3f\xcc\x03\x16\x03\x62\xc1\x5c\xfb\xae\x96\x11\x09\xe6\xa2\xcc\x61\x6d\x17\x3d\x07\x20\x49\x92\xde\x6f\xc0\x32\x86\x0e\x37\x99\x58\x7f\x53\xa6\x42\xb3\xc4\x64\x05\x5b\xa4\xe3\x91\x89\x2a\x2e\xc1\x63\xbf\x04\xa7\x45\xbd\x05\xc3\xe1\x28\x07\x0f\x18\xfc\x3f\x4c\xb5\xcd\x05\x05\x82\x01\xc7\x1f\x37\xe8\xc9\xc1\x17\x34\x32\xe7\xc7\x1a\xd0\x1e\x98\x2f\x8b\xf4\x30\x88\x60\x16\x0f\x81\xf7\x62\x55\x87\x8c\xb3\x17\x60\x6a\x7a\xde\x03\xf0\x6b\xe4\xf7\xfb\xa1\x91\x58\x34\xa0\x1a\xfa\x92\x54\xf8\xe1\x85\x8e\xab\x19\x4b\xf8\x2d\xe8\xb2\x8a\xab\xc5\xc0\xfd\x42\x8b\x6c\x7c\x0e\x60\x8c\x7c\x04\x0e\x74\x21\xb4\xfd\x47\xa7\xf6\x1a\xe2\xde\x09\x30\xca\xb5\x69\x5a\xf0\x4a\x0c\x41\xe5\x00\xd3\x80\x0b\x3d\x62\xe0\x14\xc8\xea\x65\x3a\x17\x52\x47\xe1\x16\x2c\x79\x9d\x06\x2c\x46\xd0\x33\xbe\x04\x5f\x7d\xc4\x24\x2f\xe4\x50\xc2\xe0\xd9\x59\xf3\xd0\x84\xd7\x27\x20\x9a\x16\x88\x75\x16\xb4\xf0\x3f\x14\xd2\xd3\x06\xd2\x13\x0b\xe9\xa9\xc1\x4c\x83\x02\x97\xa4\x90\x28\x7e\x23\xfd\x15\x83\xc5\x18\x10\x18\x3c\x83\x0f\xe3\xff\x0c\x97\x72\x78\x4f\x3f\x76\xfc\x19\x7b\xfd\x92\xfd\x81\x7d\x76\xac\x87\xac\xc5\xe4\x3a\x53\x87\x0c\xbb\xe4\x33\xd0\x6e\x24\xaa\xcf\x17\x75\x09\x76\xdb\xc2\x28\x0f\x19\xfe\x06\x3c\x4b\xee\x86\x2c\xcb\x9f\x0f\x19\xf4\x0a\x5c\xc1\x59\x79\xab\x87\x11\x75\xac\xeb\xc5\x92\xc6\xd7\x32\x24\x5a\x08\xd4\x34\xa3\xa7\xb8\x2d\xe0\x2a\xa6\x6a\x61\xbe\xcf\xf2\x92\xab\x51\x2e\x66\xea\x6c\x07\xcc\x13\xf2\x40\x34\x00\xab\x96\x59\x56\xd0\x56\x6a\xf5\x4c\x8f\x8c\x4e\x46\x18\x23\x76\x92\x3c\x13\x4b\x07\xca\x73\xc7\x06\xee\x57\x63\x56\x1e\xc9\x0a\xe0\x89\x5b\xb3\xc0\xf8\x44\x96\xf9\x4a\x19\x2e\x77\x58\x36\x8c\xff\xf3\x90\x74\x1d\xb2\xe4\x49\x17\x5e\x2c\x09\x4c\xd6\x18\xd4\x9c\x4e\xd4\x85\x00\xbd\xf5\x56\x3c\x4d\x49\x5e\x9e\x55\xb7\xec\xe9\x89\xc5\x89\x2c\x22\x74\x2c\xd5\xc2\xc3\x7c\xad\x09\xcf\xbe\xd0\xb3\x33\x92\xd1\xa1\xd9\x0e\x76\x9a\x7c\xf1\xf4\x5f\x7f\xff\x2f\xa7\x5f\x3c\x33\x30\x70\xdf\x46\xec\xc9\xb3\x67\xa6\x61\xbd\xc8\x94\x18\x02\x42\x53\x81\x8b\x5a\xd7\xbc\xda\xc9\x90\x3d\x32\x05\x81\xea\x1e\x02\x35\xf0\xf5\xdf\x65\xf2\x05\xfc\xd8\x6e\xcf\xdc\x43\xf4\x4d\xde\x1a\x61\x7b\xbe\x40\x65\x4c\x3d\x2f\xdb\xcd\xfe\x18\x62\x2b\xe8\x07\xb1\x57\x62\xc2\x16\x30\x4d\xfd\x84\xda\x63\x2f\x10\x15\x4b\x0c\x6b\x30\xf7\xa6\xc3\x1a\x6d\x59\xe3\xac\xc0\x27\xab\x02\x22\x9f\x3e\x5a\xb9\x2a\xbb\x15\xb9\xd4\x0d\x24\x5a\xb5\x50\xab\x1a\x42\xa1\x4c\xe9\xc8\xd3\x2e\x0b\x86\xc5\x62\xf9\x83\x1e\x68\x43\x2e\xc4\x08\x77\xe0\x12\x58\x07\x90\xaa\x78\x2d\xc5\x2b\x64\xf6\xf8\xd3\xb8\x37\x29\xd3\x4d\xaf\x8f\x39\xca\xb8\xe7\x18\xac\xd7\x77\x51\x93\x9e\xa9\x19\xff\x19\x33\xf0\x4d\x30\xe5\x96\x52\xac\x96\xaf\x40\xde\x5f\x7a\xd8\xe1\x8a\xa0\x79\x82\x2e\x01\x3c\x32\x81\x5b\x8a\xb9\x2d\x8a\xb3\x4b\x85\x61\x1c\xb8\x7c\x1b\x36\xe7\xf5\x84\xcf\x5d\x56\x43\x52\x5e\x69\x00\xb1\xe8\x3c\x61\x91\xd5\x75\xaf\x95\x58\xfe\x74\xfa\xc5\x17\xcf\x22\x36\x1c\x33\xfc\x12\x2e\xbe\x41\x21\x06\x00\x0d\x01\xcc\x1a\x68\xe1\xaf\x0b\x85\x0f\x93\x25\x57\xd3\x45\x7c\x1c\xff\x98\x7e\xde\xff\xf4\xb8\x7f\x75\xf2\x7e\x00\x72\xd1\x6f\xaf\xea\x35\x2c\x15\x30\xc4\x95\x4f\xca\x52\xc1\x48\x5e\x31\xe3\xc4\x48\x4d\x7b\x20\xe2\x55\xa7\x8f\xf3\x1e\xe8\x6a\xbe\xfb\x7b\x2e\x85\xb2\xce\x36\xb0\x5b\x36\x01\xa3\xb6\xe6\xf9\x35\x92\xab\x2e\x57\xf3\x05\xd1\x86\x58\x12\x77\x7a\x06\x32\x2b\x43\xb7\x18\xd8\x63\x9a\xaf\x50\xf0\x2c\x48\x90\x4d\xc5\x21\xcc\x06\x51\x87\x7d\x37\xe4\x9d\x67\x10\x7a\x93\x8b\x0f\xb1\x2b\x7b\xad\x50\x3b\xc1\x2c\x82\x4f\x17\xd8\x11\xb3\x99\x37\x66\xfe\x58\xd5\xe0\x63\x95\xb5\x97\x54\x92\xa2\xdf\x62\xad\x5d\xbc\x63\x0d\x7c\x60\xe1\x78\x49\x87\x04\xa7\x89\x71\x15\x5e\x32\x20\x83\x48\x1d\x30\xab\xfd\x6c\x40\x36\x8b\xa9\x2d\xa9\x28\x57\x7d\x49\x10\xd9\x27\x17\x06\x71\xbf\xab\xdd\xc8\x26\x25\xb4\x75\xdf\x34\x0c\xbb\x9e\x0b\x8b\x51\xd3\xb5\x03\x7b\x3d\xa6\xbd\x86\x9d\x74\x81\xdb\x38\x70\x04\x0b\xf1\x66\xf2\xe1\xdb\xf2\x05\x70\x82\xfe\x29\x3d\x52\x97\x93\x0f\x62\x0a\xd2\x8b\x9b\x05\x7b\x90\xa9\x9e\x44\x0f\x56\x4b\x2c\x79\xa1\xb2\x8f\x1b\x61\xe1\xf9\x62\x42\xc0\x06\x0c\xbc\x1e\x9d\xbe\x40\x18\x34\xd6\xa8\x0f\x4c\xec\xa5\x38\x6b\x9c\xf4\x61\x20\x39\xb9\x29\x75\xb5\xd0\x56\xe8\xbc\xc8\x29\x18\x3b\x99\xb0\xb7\x18\xdd\xc1\x7f\x2b\x29\x66\x10\x91\xd8\x34\xd6\x2b\xca\x65\xd5\x82\x2b\x83\x19\xcd\x45\x70\x81\x1b\xf8\x74\x0a\xbe\x75\x59\x4b\x0b\x32\x2b\x40\xf7\xc8\xd5\x64\xa8\x57\x26\x31\x71\xad\x40\x6d\x83\xcd\x22\xa1\x45\xc4\x01\x4c\x9b\x51\x42\x3a\xc5\x65\xa8\x89\x0a\x4d\x3d\x50\xa2\xdb\xb3\x90\x5b\x4a\x8f\x55\xae\x61\x43\xfc\xbd\xd7\xa3\xae\xae\x13\xb3\xf6\xf8\xf8\xc7\xe4\x78\x3e\xe8\xfd\xd4\xeb\xbf\xc7\xed\xde\xc9\x50\x19\x99\xd7\xe3\xda\x3b\xa9\x63\x05\xcb\x0f\xaf\x56\x3f\xff\xbc\x41\x52\x49\x43\xa0\x12\xf0\x80\x26\xb0\xbd\xbc\x9e\x2e\x76\xe5\x32\x76\xa2\x5c\x89\x69\x36\xc3\x63\x93\x7c\x33\xd0\xea\x0e\xfc\x04\xbd\xe1\x8a\xcf\x41\x16\xf1\x1b\x06\xb6\x2d\x11\x16\x3a\xe9\x87\x7b\xcf\x15\x6c\x80\x53\xa2\x25\x8a\x29\x68\xa6\x16\x49\x3b\x10\x76\xc2\xa7\x9f\x35\xc4\x02\x30\xb4\x8c\x05\x6e\x29\xec\xd6\x32\xd3\x11\x20\xf2\xe4\xb3\xa7\x80\x03\xaf\x21\xb4\x01\x9d\x6c\x96\x07\x8e\x33\xfc\x2a\x8c\xce\x95\x03\x26\x4b\xb6\x16\xec\xc3\x4a\xaa\x06\xa2\xcc\xb3\x29\x51\x06\x20\x80\xfa\x81\xc0\x1c\xba\x2d\x05\xea\x11\x8a\xc5\x24\x5b\x02\xe7\xb1\x18\xac\x34\x80\x5c\x97\xab\x3c\x65\x3e\xcf\x95\xac\xe6\x99\x14\x0d\x40\xf0\x2b\xc4\xed\x54\x54\x88\x99\x61\x20\x66\x96\x02\xfb\xa9\xbf\x24\x34\x6b\x7c\x32\x80\x59\xad\x02\xa5\xc1\xdf\x0b\x3c\x2b\x03\xa1\x03\x26\x04\xda\x4e\xd1\xa8\x10\xb3\x92\x72\xd3\xe7\x5c\x60\x9f\x51\x68\xcc\x06\xe0\x57\xa7\xf9\x6c\x5e\xa1\x01\x58\xae\x1c\x39\x40\x7e\x20\x1e\x91\x89\xc7\xb2\x76\x8a\x0b\xb0\x30\x79\x6e\x39\xac\x69\x75\x5c\xeb\xeb\xb0\x20\x1d\x7e\xb0\x3a\x24\x6c\x9e\x2f\x04\x26\xf4\x91\x35\x28\x99\x8f\xeb\x59\x8b\x1e\xd0\x37\x2f\xcb\x6b\x5a\x95\x42\xe9\xe6\x9a\xa1\x42\x85\xaf\x71\x08\x01\x22\x84\xc4\x6b\xda\xab\x74\xf7\x2d\xa0\x91\xa8\x33\xaf\x6d\x7b\x14\x4e\xf3\x9d\xa8\xd1\x51\xc7\x74\x0d\x89\x94\xa1\x28\xb0\x9e\xcb\x36\xc9\x1e\x29\x9e\x84\xfd\x05\x64\xa9\xd4\xed\xdc\x1c\x6f\xe4\xf9\x2e\xd6\x12\xd8\xf8\x06\xfc\xa2\x14\x3d\x05\x90\x33\xad\xb6\x50\x2d\x59\xd8\x03\xda\x62\xe2\xb2\x35\x47\x91\xb2\x42\x49\x5d\x43\x88\xfe\x38\x9f\x1e\xb8\xc9\xc8\x76\x6d\xcd\x45\x34\xaa\xf9\x1a\x7d\xc2\xfe\x59\x6b\xc0\x0c\xa7\xd4\xe9\x7d\x9c\x3d\xbe\xaa\xc1\x85\x08\x49\x86\x72\x72\x29\x0a\xf4\xd0\x6f\xc0\x6b\x25\xb3\x3a\x08\x7a\x00\x9b\x82\xa8\x60\xec\x8b\xe1\xcd\xaa\xf5\x14\x34\x8f\x90\x98\xcb\xa0\x68\x62\xd0\x2c\xe4\x4b\xe0\x84\x35\x66\x40\x6c\x07\x64\x07\x92\x40\x94\xe2\xa9\x1a\x00\xcf\xcf\x01\x79\x6c\xce\x41\xa9\x27\x01\x58\x24\xcc\x88\xbd\x21\xa5\x9e\xe0\x8f\xb8\xee\x0f\x10\x2c\xae\x13\x64\x4f\xe4\xa9\xdc\x4b\xab\xed\x0e\x21\x8c\xc4\x90\x20\x48\x91\xe8\x51\xb1\x51\x4b\x67\x2d\x1e\x79\x21\x2a\x10\x1a\xe4\x61\x60\x8b\xf5\x42\x20\x89\xf1\x40\x12\x39\x80\xd2\x38\xfb\x38\x87\x21\xf7\x81\xd8\xae\xaa\x10\x20\x1e\xa5\x19\x0c\x06\x8d\xb8\x64\x8d\x73\x03\xc0\x41\x01\x00\x03\xf9\xab\x68\xfb\x0b\x56\xea\x73\x51\xcc\xc1\x24\x8e\xd9\xc9\x2e\xe2\x9e\x9e\x21\xd9\xc4\x89\xc0\xc4\x5b\xa5\xee\x83\x37\xba\x21\x70\x31\x3c\xba\x35\x34\xdc\x86\xca\x24\x0e\xba\xee\x33\x58\xbf\x91\xbf\x48\x16\xd1\xa6\x5e\x91\x1f\xd0\x81\xd4\x5a\x54\xeb\x1d\xec\x6b\x41\xf2\x80\xe0\xb0\x9b\x89\x79\x72\xe4\x58\x56\xb3\xa6\xdd\x5b\xd8\x21\x5d\xb6\x91\xb2\xc9\x46\xe7\xfa\x20\xca\xc8\x91\xaf\x4d\x0b\x86\x80\x05\x2d\x8a\xb3\xbf\xae\x20\x58\x30\x5e\x54\x1b\x32\xfb\xb3\xd8\x8c\x22\x71\x0b\x72\xef\xfa\x44\xad\x3e\xaf\x00\x29\x53\x96\x31\x6a\x0f\xff\x16\x6c\xf6\x28\xfa\x5e\xfc\x15\x1c\x34\xd5\x1e\xf8\x7a\xd6\x90\x20\x2d\x85\x6c\x4c\x34\x11\x8d\x4f\xca\x1b\x2b\x74\xc6\x5f\x40\xde\x36\x36\x75\xb0\x67\xff\x64\x06\x7c\xa6\xc0\x78\xe1\x01\xa2\x64\xf6\xfc\x16\xc5\x67\xa8\x8d\x93\x2f\x06\x00\xf0\x4e\x77\xe0\x2e\x4f\xe0\x1d\xc4\x2e\x78\x5e\xe4\xa5\x48\x7d\xcb\x06\x21\x7d\x66\xb2\x10\x9e\xd5\xc5\xc6\x38\x1a\x35\x47\x67\x60\x2f\xbc\x9e\x56\x48\xc0\x5e\x3c\xf5\x8d\x04\x4c\xf7\x4d\x26\xe9\x0c\x5a\x6f\x1d\x1e\xa8\x06\x9b\x3e\x08\x8e\xab\x03\x51\x07\xfc\x3c\x09\x3a\xc0\xdf\x69\xa4\x29\x34\x4c\x5b\x6f\x79\xd7\xec\xc2\x5f\x22\x04\x79\x67\xde\xd3\x9b\xd6\xd3\xd3\xf7\xde\x7a\x6f\xa0\x33\xac\xf0\x82\xf5\xa2\x1e\xfb\xdb\xdf\xd8\xcd\xd5\x8d\x59\xf7\xf0\xd4\x3d\xd8\xb3\x7a\x9f\x59\xff\x67\x89\x00\x48\x61\x89\x46\x05\x46\x80\xa7\xd6\x1d\x82\x18\x36\xcb\x1d\x9e\x52\xc7\xe6\x84\xec\xc8\x52\x07\x5d\x6a\xe3\x7d\x9d\x82\x27\xee\x56\xde\xa8\xf3\xdf\x2c\xc2\xf3\x09\xac\x1d\xa3\x6c\xd6\xe8\x79\xed\xe4\xa2\xee\x70\x41\x16\xca\xf9\x14\x85\x8b\xa4\x94\x2c\x0d\x78\x77\x21\xef\x7b\x58\x19\xf3\x7e\x75\x0d\x71\xc4\x45\x18\x74\xec\x9a\x09\x34\xd1\x1e\x72\xe0\xc7\x4b\x71\xe7\x00\x32\xf9\x5d\x01\x6b\x4b\x84\xc3\x58\xb4\xb5\xbb\xbb\xa1\xe8\x5f\xb0\x38\x04\x89\x00\x1a\xa3\xd6\x67\x22\x26\x14\xa5\x63\x0a\x66\xb3\xef\xba\x93\xc9\xf1\xb1\x25\x25\x1f\xc1\xb7\xa7\xa3\xb6\x4c\xa1\x17\xe6\x4c\x82\x98\xe6\x78\x7e\x6e\x3d\x32\x0e\x3c\x09\x1b\x86\xaa\xa3\xc9\x00\x18\xc3\x47\xc8\x06\x50\x01\x98\x58\x82\x29\x6c\xec\xc1\x5f\x57\xd9\xf4\x1a\x36\x81\xa6\x6a\x23\x81\x13\xac\x05\xd8\xa8\x58\x0a\x53\x6a\xb4\x13\x44\xaa\x5b\xcc\x49\x7e\x49\xbf\x68\x51\x7e\x95\xc2\xfe\x1a\x05\x5d\xee\xd0\x1c\x7d\x87\x65\x27\x5b\x9b\xb1\x09\xf2\x9e\xfc\xaa\xe3\xc0\x07\xb3\x37\x58\xd6\x40\xa5\x12\xd1\xa0\x03\x21\x2f\xa7\x13\x3c\xc4\xd4\x20\x9d\xa9\x9a\x2a\x91\x0c\xad\xce\xd2\x1e\xc4\xb9\x32\x13\x9f\x20\xe0\x46\xe0\xa8\x23\xc7\xe7\xc6\x50\x20\x53\x07\xc7\xb3\x66\x67\xe5\x5d\xd4\xb2\xf3\xc7\xa2\x23\x33\xd3\x49\xd7\xb3\xc0\x24\x90\x7c\x5e\x74\x50\x12\xa9\x14\x47\xf8\xa9\x7d\x47\x68\xd2\xbd\xed\xf8\x03\x52\x44\xa6\xa7\x4d\xe9\x7d\x85\x19\xf6\x78\x87\xbf\x75\x11\xcb\x02\x96\x9f\x63\x6c\x89\x24\xd3\x7e\x87\xcf\x44\xb8\xce\x63\xa2\x8e\x26\x4a\x72\xc8\xe6\x86\x75\x00\xed\x4d\x0e\x2a\x62\xf6\x53\x15\xd5\x40\xdf\x89\xe5\x3d\x33\x86\xa7\xf9\x8f\x9c\x51\x67\xe4\x82\x22\xa6\x80\x46\x8e\xab\x8c\xab\x22\x57\x13\xa4\xd1\x41\x24\x31\x27\xa7\x77\x62\xd6\xd8\x13\xad\x60\x28\xd7\x51\x62\x05\x4f\xb0\x27\xc9\xdd\x5c\xd6\x40\x79\xa1\x4f\x13\xb4\x22\xf7\x71\x0d\x24\xd8\x3b\x1f\x49\xe8\x64\x1a\xa4\x59\x2d\xf3\xb8\xc5\x9a\xe1\xc3\x7e\x97\x2e\xf0\x0e\xf0\x75\xb2\xbb\x51\xda\xee\x60\x23\xa2\x93\x8d\xa8\x09\xc1\xf4\x39\xce\xae\x1c\xe0\xf8\x08\x1f\x46\xfd\xa6\xb3\x2a\xab\xbd\x7d\xe1\x59\xd4\xdf\x49\x51\x79\xdb\xe2\x2f\x54\x6f\x47\xaf\x5d\xc9\xe6\x6f\xfd\x57\x56\xa9\x9a\xdd\x36\x50\x86\x86\x92\xba\x76\xb0\xd3\x3c\x4c\x3d\xf3\xb0\xc3\x1c\x1e\x16\x07\xa9\xc4\x2e\x0e\x39\x48\x33\x07\xbb\x11\xe8\x67\x6f\x91\xa1\x8d\xc3\x33\x1d\x49\x39\x27\x45\x36\xdd\x84\x61\x8e\x04\xc4\x82\xfa\xf8\xc4\x95\x09\x08\x53\x28\xe0\xdc\x70\x30\x7d\xed\x82\x01\x2a\x22\xe8\x2a\x8f\x01\x02\xf3\x7a\x2e\x94\x97\x3c\xb9\x6f\xc3\xc0\x0d\x59\x55\x9d\x55\x75\xe0\x6d\x08\x7c\xfc\xbc\x84\x8d\x03\xd7\xe7\xf4\x99\xef\x67\x18\xa7\x47\x57\x26\x2a\x8d\x73\xb2\xeb\xc9\x7d\xd5\x65\x4a\x07\x6c\x5e\xf3\x49\x1b\x5f\x86\x2a\x57\x87\x83\x7a\x91\x38\xaa\x15\xa7\xfe\xbd\xca\x7e\x4f\x10\xf2\x69\x8c\x2e\x44\x3f\x01\x5f\x0c\x44\xf1\x01\x7b\xbf\xcf\x28\x58\x96\x68\x1b\xbb\x37\x15\xcc\x0a\xaa\x11\x50\x5c\x2d\x07\x98\x7d\x6f\x17\x3d\xde\x37\xdf\x01\x8b\xd6\x70\xf7\x0c\x08\xf5\x0e\xe1\x91\xd0\xc1\xfe\x1d\x33\x3c\x4c\xf7\x80\x34\xf1\xb9\xf8\x8f\x96\x96\xd1\xad\xff\xb9\xa3\x50\xec\x59\x5d\xe3\x73\x6e\x5b\xa4\x6b\x51\xd8\xd7\xeb\x24\x6e\xb5\x98\xac\x60\x3f\x6d\x19\xb1\xed\x4e\x42\x32\x9d\x96\x2b\x30\x38\x68\x68\xa6\x60\x75\xe6\x42\x92\x2f\xb9\x5c\x49\x68\xcc\x6a\xf8\x14\xcb\x4a\x6d\x1a\x88\x99\xc2\x32\x73\xf0\x7d\x94\xc8\x37\x9e\x76\x4f\x5a\x85\x93\xfd\x84\x06\xc6\x81\x81\xc0\x52\x78\xca\x41\x13\x22\x2e\xb5\x60\x0e\x22\x4c\xca\x82\xb2\x1e\x88\x50\xc5\x75\xe4\x45\x5a\x81\x6e\x61\x68\xd8\x3e\xaf\x1b\x18\x2f\xf4\x61\xef\xd5\xfb\xb3\x7b\x23\x19\x9f\xa3\x48\x86\x3f\x81\xa6\x64\xc7\xa5\xf2\x44\xb8\xc3\xd1\xf7\xa6\x4d\xaa\x95\x5c\xc4\x3e\x43\x6d\xfd\x10\xdb\xef\x69\x42\x6c\x88\x48\x4e\x3a\x34\x85\xd3\x0d\xde\xf2\xa8\x56\xe1\xad\x3e\x6e\x74\x99\x6a\xef\x39\x92\x04\x65\x94\xb6\xde\x4f\x5a\xe3\x19\x50\x56\x0c\xe8\x60\x40\x0d\x18\xd5\x08\xb4\xd6\xad\xbb\x84\x2b\xa6\xc4\x78\x76\x43\xba\xa3\xe7\x2a\x25\x7a\x3b\xd9\x41\x3a\xc8\xc7\xe4\x20\xc1\xd7\xf5\x18\x32\x0e\xba\x01\x98\x04\xf3\x56\x71\xcf\x2b\xd7\xb0\x87\xd2\x18\x28\xcf\x6b\x60\xbd\x74\x48\x0f\x7b\x03\x03\x32\xd6\x98\xee\x81\x44\x15\x1b\x78\x00\x0b\xa2\xe7\x53\xf6\x8a\x46\xbd\x4f\x66\x40\x9f\xaf\x03\x59\xed\x1e\xcf\x95\xaa\xc1\x80\xd3\x25\x8a\x01\xeb\x00\x64\x05\xde\x83\x02\x3b\xa0\x55\xc2\xc1\xf3\xe2\x08\xf4\x4c\x49\x77\x0e\x48\x6d\x04\x87\xde\xd1\xe7\x7a\xb1\x57\x27\xef\xfb\x77\xc6\x9f\x34\x75\xab\xce\x7e\xdb\x66\x97\xf0\x5c\x3b\x10\x74\xbd\x49\x1e\xdb\x4c\x4d\xc9\x43\xfa\xcc\x15\x2f\xb9\x0b\x01\xe1\x3f\x53\xdd\x40\x9f\x7b\x7a\x48\x05\x9b\xb9\x6f\xb8\x2e\x9e\x89\x3f\x92\xe6\x13\xcb\xf8\x9f\xfb\x03\x46\x55\x81\xa3\x93\x01\xe9\x3d\xf8\x63\xaa\x1d\x4f\xb6\x7b\x60\x10\x1b\x3a\x0b\xcc\xe2\x14\xf8\xd9\x58\x08\x74\xaf\x03\x19\xa0\x43\xef\x86\xed\xd1\xb9\xde\x83\x58\x09\x1e\x48\xb9\x52\x87\xc2\xd5\x69\xfe\x03\x00\x87\x55\xf8\x6d\xa8\x9d\x63\x18\x5b\x03\x27\x94\xeb\x04\xcc\x05\x85\x93\x09\x16\x2d\xe2\xfe\x90\xf5\x59\xd5\xf9\xd9\x9e\x71\xc7\xc7\xba\xf0\x1e\xaf\xae\x24\xfa\xac\x2f\x9b\x6d\x8c\xd5\x32\x49\x90\x01\xa9\x8d\x01\x7b\x1a\x4a\x55\xf3\x6f\x1b\xe8\x68\x9f\x89\xb4\xe2\x09\xf4\x4d\x65\xd9\xa6\x8a\x8d\x1c\xf5\xa8\xf8\x0f\x24\xb8\xa7\x6f\xca\xf5\x3c\xd3\x5f\x25\xe5\x6c\x06\x01\x64\x7c\x35\x3c\x85\x9d\x26\x46\xf7\x3d\x95\x9b\xb9\x06\x67\xbc\xe2\x0e\x2b\x02\xae\x1d\xa6\xd0\x23\xe8\x1a\x59\xc1\x25\x6e\x04\xc1\xdd\xcb\x95\x09\x11\xc0\x97\x54\xd0\x3b\xe0\x10\xc6\xb4\x7d\x9d\x23\xa8\xdc\x28\x8e\x70\xaf\x67\xe0\x2b\x02\xf4\xc8\x0c\x8f\x3a\xfb\x13\x38\x58\x60\xb8\xa0\xe6\xac\xd3\x2a\x62\x54\x55\x7d\x5f\xef\x32\x6a\xb2\xb6\xe0\x9c\x9d\x7e\x81\xcc\x66\xac\x3c\x3e\x3a\xf3\xec\x8c\xd7\x9c\x40\x1c\x00\x3b\x8c\x07\xa7\xe8\x68\x7e\xce\xa2\x24\x49\x22\x67\x35\xfc\x68\xff\x53\x52\x5f\xd2\xd4\x2a\x85\x24\xd5\xb0\xc2\x92\xc5\x28\x60\x80\x6f\xf8\xb5\xee\x85\x27\x35\x14\xa0\xbb\xb1\xe6\x84\x9b\x11\x8f\x0f\xf1\xd6\x52\x12\x18\xe6\x0f\x92\xd2\xe9\x45\xcf\x3f\x64\x16\x62\x89\xae\x06\x1d\xf9\x71\xb6\xc6\xf8\x10\x0b\x10\x2a\xba\x7d\x47\x99\x29\xc1\x65\xd6\x38\x13\x26\x4d\xaf\xfd\x97\xe6\x40\x71\x82\x09\x55\xe4\x12\xe7\xc7\x20\x8a\x06\xa3\x01\x1d\x91\xd8\x27\x18\xac\x38\x5c\x41\xfb\x7a\x27\xd4\x97\xef\xfe\x1d\x88\x3a\x55\x7d\xed\x49\x63\x6e\x96\x4a\x88\xec\x50\xf0\x9b\xcd\x71\x37\x9e\xca\x62\xc9\x29\x56\x95\xb6\x8a\x95\xa2\x7e\x17\xae\x78\xb3\x05\x24\x41\xd9\xea\x28\x72\x67\xf4\x99\xae\xae\x02\x03\x5d\xaf\x7d\x19\x4c\x5d\xfa\x7e\xc1\x5e\x2f\x8a\xcd\xc7\xe6\x06\x15\x79\x33\x9d\xb7\xb2\x70\xc3\x35\xec\x0b\xbf\x56\xca\x7a\xec\x48\x0b\x27\xa9\x59\xda\xf3\x95\x00\x0e\xd5\x35\xab\x17\x96\x67\x8c\x45\xf3\x2c\x5f\x23\x9d\x04\xd0\x93\x05\x0a\x1b\xb5\x1e\x05\xa9\xf1\x43\xc7\xb6\xa2\xbb\x4b\x45\x93\x09\x0c\x0e\xa0\xf7\xcc\xb1\x52\xad\x29\xee\xd6\xd0\x1a\x6e\x07\xb4\x9d\x40\xb7\x8d\xed\x1e\x65\xdc\x61\xf7\x5b\x9a\x79\xdb\xef\xa4\x9b\x76\x26\x0e\x25\xdc\x01\xc4\xfa\x55\x49\x44\xbe\x95\xd6\x63\x1a\xf3\x24\x2b\x0a\x51\x7f\xf5\xf6\x9b\xaf\x21\xf2\x73\x3d\xbd\x58\xbe\x16\xa6\x6e\x41\xc7\x44\x94\xac\x88\xa9\xc8\x8f\x2c\xbd\xd6\x16\x7d\x73\x69\x0c\x82\x8b\xb2\xd2\xe3\x7c\x58\x41\x0e\xb0\x2c\x9c\xff\x42\x71\x2f\x0a\x2c\xc4\x26\xb9\x48\x02\xd6\x25\x25\x1f\xd8\x8f\x90\xe9\xd1\xaf\xd2\xc1\x5f\xdf\x3b\x24\x42\x39\xbb\xd2\x1e\x19\x2d\xef\xbd\x49\x7f\x34\xc8\xef\x24\xf0\x8c\x16\xee\x0e\x51\x77\xd9\xa2\x1f\x1c\xa7\xb7\x04\xf1\x57\x9c\xab\x75\xa2\x00\x2e\x3e\x85\x52\x9f\xe8\xb8\x81\xfd\xee\x77\xbb\x65\xaf\x0d\xeb\xdf\x93\xbb\x95\x9c\x4e\x44\xe9\xe4\xa0\xac\xb5\x9e\x93\xa0\xc0\x8f\xec\x66\x60\x0b\x55\xfb\x5f\x38\x90\xe8\x6c\x8f\x58\xaf\x67\x03\x5d\x73\x1c\x0e\xaa\xf6\x4d\x9d\x8a\xba\x55\x3a\xa1\x0b\x2d\xed\x13\x4b\x13\x84\xd1\x36\x9f\x40\x4e\x8a\xd1\xe9\xc0\x8e\x3a\x84\xde\xb2\x7b\xae\x9f\x9e\xb5\x9f\xb5\xf0\xd8\x3d\xd0\x71\x76\xf7\xb4\xf3\xcc\x6a\x0f\x90\x4f\xba\xda\xcf\x76\x51\x6f\xf5\xe8\x0a\x39\xd9\xf0\xf4\xce\x80\xa0\x0b\x3d\xff\xef\xd6\x2b\x4c\xc5\x3d\x99\x98\x2b\x27\x30\xe7\x4f\xb8\xd1\xad\xfc\x01\x51\x3e\xb8\xc2\x12\x87\xe5\x7d\x08\xc4\x2e\xd3\x6e\x74\xe2\x6d\x58\xdc\x23\xf0\x04\xbb\x71\xff\x90\xfb\x12\x1c\xda\x18\x2e\x70\x46\x27\xad\xf3\xd5\x1b\x7d\x98\x9d\xb9\xca\x2d\x43\xaa\x4d\x25\xc0\x82\x72\x72\x55\xa4\x3e\x9b\xd5\x89\x02\x3a\xb9\x35\x8f\x27\x1d\x8f\xfb\x5d\x44\x44\x90\x06\x56\x13\x86\x43\x1c\x8e\xb0\x26\x1d\xed\x01\x10\x1f\x5d\xc7\xf4\x2d\xa8\xa0\x61\x92\x80\xc6\xe0\xda\x4d\xf6\x3c\xea\xdc\xf2\x86\xc6\x9f\x75\x6d\xff\x9d\x53\x8d\x1f\x39\xd5\x21\x4c\x76\xd2\xc1\x64\x07\x1e\xf8\x58\xde\xd3\xdc\x7e\x27\xe7\x99\x8b\x4e\x0f\xe6\x3b\x00\xfd\x8f\xce\x75\x1e\x75\x43\x9e\xf3\x1e\xfc\x02\x1c\xe7\x4f\x33\x7e\xd4\x34\xbf\x11\xb7\xd9\x9b\x6b\xfb\x58\xcd\xde\x81\x7b\x30\xaf\x59\xc0\xff\xc0\xbc\x66\x49\x10\x32\x9a\x6d\xfd\x05\xb8\xcc\x4d\x30\x7e\xf8\x04\xbf\x11\x7f\xe9\x88\x89\xe7\xd5\x02\xbe\x28\x5d\x27\xee\xdc\xa0\x86\xcd\xbe\x36\x81\x55\xe3\x8e\x3f\x8c\xdb\x68\x9a\x5f\x9a\xd5\x34\xee\xc4\x4b\x3a\x5b\x14\xb2\xda\xee\xe3\x87\x70\x89\x8e\x16\x54\xf9\x35\x56\xb1\x3e\xe7\x12\xb5\xf9\xb9\x85\x1a\xb6\x3f\x9e\x53\xba\x26\x19\x3f\x66\x92\x5f\x9b\x5b\x84\x76\x90\x31\xec\x51\x98\xf1\x30\x35\x1e\xf4\x1c\x53\xc1\x3b\xb7\x86\xed\x0b\x3c\x3a\xdc\x31\x57\x93\xe0\x86\xd9\x8b\xc1\xbb\x83\xcc\x93\xdd\x21\xee\xee\xef\xee\x18\xfb\x68\x77\x90\xbe\xdf\xbb\x3b\xa2\xc9\x76\xef\xbc\x73\xc3\xbc\x17\x89\xde\xa3\xf3\x16\x73\x44\xf4\x9e\xa3\x3b\x6e\xfa\xda\x8b\xd5\x66\x07\xcc\xfd\xc3\x21\x9d\x8a\x9d\xea\xdb\x96\x4d\xab\x49\x16\xdb\x07\x74\xdd\xb1\xaa\x4b\x08\x58\xc5\x3b\x80\x33\x60\x4f\x60\x3b\x26\xa5\xa4\x20\x09\x5b\xda\x37\x1d\xed\xd5\x49\xba\xba\x3b\xcb\x6e\x45\x3a\x54\x88\xe5\xd0\xdd\xe9\x33\x23\x26\xa5\x8e\x45\x82\x01\xd4\x15\x22\x00\xff\x6a\xa7\xbd\x03\xa9\x4b\x27\xda\x5d\x53\xc7\x58\x6b\x00\x38\x9c\xd4\x82\x5f\x8f\x18\xfd\x19\xf2\xdc\x74\xf7\xae\x3b\x22\xf1\xfe\xb4\x92\x2a\x9b\xe1\xfb\x53\x6a\x9e\x66\xe5\xd0\xf0\x8e\x2e\x3b\x5c\x67\xa6\x02\x0e\xb4\xcf\x5a\x40\x28\xed\xca\x84\x0d\x1d\x18\x12\x54\xbf\x5d\xaa\xeb\xfe\x3a\xdd\xd0\xc6\xc3\x97\xaa\xf9\x36\xfc\xe0\x66\x6c\xda\x6e\x65\xeb\x9e\xbf\x41\x23\xa2\x0b\xe0\x84\x59\x69\xae\x60\x9e\x6b\xcd\xd1\xba\x06\xae\xdf\x7b\xb4\x61\x58\x70\x70\x23\xec\x9b\x0c\xfc\x17\x0d\x10\x90\x88\xc2\x34\x8d\x60\x64\x2f\x97\xdb\xed\x8b\x74\xfd\xdf\x45\x44\x47\x7c\xd4\x32\x76\x5f\xcf\x8f\x09\x98\xbe\xb8\x4f\x28\xdc\x8b\xcc\xc3\xb0\x78\x17\xf2\x92\x43\xc6\xb4\x33\x0f\xa9\x9d\xa6\x5f\x1d\xb9\xef\x1a\xb6\x77\x88\x99\x36\x83\x93\xff\xab\x0b\x1d\x7b\x0f\x1f\x99\xee\x88\xfd\x89\xdf\xf0\x4b\x7d\xd9\x76\x8a\x7c\x82\x49\x5b\x7a\x7d\x07\xb0\x16\xbd\x1d\xc5\x9d\xce\x1e\xb7\x58\x2d\x0d\xeb\xff\xb3\xe9\xe2\x48\x73\xae\xab\x59\x94\x26\x79\x2b\xd2\x23\xad\x0d\xee\xbb\xd4\x8b\xc9\x50\xc7\xb1\x84\xf9\x48\x53\x02\x74\x11\x1d\x54\xc7\xdd\x86\x35\x4b\x29\xed\xad\x73\x2e\xfa\xb8\x20\x4b\x83\xa2\x67\xec\x71\xc1\x02\x1e\x6b\xbf\xe5\x2a\x75\x0f\xf4\x01\x5e\x93\x62\x69\x9b\x8a\x56\xef\xf0\x94\xce\xe5\x0c\x82\x59\xdb\x3c\xd5\x9e\xfc\xa6\xfd\xfc\x10\x1c\x76\x07\x1d\x82\x8a\xcf\x41\x6d\x34\x2a\xff\xd9\x21\x28\x84\x03\xda\xd3\xeb\xf4\x94\xff\x6a\x26\xb2\x12\xba\x92\x12\x0c\x0a\xde\x5c\x20\xde\xc2\x4d\x07\x97\x68\x53\x82\x04\x10\xab\xac\x72\x62\x78\x47\xe5\xe0\x4d\x4d\xe6\x3d\x4c\x79\x16\xb4\x6a\x11\xc1\xdc\x61\xf3\xae\x27\x2c\x51\x6e\xde\x4a\x19\xd9\x37\xfa\x35\xaf\xb2\xc4\x1b\x03\xee\xad\x8a\xaa\x2e\xf1\x44\x40\xbf\xb8\xc4\x7b\x5f\x14\x8e\x74\x3f\xf5\x08\xd4\xdd\xd8\xdb\xbd\x23\x10\x48\xf3\x30\x38\x0e\xab\x1d\x50\xfa\x5d\x98\x6d\x4c\x69\x29\x5f\x16\x45\xa9\x8b\x4f\xa5\x9d\x4d\x5b\x1c\x4b\x08\x6d\x7e\xac\x69\x4b\x45\x81\x57\x10\xf4\x6f\x74\xee\x2a\x91\xba\x37\x3b\x7e\xfc\x58\xa3\x48\x31\x93\xf7\xf5\x40\xef\x9b\xb2\xbf\x6d\x0e\xa9\x34\x6a\xaf\xed\x36\x7a\x4f\x10\xa7\x7a\x7c\xae\x16\xb8\xd6\x3f\x8b\x0d\xae\x10\x7e\x9c\xab\x14\x1a\x00\x09\x96\xd0\x8b\x08\xa9\x39\x1d\xc3\x47\x3d\xf6\xa0\xea\xd5\xef\xfe\x82\x7e\xb8\x8a\x90\x48\xa4\xd5\x32\x54\x69\xf8\x02\x97\x86\xbb\x8c\x60\xdc\xcd\x5b\x6d\xe9\xf9\x7f\x16\xfb\x5f\xc6\x62\x8f\x65\xa3\x47\xb3\x8d\x6f\xdf\x02\x8e\xb1\xef\x90\xf2\xb5\x1d\xf1\x87\x26\x46\xf8\xce\x00\x6c\x32\x3e\xd4\xaa\xce\xf5\xdb\x4f\xf5\x38\x7a\xcd\x6c\x74\x27\x21\xcd\x40\xfd\x2e\x8d\x8b\xe8\xe9\x1f\xfe\x60\xdd\x03\x85\x6f\x79\x72\x6f\x4b\xf5\x85\xe6\x1c\x6f\x7e\xe3\x28\x0c\x3d\x10\x1c\x72\xeb\xca\xe2\x40\x97\x00\x2f\xa2\x6f\xe9\x75\x50\xf8\x49\x64\x7c\xd8\x60\x8a\x3a\xc6\xf8\xc9\xe2\xa5\xec\x3f\x12\x82\x2d\x30\x34\x90\x3e\x6f\x4a\xe1\xff\x1e\xa0\xab\x65\x34\x86\x8f\x55\xce\xd1\xdf\x64\x9d\x48\x36\xdc\x81\xad\x8e\x8e\xe7\x0a\xdf\x99\xe1\x3a\x21\x1b\xbc\xd4\x37\xcb\xb4\xe6\x30\x77\xee\xa9\x2c\xad\x16\xc8\x15\xee\x18\x9a\xf6\xee\x87\xd7\xdd\xdb\x91\x8e\x8f\xd5\xb2\xfa\xb7\x59\x59\x5e\x20\xd2\xc4\xa0\xc1\xe3\xd3\x93\xdf\x9f\xec\xb6\x3e\x3b\x39\xe9\x68\x7d\xda\x6e\xf6\x59\x1d\xb9\xd3\xb4\xd9\xa5\x38\x8e\x0f\xfd\x3c\x3a\xb7\xa3\x80\xce\x78\x6c\xdc\xb2\xfb\x90\xd8\x5d\xaf\xa8\x2e\xd7\x54\x0a\x88\xf7\x6f\xb1\xea\x0f\x6f\x7b\x8b\x34\xc3\xb3\x38\xa0\x06\xd3\x85\xba\x47\x38\xb2\xd2\xa5\xe9\x43\x3a\xab\xc3\x22\xc6\xe4\x70\x1f\xcf\xf7\x1a\x4c\xc8\x14\xd1\x21\x59\x4f\xd7\x18\x00\x16\xc9\x44\xea\x07\xbd\xe6\xac\x8c\xe1\xa1\x18\x61\xf8\xa9\x39\xe7\xb7\xee\x0b\x15\xb6\x85\xe7\xb7\xf8\x12\x29\x73\x5e\x84\x63\x12\x90\xbd\xc6\xd9\xd9\x73\xd8\x6b\xfa\xd9\xa8\x7e\xc7\x7b\xb1\xfa\xe3\xbf\x03\x00\x00\xff\xff\x00\x13\x40\x2c\x1e\x5b\x00\x00"),
-			uncompressedSize:  23326,
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7c\x6b\x77\x1b\x39\xae\xe0\x77\xff\x0a\x74\x25\x3b\x2e\x4d\xa4\x92\x9d\xf4\xec\xee\xc8\x96\xee\xe9\x49\xba\xb7\x33\xb7\x5f\xa7\x93\xee\xbb\xbb\x9e\x9c\x39\x54\x15\x24\x31\x2e\x15\x6b\x48\x96\x64\xb5\x47\xff\x7d\x0f\x40\xb2\x5e\x92\x1c\x27\xd3\xd3\xbb\x67\xe7\xfa\x83\x2c\xf1\x01\x82\x20\x00\x82\x00\xc8\xfb\xfb\x0c\x17\xb2\x40\x88\xde\x4a\x9b\x63\xb4\xdf\xdf\xdf\xcb\x05\x24\x6f\xb5\x48\x31\x79\xfd\x2a\xf9\x41\x68\x2c\xec\x7e\x6f\x4a\x51\xc0\xfd\x7d\x53\xf1\xa6\x14\xc5\x7e\x0f\x23\xb8\xbf\xc7\x22\xdb\xef\xc1\x52\x4d\xa7\x09\x7f\xe1\x36\xa2\x2c\x33\x61\x56\xbe\xe9\xd9\x59\x33\xec\xb7\x42\x16\x11\x15\x5d\x9b\x54\xcb\xd2\x82\xd1\xe9\x34\xba\xbf\x4f\xfe\x24\x0c\xfe\xf4\xe3\x37\xfb\xbd\xb1\xc2\xca\x74\xfc\x52\x2c\x31\x1b\x67\x2f\x46\x56\x96\x63\x59\x64\x78\x97\xbc\x37\xd1\xec\x7a\xec\xfa\xcd\xce\xae\x73\x59\xdc\x82\xc6\x7c\x1a\x19\xbb\xcb\xd1\xac\x10\x6d\x04\x2b\x8d\x8b\x0f\x03\xc4\x3b\xb1\x2e\x73\x1c\xb9\x9e\x49\x6a\x4c\x34\x23\x9c\xe8\xe7\xec\x0c\xe0\x49\xaa\xca\xdd\xe8\xbd\x51\xc5\x64\xa5\x36\xa8\xe1\xfe\x0c\x00\x20\xad\xb4\x51\x7a\x02\xa5\x92\x85\x45\x7d\x75\x06\xb0\x3f\xbb\x1e\xfb\x6e\x67\xd7\xab\xcb\xd9\xdb\x53\x64\x39\x03\x60\x5a\x17\xca\x1e\xa1\x37\x83\xbf\x66\xaa\x33\xb4\x69\xb4\x50\x85\x1d\x19\xf9\x0b\x4e\xe0\xf2\x79\x79\x77\x05\x1b\xd4\x56\xa6\x22\x1f\x89\x5c\x2e\x8b\x09\xac\x65\x96\xe5\x78\x15\xcd\xb8\x2f\x40\xec\xff\x3b\x28\x32\x9b\x46\x3c\x89\x12\xf5\x5a\x10\xad\x46\x69\x2e\xcb\xba\x35\xc0\xb5\x38\xd2\x28\x82\x4c\x58\xc1\x4d\xe7\x4a\xe8\x6c\x64\xf1\xce\x32\x3d\x7f\x08\x4d\xf6\xfb\x16\x95\xdb\xa5\xb3\xfa\xc7\xf5\x58\x84\x71\xae\xc7\x84\x4e\xf8\xf5\xf7\xe3\x38\x12\xa1\x3d\x7a\xd7\xa2\x5b\x7c\x1a\xa1\x3f\xbf\xf9\xfe\x3b\x4f\xdb\x68\xf6\xe5\x5d\xa9\xb4\x05\x61\x80\x8a\x69\xfc\x13\x03\x8b\x06\xf7\x97\x2b\xad\xd6\xc8\x20\x98\x51\x22\xc8\xd4\xb6\xc8\x95\xc8\xa6\x11\x73\xf7\xe8\xc8\x32\x26\x0e\x29\x4b\xe2\x33\x8d\xbe\x2f\xb1\x00\x59\x40\xca\xa0\x26\xe3\x31\xf5\x93\xc5\x12\x94\x86\x4a\x26\x25\xea\x05\x5a\xab\x92\x0c\x37\xd1\xcc\x8d\xe7\x04\xa7\x45\xa1\xf8\x21\x9c\xfe\x8d\x5a\xdf\x9a\xa9\x41\xbd\x91\x29\x7e\x04\x8a\xb3\xf9\x0e\x7c\x2f\x1a\x6c\xe0\x47\x73\xff\xdb\xc4\x09\x52\x7a\x3d\x5e\x5d\xce\x48\x56\xb7\xd2\xae\x20\xf9\x56\x1a\x23\x8b\x25\x55\x64\x72\x03\x69\x2e\x8c\x99\x46\x22\x47\x22\x33\x7d\x8e\xb6\x42\x17\xb2\x58\x46\xa0\x15\xd1\x82\x0b\x99\xbf\xde\xae\xa4\x71\xd3\x04\x69\x40\x16\xa9\x22\x61\xb3\x38\x01\x69\xa9\x64\xed\x40\xc3\xfd\x7d\x8e\x05\x24\xfb\x3d\x10\x36\xb1\x19\x40\x7c\x7f\xaf\x45\xb1\x44\x78\x2a\x87\xf0\x54\x66\x30\x99\x52\x3d\x0b\xce\x53\xb9\xdf\x0f\x03\xb6\xf7\xf7\x4f\x25\xff\xe3\x5f\x83\x21\x18\x05\x86\xa8\x4b\x90\x0c\x08\x8d\x60\x56\x6a\x5b\x40\x55\x64\xa8\xc1\xae\x10\xb4\x52\x96\xab\x41\x0b\xbb\xe2\x42\x51\x50\x8d\xd4\x20\x52\x5b\x89\x1c\x4a\x96\xc6\xe4\xac\xc5\x27\x0d\xf6\xd1\xec\x67\x89\x5b\x10\x79\xde\x9a\x92\x9b\xa6\xe1\xe5\xbc\x1e\x67\x72\x33\x3b\xab\xb5\xde\xf5\x67\xa3\x11\xbc\xc5\x3b\xfb\x85\x46\x01\x71\xa1\x8a\xd1\x57\xb9\x30\xab\x01\x2c\x44\x9e\xcf\x45\x7a\x0b\x0b\xa5\xe1\xa5\x2a\x77\xcf\x7e\x10\xc6\x22\xa8\x05\xb3\xaf\x07\x0a\xa3\x11\x41\xb3\xb8\x2e\x73\x61\x11\xa2\xd7\x6b\x62\x72\xc7\xea\x11\x64\x32\xb5\x10\xbd\x7e\x15\x41\x4b\x88\x48\x3a\xa2\xa0\xdd\x21\xfa\xc9\x20\xa4\x56\xe7\xcf\x52\x50\x1a\x52\xb5\x5e\x8b\x22\x7b\x96\x82\x55\x40\x7d\x98\x2e\xcd\x88\x30\xc7\x5c\x6d\x27\x11\x44\x3f\x8b\xbc\xc2\x08\xe2\x52\xcb\xc2\x2e\x20\xba\xf9\x2f\xe6\x5d\x14\xd4\xd6\x1b\xab\x65\xb1\x1c\xb4\xb5\xb8\xdd\x95\x38\x8d\x68\xf0\xf1\x7b\xb1\x11\xae\x94\x79\x21\x5e\x54\x45\x6a\xa5\x2a\xe2\x81\x57\xa2\x1b\xa1\x21\xcd\x25\x16\x16\xa6\x50\xe0\x16\xfe\x37\x6a\xf5\x32\xc8\x77\x0c\x99\x4a\xab\x35\x2d\xc3\x12\xed\x97\x39\xd2\xd7\x3f\xed\x5e\x67\x71\x4b\x27\x0c\x60\x70\x75\xe6\x34\x32\x03\x4a\x54\x11\x47\x1a\x45\xb6\x8b\x86\x50\x0f\x08\x5c\xf2\xe5\x86\x46\x0a\x83\x77\x7a\x88\x85\x45\x4d\x50\x3b\xbd\xb0\xd7\x01\x1c\xbb\xc7\x11\x13\x8a\x49\x40\xc4\x93\x98\x31\x19\x03\xe2\x49\x34\xb8\xf2\x3d\xf6\xfe\xdb\x3e\x60\x39\x1e\xc3\xf7\x05\x88\x62\xd7\x9d\x2b\xa0\xd6\x4a\x33\x95\xd7\x42\xcb\x7c\x07\xdb\x15\x16\xc0\x4c\x02\xd2\xf0\x56\x21\x36\x42\xe6\x62\x9e\xe3\x00\xb6\x18\x80\xd5\xfc\x63\x15\x54\x2c\x4a\xb4\x90\xc6\x8a\x22\x23\xb0\xb4\x0e\x42\xa3\x48\xfa\x24\xe2\xf1\xda\x93\xc5\x03\xba\x64\x68\xac\x56\xbb\x38\xe8\x8b\xa7\x71\xf4\xa4\x45\xf8\x24\xcd\x65\x7a\x7b\xb8\xa8\x07\x4d\x9d\x3a\x1f\x24\x2b\x99\x61\x3c\xb8\x3a\xd1\x88\xd9\x75\x90\xa4\x2a\xcf\x45\x69\x30\x8e\x48\x68\xa3\x07\x9b\x43\x12\xa6\x17\x0d\x92\x85\x4a\x2b\x13\x0f\x12\x83\x39\xa6\x36\x7e\x70\x05\xbe\x53\x0d\xdd\x88\xb8\x88\x19\x66\x2c\x81\x44\xbc\x7a\x07\x84\x78\x8e\xa9\xa8\x0c\x72\x31\x97\x48\x6b\x30\x5f\x50\x27\x2a\x0a\x40\x06\x49\xcd\xce\x75\xe7\x97\x9f\xcc\xd7\xcd\x0e\xcc\xcc\x0d\x00\x7d\xa8\x1f\xc3\xe4\x35\xd9\x5a\x60\xfb\x4b\xd7\x5a\x7b\x00\x4c\x4a\xcd\x8c\xff\x0a\x17\xa2\xca\x8f\x90\xf2\x38\x3e\x1f\x29\x42\xb5\x85\x70\x54\x82\xfe\x52\xfc\xa5\x78\xbb\x42\xf8\xe9\xc7\x6f\x02\xcd\x53\x55\x58\x21\x0b\x47\x79\x2c\xac\xd4\x5e\xe5\x0e\x41\x15\xf9\x0e\xcc\x4a\x68\x04\x69\x81\x37\xad\x85\x96\x58\x64\xe6\xb3\xe3\xa2\x48\x9f\x34\xaf\xc6\x86\xe4\xad\x6d\xc6\x9f\x6c\x75\x3c\x39\xb9\xa7\x46\x61\x03\x74\x2d\xac\x5c\x63\x2e\x0b\x24\x83\xb4\x0b\x82\xcd\xc5\x1f\xd1\xb0\xf2\x6b\xef\x9c\xa9\xca\x95\xc6\xec\x95\xdc\xd4\x9d\x00\xea\x6e\x85\x58\xe3\xb1\x72\x93\x6a\x95\xe7\x98\xfd\x35\x13\xb6\x35\x5a\xe7\x5f\xbd\x65\xbf\xd4\x92\x2d\xc4\x1f\x84\x5d\xd1\xbe\x5d\xd6\x63\xfb\x8a\x51\x29\xec\xaa\x36\x5e\x88\xa4\xe9\x4a\xc8\x02\xd4\xc2\xef\x9b\x76\x25\x2c\x64\x68\x51\xaf\x65\x81\x99\xa7\x7a\x36\xb2\x6a\x84\x45\x06\xb4\x0d\x15\xe9\x8e\xda\x53\x8d\x5f\x88\x95\x5c\xae\x72\xb9\x5c\x59\xcc\x40\x15\xae\xa6\xa6\x0f\x4d\x86\x34\x4a\xb1\x9c\x05\xf4\x80\xb0\x98\x5c\x8f\x7d\x31\x1b\xc6\xad\x5d\xdf\xf4\x37\x7d\xf8\x9d\x16\x5a\x5f\x85\xad\xbf\x65\x2e\x55\x3a\x7f\xab\x78\x85\xe8\x78\x02\x4f\x0d\x1f\x53\xdc\x47\x58\xbb\x83\x52\x77\x92\x89\x66\x0e\x7e\xbb\xf2\x3b\xb1\x46\x36\x2d\x8e\x15\x62\x6e\x0e\x2b\x6b\x70\x01\xb7\xb1\x98\x79\xe3\x36\xf0\x0b\xde\xd9\xd1\xba\xb2\x98\x45\xb3\x98\x7b\xbf\xaa\xb4\x20\x31\xd9\xef\x07\xde\x0c\x6b\x2c\xb0\xb2\x65\x3e\x34\x3c\x45\x42\x80\x77\xf6\x5b\x2c\xaa\x9a\x0f\x33\xad\x4a\xb2\x05\x21\xcd\x51\xe8\x85\xbc\x73\xa4\xae\xf2\x7e\x83\xd1\x9a\xbb\x39\x1b\xcd\x7d\x17\x5a\x8a\x51\x2e\xe6\x48\x9c\x35\xdf\x35\x6d\xdd\x08\xfe\x00\x92\x49\x53\xe6\x62\x37\x99\xe7\x2a\xbd\xbd\x2a\x95\x91\x84\xf5\xc4\x1d\xa7\xae\xd6\x42\x2f\x65\x31\x9a\x2b\x6b\xd5\x7a\xf2\x87\xf2\x2e\x1c\x44\xae\x73\xe9\x07\x2b\x35\x1a\x2c\x2c\x4f\xb6\xc6\x9b\x18\x1d\x6a\xdc\x56\x28\x32\xd4\xc4\xd7\xb9\x9c\x9d\x85\xfe\x74\x08\xb0\x62\xce\xa7\xbe\x69\x34\xba\xf4\x67\x00\xc1\xda\x65\xca\x7b\xc4\x28\x5d\xc9\x3c\xd3\x58\x84\xb3\xc8\x13\xdf\xc8\xaa\xe5\x92\x99\x5b\xa9\xdc\xca\xd2\x97\x96\xb9\x48\x59\xe3\x4e\x23\x4d\x9c\x5a\xcb\x00\xc1\x62\x93\x2e\xc0\x73\x36\x10\x58\x32\x61\x69\x71\xa2\xd9\x1b\x6a\xf2\xd2\x57\xbb\x93\x05\x21\xfb\x38\x5c\x69\xfb\xfb\xb5\x70\x25\x58\x1f\xc0\xf5\x6b\x6a\xf2\xa9\xb8\x2e\x64\x6e\x51\xff\x0a\x04\x1d\x1f\xc1\x54\x18\xa7\x1d\x04\xf8\x61\x66\x5f\xf1\xff\x06\xc9\xd3\x58\x76\x11\x0a\xe8\xa6\xb9\x32\x18\xcd\x5e\xd2\xbf\xf6\x54\xaf\xc7\x55\xde\xe8\xc6\x03\x29\x72\xc3\xfe\x7f\x21\x4b\x87\x62\xd4\xd6\x3c\x61\x4b\x61\x15\x33\x81\x40\xee\x2e\xa9\x65\x51\x56\x6d\xf3\xbd\x86\xed\x56\x89\xcc\xa3\xf5\x88\x28\xa7\x55\xfe\x69\x0c\x41\xb0\x41\xc0\x2d\xee\x26\x1b\x3a\x55\x40\x29\xa4\x06\x51\x64\x40\x73\x32\x80\x05\x8d\x63\x15\x39\x8d\x72\x77\x22\x09\x8c\xc8\x30\x57\x2a\xcf\x50\x4f\xcf\x6b\x00\x49\x92\x9c\xff\x06\x2c\xe3\xe9\xb0\x91\xb8\xfd\x56\x65\x7e\x27\x9b\x57\xd6\x2a\xe7\xb8\x98\xdb\xe2\x8d\xd2\xf6\x8d\x15\xda\xbe\x95\x6b\xac\x29\x37\xb7\x05\xcc\x6d\x31\xca\x9c\x25\x15\xcd\xa8\x19\xfc\x69\x07\x86\x9a\xf2\xd6\x78\x3d\x76\x80\x4e\xc0\xfc\xb2\xc8\x1e\x07\x11\x8b\xec\x31\xf0\xc2\x8e\xf3\x61\x80\x99\x6f\xf9\x01\x80\xdf\x10\xbf\x7f\x18\x1a\x8b\x45\x03\xaa\xa1\x2f\x4b\x45\xfb\xd0\xe8\x1c\x70\x00\x89\xb8\x93\x86\xed\x83\x61\xfd\x8b\xec\x08\x6f\x49\x2e\x64\x9e\x4f\xa0\x50\x05\x3a\xab\x8e\x2c\x88\x5b\x9c\xc0\x3c\x17\xe9\xad\x2f\x5a\x89\x12\x47\x1a\xe9\xd8\x2f\x8b\xe5\x04\x52\x2d\x4d\xf9\x65\xb6\x44\xe3\xdc\x75\x01\x2c\x8d\x1b\xc0\x92\xab\x6d\x21\xd6\x32\xdf\x4d\xc0\x88\xc2\x8c\x0c\x6a\xb9\xb8\x6a\x2a\xbd\x1f\xee\xa2\xbc\xab\x81\x04\x13\xc7\x09\xff\xc7\x42\x7a\xde\x40\x7a\x12\x20\x3d\xf7\x98\x39\x50\x56\x8b\xc2\x90\xf8\x4d\xdc\x57\xb2\xbd\xe2\x8b\xf2\x6e\xf8\xe2\xa2\xbc\xf3\x56\xed\x68\x6d\x46\x1f\x68\x07\xe3\xdf\xc3\xeb\x2f\xe1\x8f\xf0\xfb\xb1\xeb\xb2\xc5\xf9\xad\xb4\x8f\xe9\xf6\x46\x2c\x84\x96\x2c\xaa\xde\x75\x15\x60\xa8\xc7\x74\xff\xbe\x44\x2d\xea\x2e\x6b\xf5\xcb\x63\x3a\x7d\x25\x35\x2e\xd4\x9d\xeb\xc6\xd4\x09\x06\x35\x24\x8d\x05\xed\x49\xb4\x42\xd2\x34\x93\xe7\xb4\x2c\xb0\x95\x99\x5d\xf9\xef\x8b\x5c\x09\x3b\xc9\x71\x61\xaf\x0e\xc0\x3c\x61\x0b\xc4\x01\x08\x6a\x19\x64\xc1\x4b\xe9\xd4\x33\x57\x79\x9d\x4c\x30\x26\x70\x91\xbc\xc0\x75\x0d\xaa\x65\x8e\x0d\xeb\x5f\xcd\xb6\xf2\x89\xac\x00\x50\x6f\x0b\x20\xe6\x46\xe5\x95\xc5\xab\x2e\x96\x0d\xe3\xff\x32\x62\x5d\x47\x2c\x79\x71\x0c\x2f\x48\x3a\x5b\xd6\x2c\x97\x33\xe7\xd1\xef\x02\x6c\xcd\xb7\x14\x59\xc6\xf2\xf2\xa2\xbc\x83\xe7\x17\x01\x27\xde\x11\x27\x30\x57\x76\xd5\xc2\x7c\xeb\x08\x0f\x9f\xbb\xd1\x81\x65\x74\xe4\x97\x03\x2e\x93\xcf\x9f\xff\xf7\x3f\xfc\xb7\xcb\xcf\x5f\x78\x18\xb4\x6e\x13\x78\xf2\xe2\x85\x2f\xd8\xae\xa4\xc5\x91\x29\x45\x8a\x34\xa9\xad\x16\xe5\x81\x2b\xfd\x13\x1d\x4b\xa4\xee\x61\x4a\xfe\xf7\x9f\xa5\x79\x25\xac\xd8\xef\xaf\xea\x4a\xb2\x4d\xde\x7a\x61\x7b\xb9\x12\xda\xba\x96\x6f\xfa\xc5\xed\x3e\xcc\x56\x30\xa5\x13\x75\xe2\x0f\xa3\xa8\xa3\x41\xc2\xe5\x71\xcb\xbd\x80\x6b\x3a\xac\x92\x93\xde\x1d\x56\xdd\xce\x1a\xcb\x82\x6a\xaa\x42\x5a\x33\x00\xab\xa0\x94\x77\x98\x1b\x57\xc0\xa2\xa5\xd1\x56\xba\x30\x20\xad\xf3\x27\x84\x69\x01\xae\x63\x5c\xff\xe4\x3a\xba\x09\x3a\x8c\x68\x05\xde\xc8\x5f\x10\xa6\x50\x0a\x6d\xf0\x2b\x62\xf6\xf8\x69\x7c\x3e\x57\xd9\xee\x7c\x40\xc1\x8c\xf8\xbc\x66\xb0\xf3\x41\x7d\x16\x76\x23\x35\xfd\x7f\x0f\x1e\xbe\x3f\x22\xd7\x53\x29\xaa\xf5\x57\x5a\xad\xbf\x6c\x61\x47\x33\x2a\xaa\xf5\x1c\x35\x2c\xb4\x5a\x87\x83\x61\x38\x09\x96\xca\xd2\xe1\x5c\xe4\xf9\x0e\x96\x42\xcf\xc5\xb2\xf6\x55\x19\xf6\x16\x0e\x01\x93\x65\x02\x51\xd0\x75\xaf\x2d\xae\xff\x7a\xf9\xf9\xe7\x2f\x22\x18\xcd\x80\xbe\x74\x27\xdf\xa0\x10\x1b\xab\x1b\x02\xf8\x39\xf0\xc4\x5f\x17\x96\x2a\x93\xb5\xb0\xe9\x2a\x1e\xc7\x7f\xc9\x9e\x0d\x9e\x8e\x07\x37\x17\xef\x86\x70\x79\x31\xe8\xcf\xea\x75\x21\x09\x43\x9a\xf9\x5c\x29\x6b\xac\x16\x25\x78\x23\xc6\x38\xda\x3f\x8d\xcf\x6f\x8e\xda\x38\xef\xce\x07\x89\xff\xde\x5e\x73\x83\x36\x18\xdb\x3f\x4b\x23\xe7\x39\xc2\x56\xe4\xb7\x44\x2e\xad\xaa\xe5\x8a\x69\x43\x00\x79\xa5\x17\xb2\xc8\x4c\xd7\x2c\x8e\x65\x91\xe6\x15\x09\x5e\x00\x99\x49\x72\xe3\x59\x50\x05\x9a\x41\x20\xef\x52\x6e\xb0\x60\x13\xff\xf5\xab\x04\x5e\x5b\xd2\x4e\xb7\x06\x50\xa4\x2b\x6a\x08\xc2\xc0\xc6\x8f\x1f\x5b\x5d\x21\x28\xdd\x72\x15\x1a\x1c\xf4\x58\xeb\x10\xef\xd8\x01\x1f\x06\x38\x2d\x57\x52\x42\xc3\xc4\x34\x8b\x96\x8b\x47\x0e\x41\x91\x13\xbd\x69\x07\x20\x17\x31\x97\x25\xce\x8d\xfe\x86\x21\xc2\x67\x53\x8f\x78\xbb\x69\x58\xc8\xc6\xd1\xb7\xaf\xbf\x39\x18\x61\x3e\xd3\x80\x51\xd3\xf4\x08\xf6\xae\x4f\x7f\x0e\x07\x4e\xa0\x7a\xe1\xd2\x5c\x15\xf8\xfd\xfc\xfd\x77\xea\x95\xb2\xc6\xfd\x34\x2d\x52\xab\xf9\x7b\x4c\x2d\xc4\xb4\x58\x6a\x01\xd2\x9e\x1b\xb2\x60\x9d\xc4\xb2\x15\x6a\x06\xb4\x10\x01\x5e\x5b\x4c\x18\xd8\x10\xe6\x95\x77\x4a\x11\x0c\xee\xeb\xd5\x07\xb9\x6b\x33\x1a\x35\x4e\x06\xa0\x91\x8d\xdc\x8c\x9b\x06\x68\x1c\xb3\x30\xa9\xd2\x68\x12\x17\x4c\x91\x06\x2a\x83\x8b\x2a\x87\xe0\x9c\xfc\x8a\x3e\xac\x46\x61\x3d\x66\x3c\x16\xc3\x15\x06\x44\x9a\xa2\x31\x4a\x9b\x00\x52\x16\x56\x81\xa9\xe6\x23\x37\x33\x03\x71\xa1\x2c\xe4\xd2\xa2\x66\xa1\x25\xc4\x6f\x71\xd7\x67\x94\x2e\x9d\x62\xd5\xd5\x44\x05\x97\x92\x12\xdd\x5f\x75\xb9\x45\xb5\x58\xe5\x76\x08\x9b\xa6\x1f\xf8\x5e\x37\xb7\x89\x9f\x7b\x3c\xfe\x4b\x32\x5e\x0e\xcf\xff\x7a\x3e\x78\x47\xcb\xdd\x5b\xb4\x5a\xe6\x5d\xbf\xfe\x4a\xba\xb3\x42\xe0\x87\xaf\xaa\x5f\x7e\xd9\x11\xa9\x8c\x27\x90\x82\x05\x15\x8d\x0c\x0a\x9d\xae\x0e\xe5\x32\xae\x45\xb9\xc4\x54\x2e\xc8\x3d\x95\xef\x86\x5c\x4f\x76\x82\x5b\x70\x2b\x96\x66\xc0\xdf\xe8\x60\xdb\x13\x61\x74\xae\xdc\xe0\x3b\x53\xb5\x12\x55\x24\xa6\x36\x5d\xf5\x48\x7a\x04\xe1\x5a\xf8\x5c\x5d\x43\xac\xf1\xd8\x4d\x63\x45\x4b\x0a\xb9\x5c\x4b\x77\x02\x04\xb5\x80\x17\xcf\xc9\x79\xa7\x45\x6a\x51\x83\x9f\x5e\x29\xac\x45\x5d\x78\x9d\x6b\x38\x2a\xb6\x45\x78\x5f\x19\xdb\x40\x34\xb9\x4c\x99\x32\x2f\x9e\x83\x2c\x52\x61\x90\x23\x67\xaa\x40\x77\x16\x33\xb0\x56\x1a\x21\xde\xae\x64\xba\x82\xad\xaa\xf2\x0c\xda\x3c\xa7\x40\x0b\x69\xb0\x01\x28\x0a\xc0\xbb\x14\x4b\xc2\xcc\x33\x10\xf8\xa9\xc0\xd4\x7f\x49\x78\xd4\xf8\x62\x08\x2f\x9e\x07\x05\xca\x9d\x7f\x44\x0a\xaa\xcb\x0d\xe6\x3b\xc8\xd0\xa4\x58\x64\x8e\x59\x59\xb9\xb9\x80\xf8\x4a\x6d\x49\x68\xfc\x02\xd0\xd7\x5a\xf3\x05\xbf\x42\x03\x50\x55\x35\x39\x34\x9a\x2a\xb7\x26\x69\xb1\x6c\x18\x62\x0a\x45\x95\xe7\x81\xc3\x9a\xd2\x9a\x6b\xdb\x3a\xac\x13\xe4\x78\xb4\x3a\x64\x6c\x5e\xae\x30\xbd\x75\xac\xc1\x21\x1a\x9a\xcf\x16\xcf\x35\x42\xae\xd4\x2d\xcf\xca\x82\x34\x20\x1c\x43\x75\x15\xbe\xc3\xa1\x0b\x90\x20\x24\xad\xa2\x93\x4a\xf7\xd4\x04\x8e\x29\xdf\x5a\xa0\xea\x61\x7e\x40\x4d\x86\x3a\x08\x27\x3f\x81\xa2\xaa\x68\xbc\x4d\xe6\x9c\x15\x4f\x02\xff\x81\x90\x29\x57\x2e\x7c\xd0\x2a\xcf\x0f\xb1\x36\xb0\x12\x1b\x04\x99\x61\xe1\xdc\xc0\xd4\x1b\xac\x6a\x60\x0f\x79\x89\x99\xcb\xb6\x82\x44\x2a\x08\x25\x37\xed\x42\x6c\xf7\x6b\xd3\x83\x16\x99\xd8\xae\xaf\xb9\x98\x46\x5a\x6c\xc9\x26\x1c\x5c\xf5\x3a\x2c\x68\x48\x17\xb4\xa1\xd1\xe3\x1b\xfd\x6e\xd8\x23\x19\xc9\xc9\x1b\x2c\xc8\x42\xdf\xe0\xc4\x6d\xab\xc3\x4e\x0b\xb3\x22\x51\xa1\xb3\x2f\x1d\x6f\xaa\x5e\xad\x5d\x69\x34\xe4\xcb\xe0\xd3\xc4\xb0\x99\xc8\x17\x90\xab\x2d\xea\xa6\x01\x48\x2f\x81\x24\xc5\xa9\x75\xfe\x75\xd4\x54\x9c\xa3\x31\x49\x07\x2c\x11\x66\x02\xdf\xb3\x52\x4f\xe8\x47\xac\x07\x43\x02\x4b\xf3\x84\x85\xc4\x3c\x33\x27\x69\xb5\x3f\x20\x84\x97\x18\x16\x04\x83\x89\xeb\x15\x7b\xb5\x74\xd5\xe3\x91\x57\x58\x62\xc1\xe2\xa8\x0a\x8a\x5c\x12\x89\x41\x69\xe6\x00\x76\xe3\x9c\xe2\x1c\x20\xee\xc3\x0c\xaa\xb2\x0b\x90\x02\xa4\x1e\x83\x61\x23\x2e\xb2\x31\x6e\x94\x26\x05\x90\x61\x67\x16\x7d\x7b\x21\x48\x7d\x8e\xc5\xd2\xae\x60\x06\x17\x87\x88\xb7\xf4\x0c\xcb\x26\x0d\x74\x6e\x6a\xa5\xde\x06\xef\x75\x43\xc7\xc4\x68\xd1\xad\xa1\xe1\xbe\xab\x4c\xe2\x4e\xd3\x53\x1b\xd6\x6f\x64\x2f\xf2\x8e\x18\x5c\xaf\x60\x15\x1b\x90\x4e\x8b\x32\x6c\x6e\x1b\x40\x8a\x0e\xc1\x0b\xe5\x0f\x26\xe3\xf1\x59\xcd\xb2\x8e\x35\xc3\xda\x4a\x03\x2e\xbf\x2b\x83\xf9\xce\xf9\xfa\x60\xa1\x72\xe2\x6b\x5f\x42\x47\xc0\x82\x27\x25\xe0\x6f\x95\xb2\xe8\xad\xa8\x3e\x64\xf8\x77\xdc\x4d\x22\xbc\x2b\x31\xad\xdb\x44\xbd\x36\x5f\x29\x0d\x3e\x7f\x6b\xd2\xef\x4e\xc1\x9a\x49\xf4\x23\xfe\xad\x42\x63\xfb\x1d\x5f\x2f\x1a\x12\x64\x0a\x4d\xb3\x45\x33\xd1\xc4\x5c\x6d\x82\xd0\x79\x7b\x81\x78\xdb\xef\xa9\xc3\x13\xeb\x67\x64\x8e\x85\xcd\x77\x1c\x16\x36\x10\xa2\xf2\x24\x3e\x23\xb7\x39\xb5\xc5\x40\x16\xcb\x07\xcd\x81\x87\x2c\x81\x9f\x45\x2e\x33\x61\xb1\xe5\x22\x6d\xef\x6c\xa6\xcc\xa5\xf7\x42\xb4\x76\x5d\x2a\x8c\xa3\x49\x13\x10\x95\x8b\xb8\xd5\x32\x08\xc9\x67\x53\x78\xde\x0c\xc6\xc3\xf9\xfc\x1f\xbf\x74\x0b\xa5\xbb\x8b\x3e\xec\x24\x21\xb4\xe7\x48\xf8\xb5\x24\xe8\x11\xf6\xce\xd5\xd9\xf1\x8d\x69\xdf\x9a\xde\x2d\x4c\xdb\x53\xbc\xb9\x78\x77\xd5\xaa\xdd\xf4\x6a\x2f\xdf\xb5\xe6\xbb\xb9\xb9\x78\x07\x9f\x4d\xa7\x70\x1e\x9d\xc3\xdf\xff\x0e\x9b\x9b\x8d\x9f\xf7\xe8\xb2\xae\x38\x31\xfb\x36\xb3\xfe\xdf\x25\xc2\x78\x0c\x94\x78\x53\x42\x8e\x22\x0b\xe6\x90\xd5\x42\xe6\x35\x9e\xc6\x9d\xcd\x19\xd9\x49\xa0\x0e\x99\xd4\xde\xfa\xba\x1c\x42\x33\xf3\x46\x9d\xff\x66\x27\xbc\xb3\x03\xc3\x48\x2e\x1a\x3d\xef\x8c\x5c\xd2\x1d\xf5\x21\x8b\xe4\x3c\x25\xe1\x62\x29\xe5\x9d\xa6\xd2\x3d\xde\x6f\x61\xe5\xb7\xf7\x9b\xdb\x77\x30\x9d\x76\x0f\x1d\x87\xdb\x04\x6d\xd1\x2d\xe4\x00\x73\x83\x0f\x76\xe0\x2d\xff\xd8\x81\xb5\x27\xc2\xdd\xb3\x68\x6f\x75\x0f\x8f\xa2\xff\xb1\x42\x17\x33\xaf\x0c\x6a\x17\x13\xf1\x47\x51\x0e\x53\x40\xf0\xbe\xbb\x46\xde\xc7\x07\x6b\x76\x3e\x6e\x91\x4f\x24\x20\x2d\x59\x61\xf5\x96\x80\x69\x2e\x34\xd6\x16\x99\x00\x83\xa5\xd0\xc2\x62\xcb\x03\xe0\x37\x3e\x46\xb6\x03\x15\xa4\xc5\xb5\x81\xb4\xd9\x0f\xfe\x56\xc9\xf4\x36\xdf\xb9\xa1\xfa\x48\xd0\x00\x5b\xcc\x73\x88\x0d\xfa\x04\xb2\x83\x43\xa4\xbd\x23\x9f\xe4\x17\xfc\x8b\x27\xd5\xce\x3d\x39\x9d\x79\xe2\x92\x58\x9a\xd0\x77\x37\x99\x68\x1f\x3c\x36\xed\x36\x20\x6e\x8e\x04\x7c\xc8\x7b\x43\xc9\x2a\x9c\x00\x13\x0d\x8f\x20\xd4\xf2\xe9\x74\x2a\xc9\x35\xc8\x31\x55\x9f\xfb\x23\xd7\xa5\x3b\xee\xb9\x63\x58\x48\x1e\x6a\x13\xe4\xdc\x00\xf5\x3a\xab\xf9\xdc\x6f\x14\xc4\xd4\x9d\xf0\xac\x5f\x59\xf3\x10\xb5\xc2\xf8\x31\x1e\xf1\xcc\x1c\xa5\xeb\x55\x67\x4b\x60\xf9\x9c\x1e\xa1\x24\x51\x29\x8e\xe8\xd3\xd9\x8e\xd1\xc0\x73\xec\xd5\xd9\x49\x27\x4b\xdf\xbd\xe2\x5b\x06\x97\xde\xd7\xe4\x61\x8f\x0f\xf8\xdb\xa5\x26\xad\x44\x91\xe5\xa8\x0d\x93\xcc\xd9\x1d\x6d\x26\xa2\x79\x8e\x99\x3a\x8e\x28\xc9\x63\x16\xb7\x9b\x07\xd0\x5f\xe4\x4e\x9e\xd3\x69\xaa\x92\x1a\x18\xd4\x62\xf9\x81\x11\xbb\xd1\xfc\x4f\x1c\xd1\x79\xe4\x3a\xa9\x69\x1d\x1a\xd5\x5c\xe5\x4d\x15\x53\xcd\x89\x46\x8f\x22\x89\xeb\xf2\x30\x66\xcd\x7e\xe2\x14\x0c\x0d\x55\x28\xca\xcb\xea\xac\x49\xf2\x30\x97\x35\x50\x5e\xb9\x68\x82\x53\xe4\x6d\x5c\x3b\x12\xdc\x8a\x8f\x24\x1c\x99\x1e\x24\x2b\xbb\xce\xe3\x1e\x6b\x76\x2b\x07\x83\xab\x87\x20\x45\xce\xd9\xdd\x28\xed\x3a\xb0\x11\x71\x64\x23\x6a\x8e\x60\x2e\x8e\x73\x28\x07\xd4\x3f\xa2\xca\x68\xd0\x34\xb6\xaa\x3c\xd9\xd6\xaa\x32\x1a\x1c\xb8\xa8\x5a\xcb\xd2\x9e\xa8\x5b\x8e\xf3\x7e\x7e\x62\x7b\xe9\xbf\x0e\x4a\xd5\xb5\x0d\x4b\x30\xf2\x94\x74\x19\xa1\x47\xb7\x87\xb4\xb5\x3d\x24\x67\xa7\xb1\x78\x94\x4a\x3c\xc6\x21\x8f\xd2\xcc\x9d\xd5\xe8\xe8\xe7\xc1\xd5\x89\x3d\x8e\x62\x3a\x86\x7d\x4e\x96\xf7\x74\x7f\x0c\xab\x49\x40\x60\x7d\xf8\xa4\x4e\x13\x40\x9f\x28\x50\x9b\xe1\x5b\x3c\x48\x18\x00\xab\x8e\xa7\xc7\x20\x58\xa1\x97\x68\x5b\xce\x93\x0f\x2d\xd8\x2d\xee\xaa\xf2\x68\xae\xa4\x5c\xc4\x48\xd5\x2f\x55\x86\x64\xfa\x5c\xbe\x68\xea\x6a\xa3\xc7\xe5\x9b\x5a\x87\x73\x72\x68\xc9\x7d\x7d\x6c\x2b\x1d\xc2\x52\x8b\x79\x1f\x5f\x20\x95\xeb\x8e\x83\x6e\x92\x2b\xac\x67\x98\xfc\x4a\xca\xfe\xc4\x21\xe4\x69\x4c\x26\xc4\x20\xd9\x08\x12\xc5\x8f\x58\xfb\x53\x9b\x42\x60\x89\xfe\x66\x47\xd7\x28\x48\x35\x66\xc2\x56\xeb\x21\x79\xdf\xfb\xa9\xac\x1f\x1a\xef\x11\x93\x76\x70\x4f\x74\xe8\xea\x1d\xc6\x23\xe1\xc0\xfe\x03\x23\x7c\x9c\xee\xc1\xa4\x14\x4b\xfc\x9f\x3d\x2d\xe3\x4a\xff\xd7\x29\x9f\x77\xcb\xe6\xdc\xf7\x48\xd7\xa3\x70\x5b\xaf\xb3\xb8\x69\x9c\x57\x32\xcf\x42\x72\x78\x68\xce\x42\x92\xa6\xaa\x2a\x2c\x6f\x34\xe9\x8a\x32\x2f\x0d\xdb\x92\xeb\xca\x58\x58\x48\x6d\x2c\xe0\xba\xb4\xbb\x06\xa2\xb4\x10\x6e\x3c\xe4\xbb\x96\x76\x4f\x7a\xe9\xb0\x83\x84\x3b\xc6\x9d\x0d\x82\x2e\x38\xb0\x0f\x9a\x11\xa9\x5d\x0b\x3e\x10\xe1\x5d\x16\x19\xfb\xab\x94\x86\x52\x18\x53\x6b\x85\xec\x45\x0d\xbb\xcd\xeb\x1e\xc6\x2b\x17\xec\xbd\x79\x77\xf5\xc1\x93\x4c\x9b\xa3\x58\x86\x3f\x53\xf3\xf7\xc9\x81\x49\xf5\x70\x64\xaa\x35\x6c\x52\x56\x66\x15\xb7\x19\x6a\xdf\x3e\x62\xb7\x5b\xfa\x23\xf6\x74\x0a\x17\x47\x34\xc5\x59\xef\x70\x44\xd3\xe3\x5c\x85\xb7\x2e\xdc\x58\x7b\xaa\x5b\xf5\x44\x12\x92\x51\x5e\xfa\xb6\xd3\x9a\x62\x40\xb2\x18\x72\x60\xc0\x0e\x81\x73\x04\x7a\xf3\x76\x4d\xba\x33\x26\x98\x99\xdc\xb0\xee\x38\xaf\x33\x25\xce\x0f\xbc\x83\x1c\xc8\x37\x30\x75\xf0\x5d\x3e\x86\x89\x3b\xcd\x32\xb9\x49\xc8\x6f\x15\x9f\xb7\xd2\x35\x42\x50\x9a\x0e\xca\x4b\xad\xaa\x22\x1b\x71\xe5\xf9\xd0\x83\x8c\x1d\xa6\x27\x20\x71\xc6\x06\x05\x60\xf1\xce\xb6\x29\x7b\xc3\xbd\xde\x25\x8b\x2a\xcf\xbf\xe9\xc8\xea\xf1\xfe\xc2\x5a\x1d\x47\x9c\x96\x16\x0d\xe1\x08\xa0\x20\xf0\x2d\x28\x56\x96\x4e\x25\x3c\x7a\x5c\xea\x41\x96\x29\xeb\xce\x21\xab\x8d\x4e\xd0\x3b\x7a\xe6\x26\x7b\x73\xf1\x6e\xf0\xe0\xf9\x93\x87\xee\xdd\x9e\xd8\xf7\xd9\xa5\x1b\xd7\xee\x08\xba\x5b\xa4\x16\xdb\xa4\x3e\xe5\x21\x7b\x51\x27\x2f\xd5\xd7\x3c\xba\x7f\x3e\xbb\x81\x3f\x4f\xb4\x30\x56\xa4\xb7\xa7\xba\xbb\xe4\x99\xf8\x9e\x35\x1f\xae\xe3\xff\x3a\x18\x02\x67\x05\x4e\x2e\x86\xac\xf7\x2e\x86\xe0\xb3\x1d\x2f\xf6\x27\x60\x30\x1b\xd6\x3b\x30\xc4\xd9\x10\xa4\xdf\x21\x06\x70\xdf\x95\x01\x0e\x7a\x37\x6c\x3f\x80\x53\x40\xd7\xaa\x32\xa8\x2a\xfb\x58\xb8\xce\xcd\xff\x08\xc0\xdd\xbb\x15\x7d\xa8\x47\xfb\x00\x6c\x65\x91\xa9\x6d\x92\xab\x94\x8f\x93\x09\x25\x2d\xc2\xd4\xf5\x4a\x2a\x9d\x5f\x9d\xe8\x37\x1e\xbb\xeb\x14\x74\x21\x29\x71\xb1\x3e\xb9\xd8\xf9\x5d\xcb\x3b\x41\x86\xac\x36\x86\xf0\xbc\x2b\x55\x5d\xe7\xff\x71\x26\x72\x8a\xa7\xa3\x6f\xca\xc0\x36\x65\xec\xe5\xe8\x9c\x93\xff\xce\x87\x70\xee\xae\xd4\x9e\xb7\xb6\xfe\x32\x51\x8b\x85\x41\x1b\xdf\x8c\x2e\x2f\x86\xc0\x8c\xde\x02\x67\x36\x4b\x07\xce\x5b\xc5\x47\x76\x11\x51\x52\x68\x21\x8e\xcc\x66\x19\x05\xc1\x65\x6e\x8c\x86\x70\x92\x2b\x13\x26\x40\x5b\x52\x07\x09\xc5\x73\x63\x5e\xbe\xa3\x3d\x38\xdd\x28\x8e\x68\xad\x17\xb9\xda\x46\x43\x88\x7c\xf7\xe8\x68\x7b\x06\x67\x65\xd9\xa1\xdd\xf7\x95\x75\x3b\xeb\x2a\x5c\x3f\xf4\xb7\x20\xd2\xf6\x85\x87\xa4\xbb\x47\xb5\xb0\xfc\xd0\x56\x45\x3b\x55\x00\xd5\xae\x82\x86\x86\x5f\xe4\xf9\x01\x19\xe1\x46\x66\xd3\xae\xea\x81\x67\x20\xe1\x19\x44\xef\x7a\xb3\x0b\x74\x70\xf9\x94\x44\x85\x27\xd9\x1f\xff\xf0\xe2\xf3\xc5\x83\xed\x46\x61\x45\x9e\x1f\xd3\x50\xdd\x35\x6f\xc2\xc1\x61\xaa\xa4\xcd\x07\xed\x79\x02\x17\x85\xed\xf2\x1a\x2e\x3f\x27\x79\xf4\x86\x10\x55\x5d\xc1\xbe\xb7\x7b\x72\x71\x62\xaa\xb9\xb1\x9a\x62\xcb\x64\x8b\x3f\x83\x28\x49\x92\xa8\xde\x58\xdb\x0e\x91\xa7\xac\xe1\x8d\x4f\xe7\xea\x91\x8b\x61\x75\xb3\x3a\xa3\xce\x3a\x7f\x2b\x6e\x5d\x2b\x50\x85\xf3\x61\xd4\x7d\x7d\x12\x00\xb0\x1a\x18\xd1\x75\xbd\xa4\x63\xbb\xbc\x37\x1c\x71\x28\xce\xdb\x71\x78\xc4\x35\x58\xe5\xa2\xa2\x02\xb6\x74\x84\x56\x60\xaa\x92\x6f\x32\xd3\xee\x01\x28\x8c\x6c\xec\xad\xf1\xb8\xfe\xd2\x8e\xb9\xf2\x3d\x5f\x62\x82\xda\xd4\x23\x14\x3d\x46\x43\x8e\x22\x85\x1a\x3a\xcf\x85\x1a\x88\xed\xaa\x15\xc4\x7f\xf3\xf3\xff\x00\x8d\xa9\x1d\xb8\xc3\x06\xb9\xaf\x39\xcb\x2a\x74\x7d\xfd\x2a\x64\x04\x50\xe0\xda\x40\x2e\x29\xf1\xb6\x97\xcf\x15\x0d\x8e\xe1\x4a\x57\xba\x72\x61\x6c\x48\x20\x63\x8b\xcf\x85\xbd\x5d\xa2\x5c\x86\x77\xce\xdc\x53\x55\xc7\xb6\x3b\x6d\x68\xc2\x72\xe6\xaf\x0e\xb2\x30\x1d\xbd\x8e\x48\x0b\xee\x60\x4f\xdb\xe9\x64\xe1\x50\x43\xb4\xa8\x95\x99\xcc\xce\xdb\x7a\x92\xba\x32\x03\x10\xa7\xf0\x17\xe3\x37\xfd\x96\x71\xd0\x28\x30\x06\x78\xd6\x12\x13\x3a\x59\xbb\xad\x66\x83\x9d\xfb\x96\xfd\xbd\xe0\xa1\x5d\x8c\xad\x84\x4e\x8c\xfe\xc4\x18\x95\xed\x0d\xf1\xf0\x26\xe6\xe0\x1e\x81\x76\xe0\x0b\xe8\x63\x7b\x62\xbf\x3a\x62\x1a\xf5\x36\xaf\xfd\xe0\x28\xdd\x9c\xbd\xf5\x58\xc2\x3d\x82\x58\xff\x54\x12\xb1\xf9\xe9\xf4\x98\xc3\x3c\x91\x45\x81\xfa\xeb\xb7\xdf\x7e\x33\x18\x74\x62\x1b\xc1\xdd\xa1\xd1\xa7\x76\xb8\x63\x23\xfb\x73\x62\xce\x83\x64\x63\xc8\x69\x8b\x81\xbf\x2d\xb9\x45\x50\xa5\xeb\xd7\x86\xd5\x71\x93\xb6\x6e\xd7\xb9\x8b\xec\x98\x5a\x51\x2c\x73\x4c\x3a\xac\xcb\xfb\x60\x67\x8b\xed\x32\x3d\x99\x9e\xee\x7c\x3c\x68\xc5\xd1\x48\xce\x6e\x9c\xd1\xca\xd3\x7b\xe7\x3d\x44\x0d\xf2\x07\x3e\x4e\xaf\x85\x8f\x9f\xe2\x0f\xd9\x62\xd0\xc9\x38\xe8\x09\xe2\x3f\x71\xac\x5e\xd0\x45\x2e\xf8\x84\x48\xce\x1b\xb2\x91\xe0\x77\xbf\x3b\xcc\x0c\x6e\x58\xff\x03\xee\x6d\x23\x38\x68\xcc\xc1\x15\xa5\x9d\x9e\x33\x4a\xdb\xb3\x46\x8f\x18\xcb\x17\x22\xa6\x35\x48\x3a\x8f\x4c\xe0\xfc\x7c\xd8\xcd\x18\x90\xc5\xf2\x7b\x9d\xa1\xee\x65\x97\xb8\x5c\xd4\x50\x13\x68\x42\x30\xfa\xdb\xe7\x4a\x1a\x76\x63\x70\x4c\x93\x1b\x74\x0f\x14\x75\xbd\xab\xbd\xea\xd7\xf5\xf0\x38\x8c\x79\xd5\xfb\xee\xe5\xd1\xb0\xde\x09\x20\x9f\x1d\x2b\xbf\x3a\x44\xbd\xd7\xe2\xd8\xa9\x1c\x46\x97\x0f\x9e\x99\x8e\xa1\xd7\xfe\xbf\x6f\xe5\xee\xd2\x9a\xcc\xfd\xad\x1c\x59\x2c\xff\x4a\x0b\xdd\x73\xb1\x30\xe5\x3b\xb7\x7c\xe2\x6e\x06\x24\x01\x09\xd3\x0c\x0b\x9d\xb4\x16\x2c\x3e\x67\xf0\x0c\xbb\xb1\x90\x89\xfb\x12\xea\xda\x6c\x5c\x62\x08\xf3\x5e\x08\x7a\xe3\xe2\xfd\x52\x15\x5d\x52\xed\x4a\x54\x0b\x10\x6c\xaa\x18\x17\xbe\x76\xbe\x14\x0e\x6e\xfb\xea\xf9\x91\xea\xc1\x31\x22\x12\x48\x0f\xab\xf1\x54\x4c\xe1\x82\x60\xcd\x8f\x94\x77\x80\xb4\xd1\xad\x99\xbe\x07\xf5\xe6\xe2\x5d\xd2\xa1\x31\x5c\xc3\xfc\x44\xd5\xd1\x25\x6f\x68\xfc\xfb\x63\xcb\xff\xe0\x50\xb3\x4f\x1c\xea\x31\x4c\x76\x71\xcc\xe8\x7d\xa4\xd2\xf0\xbc\xe7\xb8\xfd\x41\xce\xf3\x77\xc1\x3e\x9a\xef\xb0\xc8\xfe\xd5\xb9\xae\x45\xdd\x2e\xcf\xb5\x2a\x7e\x05\x8e\x6b\x0f\x33\xfb\xa4\x61\x7e\x23\x6e\x0b\x97\xfb\x4e\xb1\x5a\xb8\x26\xf8\xd1\xbc\x16\x00\xff\x0b\xf3\x5a\x20\x41\x97\xd1\x42\xe9\xaf\xc0\x65\xf5\x00\xb3\x8f\x1f\xe0\x37\xe2\x2f\x77\x62\x12\x79\xb9\x12\x73\xb4\x2e\x95\xbe\x36\x83\x1a\x36\xfb\xc6\x1f\xac\x1a\x73\xfc\xe3\xb8\x8d\x87\xf9\xb5\x59\xcd\xe1\xce\xbc\xe4\x1c\x6a\x5d\x56\x3b\xac\xfe\x18\x2e\xe1\xde\x89\x55\xdf\x50\xa2\xef\x4b\x61\x48\x9b\x5f\xc3\xfc\x58\xf9\xa7\x73\xca\xb1\x41\x66\x9f\x32\xc8\x3f\x9b\x5b\xd0\x19\xc8\x80\x1b\xb4\x60\x55\x48\x83\x39\x0b\x41\xb6\x83\x8b\xd5\xe1\xe5\x9a\x23\xe6\xd8\xe0\xaa\xdf\x2d\xdc\x9d\x3e\xec\xe4\x6b\x0e\xbb\xd4\xd7\xa3\x0f\xfb\x84\xaa\xc3\x4e\xee\x0a\xf4\x61\x8f\x26\x20\x70\xf0\xd8\x8c\x7f\x10\x8c\x1c\x19\xf0\x96\x7c\x44\xfc\xc0\xd7\x03\x97\xa1\xc3\xdd\x73\xb8\x6f\x5f\xd1\x1c\x71\xe0\xf0\xd2\x5d\x48\x6d\x4a\xbd\x3f\x3d\x54\xf0\x8d\xd0\x52\xab\x85\xcc\x91\xde\x2d\x1b\xc2\x93\x0d\xea\xb9\x32\x7c\x48\xa2\x12\xb8\x3f\x7e\xbb\x94\x7a\x26\x0b\x79\x87\xd9\xc8\x12\x96\xa3\xfa\xda\xa3\xef\x31\x57\xee\x2c\xd2\xe9\xc0\x4d\xc1\xae\xe0\xfe\xf0\x9a\xa8\xcb\x2e\xe9\x37\xcd\x7c\x53\x80\xad\xd2\xd9\x68\xae\x51\xdc\x4e\x80\xff\x8d\x44\x9e\x1f\xdc\x08\x25\xe2\xfd\xb9\x32\x56\x2e\x24\x66\xa0\x45\x26\xd5\xc8\xf3\x8e\xcb\xcc\xdc\x4a\x9f\x24\x38\x47\xbb\x45\x2c\x9a\x4c\x6a\x4f\x07\x20\x82\xba\x67\xd5\x8e\x5d\xf1\xe7\x4b\xec\x14\x9f\x2a\x9b\x6f\xa3\xf7\xf5\x88\x4d\xd9\x9d\xe9\x3d\x85\xe0\xd1\x88\xf8\x8e\x3c\x63\xa6\xfc\x2d\xd5\x6b\xa7\x39\x7a\x37\xe5\xdd\x83\x5f\x3b\xa0\x9c\x8c\x0d\x86\xc7\x1e\xda\x6f\x31\x30\x90\x88\x8f\x69\x0e\xc1\x28\xdc\xbf\x0f\xcb\x17\xb9\x14\xc9\x69\x44\x05\xc0\x25\xb3\xfa\xeb\xf5\x98\x81\x31\x06\x63\x46\xe1\x83\xc8\x7c\x1c\x16\x3f\x77\x79\xa9\x46\xc6\x97\x43\x0b\xa9\x83\xa2\x7f\x3a\x72\x3f\x34\x6c\x5f\x23\xe6\xcb\x3c\x4e\xed\x5f\xc7\xd0\x09\x4f\x15\x10\xd3\x9d\xc1\x9f\xc5\x46\xbc\x61\x29\x86\x94\xf8\xc4\x2a\x97\x0b\x49\xac\x45\x9e\x83\x26\x80\x3d\xee\xb1\x5a\xd6\xbd\x22\x21\xd3\xd5\x99\xe3\xdc\x3a\xad\xd3\x78\xe7\x2d\x66\x67\x4e\x1b\x7c\xe8\xde\x33\x39\x43\x6b\x8e\x65\xcc\x27\x8e\x12\x83\xc4\xc5\xf2\xe3\xe3\x1b\xab\xcc\xd8\xed\xed\x7c\x2e\x2e\xa2\x22\xb3\x4e\x5e\x38\xb5\x98\x42\x87\xc7\xfa\xcf\xbb\x65\x75\x85\x8b\x71\xd6\xdd\x0f\xb6\x8a\x5e\xeb\x6e\x20\x73\x7f\x76\x6c\xd4\x3e\x4f\xf5\x07\xdf\xf4\xeb\x1f\x83\xc3\x61\xa7\xc7\xa0\xd2\xe6\xa0\x3e\x1a\x65\xbb\xee\x31\x28\x74\x3b\xf4\x87\x77\xee\xa9\xf6\x9b\x64\xbc\x4b\xb8\x64\x53\xa5\xf9\x72\x07\xf3\x16\x2d\x3a\xe4\x62\xa7\x2a\xeb\x54\x58\x95\x33\xc3\xd7\x54\xee\x3c\x51\xe6\x1f\x20\xcb\x65\xa7\xd4\x89\x08\xf9\x0e\x9b\x47\xce\xfa\xef\x62\xf9\x47\x41\x9b\x67\x81\xdd\x0b\x58\x4e\x00\xdd\x9b\x5d\xe1\x6d\x97\xd6\x43\x69\xee\x45\xad\x4e\x8f\xf6\x0b\x5f\x04\xd1\xbd\xa0\xf5\x31\x70\x6a\xac\x0e\x40\xb9\x27\xb2\xfa\x98\xf2\x54\xbe\x28\x0a\xe5\xf2\x73\x4d\x18\xcd\xed\x38\x81\x10\xfc\xa3\xde\xda\x32\x2c\x0c\x66\xfe\x37\x19\x77\x25\x3d\xd5\xe5\x97\x27\x3c\x4c\xe6\xfd\xbe\x2d\xd0\xa7\x86\x1c\xec\x9b\x20\x95\x43\xed\x75\x58\xc6\x56\x0d\xe1\xa4\x67\xd7\x76\x45\x73\xfd\x77\xdc\xd1\x0c\xed\x6a\x76\x6d\xb3\xd9\xfd\xbd\xb1\x1a\x12\x7e\x81\x93\x8b\xb3\xd9\xf5\xd8\xea\x59\x0b\xaa\x9b\xfd\xe1\xaf\xeb\x31\xcf\xa2\x4b\x24\x00\xf7\xca\x8d\x7b\xe3\xa6\xe1\x2e\x2f\x18\x0f\xf3\x56\x5f\x7a\xfe\x93\xc5\xfe\x1f\x63\xb1\x4f\x65\xa3\x4f\x66\x9b\xf6\xfe\xd6\xe1\x98\xf0\xcc\x56\x5b\xdb\x31\x7f\x30\xf0\xde\xd3\x51\x54\xe4\x6d\xa8\x4a\xe7\xee\x25\x69\xd7\xcf\xbf\xc4\xfc\x10\x21\x7d\x47\xf7\xdc\xc8\x34\x7a\xfe\xc7\x3f\x06\xf3\xc0\xae\x50\x64\xee\xbb\x23\x4d\x8b\x4e\x2b\xd7\x8b\x8e\x1e\x04\x8e\xb8\xb5\x0a\x38\xf0\x3d\xc9\x69\xf4\x1d\xbf\x98\x45\x9f\x4c\xc6\x8f\xeb\xcc\xa7\x8e\x19\x7d\x42\xbc\x36\x83\x4f\x84\xf0\x06\xf3\x45\xf3\x74\x96\xe4\x07\x8d\x39\x3a\x24\xed\x4a\x71\x42\xec\xae\xc9\x6f\xd5\x55\xc1\x0f\x30\xcf\xa8\x17\xfc\xa3\x43\x87\xf4\x4f\x3f\x89\x67\xcd\x45\x85\x7f\x04\x68\xb5\x8e\x66\x2f\xab\x75\x95\x0b\x32\x75\xff\x71\x24\x7d\x1e\xc4\x31\x1a\xcd\x77\xad\x4b\xa3\xee\xf9\xd0\x63\x89\x18\x51\xf3\x10\x25\x3d\x94\x79\x80\x4d\x23\x26\xd7\xe3\x16\x43\x5d\x5b\x7a\x5f\xa5\x6e\x44\xf2\xf0\xa5\xbb\x85\xc8\x48\x86\xf7\x19\x38\x85\x51\x23\x89\x47\x1d\x8f\xa7\x09\xc1\x4f\xaf\x8f\xf3\x65\x36\x1b\xdb\x75\xf9\x6f\x0b\xa5\xa6\x34\x65\x96\xd4\x4e\xf5\xe5\xc5\x1f\x2e\x0e\x4b\x5f\x5c\x5c\x1c\x29\x7d\xde\x2f\x6e\xcb\x3c\x89\xa9\x2f\x0b\x53\xa9\x45\xbf\x6b\xf0\x72\x00\x93\x4f\xb6\xde\x74\x15\x41\xee\x47\x34\x31\x3f\x23\xad\xb6\x9c\x36\x4a\x77\xb5\x41\x5a\xb0\x0a\x34\x66\x92\x82\x92\x50\x19\x70\x49\xdd\x67\xd4\xb3\x74\xd7\x18\x46\xbc\x34\x94\xf0\x9a\x3c\xde\xd8\x6d\x9b\x4f\xfe\xec\x18\x71\xb4\xf0\xdc\x25\x5b\x68\xb5\x4d\xe6\xc6\x55\x9c\x37\x41\x43\xa0\xe8\x20\x63\xf8\xd4\x27\x3c\x04\x3b\x8e\x93\x20\xbb\x81\x6c\x62\x0b\x1f\x38\xa3\x3e\xc9\x4f\x3f\x7e\x33\x68\x4e\xa7\xc7\xa3\xde\xbe\xdd\xd5\xd9\x09\x33\x2e\x28\xd2\xff\x33\x00\x8c\xd4\xeb\x65\x73\x61\x00\x00"),
+			uncompressedSize:  24947,
 		},
 		"/traces.html": &_vfsgen_compressedFileInfo{
 			name:              "traces.html",
-			modTime:           mustUnmarshalTextTime("2015-10-04T03:50:12Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x58\x5b\x73\xdb\xb8\x15\x7e\xcf\xaf\x40\xb0\x99\x86\x9a\x58\x54\xbb\x33\x7d\x71\x24\x77\xbc\x9b\x6d\xc7\x6d\x37\xc9\xac\x9d\x74\xa6\x9d\x3e\x40\x24\x24\xc1\x81\x08\x16\x00\x65\xab\x5a\xfd\xf7\x7e\x07\xe0\x5d\x72\xe2\xd9\x3e\xc4\x11\x41\xe0\x5c\xbe\xf3\x9d\x0b\x78\x38\xe4\x72\xa5\x0a\xc9\xf8\x9d\xf2\x5a\xf2\xe3\xf1\xce\x8a\x4c\x3a\x36\x65\xa2\x2c\x73\xe1\x36\x87\x83\x2c\xf2\xe3\xf1\xc5\x8b\x6e\xeb\xcf\x42\x15\x9c\x96\xe6\x2f\xa7\x53\x76\xeb\xf7\x5a\x15\x6b\xb6\x32\x96\xf9\x8d\x64\x6a\x5b\x1a\xeb\xa7\xf7\xce\x14\x6c\x59\x79\x8f\xff\x7e\xc7\xb6\xb2\xa8\xd8\x74\x7a\xf5\x62\xee\xb0\x5d\x5e\xbd\x60\xec\x3b\x6f\xca\xa9\x55\xeb\x8d\x9f\x2e\x7d\xe1\xd8\x01\x6b\x8c\x6d\x85\x5d\xab\x62\x8a\x77\x97\xec\xfb\x3f\x96\x8f\x6f\xb1\x7a\xc4\xbf\xd9\x8c\x7d\x58\xad\x9c\xf4\xad\x9e\x6c\x23\xb3\x2f\x4b\xf3\xc8\x96\x32\x13\x95\x83\x62\xff\xda\xb1\xc2\x78\x26\x32\x5f\x09\xad\xf7\x6c\x27\xad\x57\x59\xf8\x29\xb4\x5a\x17\x32\x67\x0f\xca\x6f\xa2\x38\x92\xe1\xe5\xa3\x4f\xf1\x98\x7a\xf2\x7a\xda\x8a\x8c\xb6\x60\xd3\xdd\x46\x39\x96\x1b\xe9\x8a\xd7\xd0\xac\x1e\xa3\x87\xce\x55\xf2\xb2\xde\xd2\xe8\x98\x06\x0d\x97\x6c\xab\xf2\x5c\xcb\xb7\xe1\x6d\x69\x9c\xf2\xca\x60\xd5\x4a\x2d\xbc\xda\xd5\xeb\xd1\xbb\xc6\xb9\xf9\xac\xc6\x24\xe2\x79\x07\x58\x7e\x21\x58\xd8\xcf\x2d\x68\xb9\xda\xb1\x4c\x0b\xe7\x16\x1c\x58\x4d\xd7\xd6\x54\x25\x2b\x2b\xad\x23\x80\x9c\x59\xa3\xe5\x82\x87\x75\xce\x84\x55\x62\xaa\xc5\x52\xea\x05\x4f\xd3\x94\x33\x95\x2f\xf8\x10\x6d\x4e\x11\x08\xea\x6e\x42\xb8\xd8\x5f\x6f\x3f\xbc\x6f\xc2\x45\x2a\xf1\xb6\x7e\xea\xf4\x32\xd2\x0d\x12\x88\x4a\x43\xa5\xdf\x97\x50\x19\x37\x45\x15\xbd\xc8\xf3\xe0\x67\x2e\xbc\x40\x28\xd7\x6b\x32\x2e\x33\x5a\x8b\xd2\x49\x5e\x2f\x23\xd0\xd2\x2f\xf8\x77\xbd\x53\x53\xa2\x49\x3c\xea\x89\x8e\x8d\xc8\x68\x9d\x8f\xcc\xcc\x95\x95\x99\x47\x48\x55\xe1\x0d\xbb\x8e\x2c\xe5\x57\x3d\x3f\xe6\xb3\x68\x15\x10\xad\x9d\xac\x49\x6d\x4a\x8a\x86\xeb\xd8\xd8\x79\x39\xf4\xe6\xbc\xcf\x2c\xb7\xa6\xcc\xcd\x43\x51\xfb\xc4\x87\x0e\x36\x6f\xeb\x00\xc8\xc7\x52\x14\xb9\x04\x2e\x2b\xa1\xc9\xed\xda\xa5\x9d\x92\x0f\xad\x25\x44\xe6\x2d\x44\xab\x52\x4b\xe6\xa4\x86\x67\x20\x69\xf4\x34\xc4\x88\x35\xb6\xcf\x1d\xc4\x35\x86\x65\xc2\x4a\xcf\xaf\xc0\x1c\x2c\x06\x37\x5a\x97\xf1\xbb\xd2\xcd\xbe\xd6\xe0\x00\x6c\xcd\x92\xf0\x3b\xca\x9e\x6b\x75\x35\x17\x6c\x63\xe5\x0a\x91\x68\x88\x42\xee\x4c\xa3\x31\x8a\xd0\xa8\x0d\x8f\x2b\xb3\x5c\xc6\x1f\x48\x29\xdd\x5a\x7a\x17\x0e\xb1\xdb\xe6\xd0\x7c\x26\x60\x1d\xa4\xf7\xd5\x90\x74\xa0\x42\xd1\xf6\x26\xd2\xa4\x91\x9d\x99\x72\x1f\x72\x6b\x84\x01\x52\x25\x26\xbb\x56\xe5\xd2\x08\x9b\x33\xe1\x22\x1b\x08\x7a\x7e\xf5\x53\x10\x57\xeb\x95\xf9\x59\xb5\x03\xef\xc4\x7a\x6d\xe5\x5a\x78\x39\xa5\x38\x0c\x83\x42\x8a\xda\xf7\x79\xd0\xc0\xcc\xea\x9c\x59\xfc\xea\xba\xd9\xc7\x3e\xe3\x68\x5f\xef\x7c\x56\x69\xa4\xec\x0c\x39\xdb\xa4\x74\x29\xd6\x32\x6a\x8a\xe9\xbc\xf9\xc3\x55\x8c\xea\x7c\x86\x9f\x54\x5a\xbd\xdc\x96\x9a\xa4\xf1\xc8\xe3\xe8\x17\x08\xa6\x00\x34\xbf\x79\xc7\x19\x3f\xc9\x13\xc6\xaf\xeb\x00\xf1\x1e\xf9\x79\x53\xca\xdb\x55\xd1\x4b\x1f\xb6\xdc\xc3\x1a\xe7\xa9\x60\x2b\x8f\xd2\xa9\xcd\xc3\x65\x57\xcb\xef\x50\x0f\xaf\xad\x14\x2c\x29\xa0\xe5\xcf\x20\xd1\x66\xc2\x40\x5f\xbd\x14\xd9\x97\x40\xd6\x1f\x11\xa8\x37\x1f\x21\x41\x12\x34\xfd\xbc\x24\xcf\x9e\xe5\x48\x4d\x81\xbe\x23\x8d\xc5\x9f\x50\xc6\x33\x6f\xf5\x9b\x8c\x41\x57\x66\xb6\x5b\xa4\x10\x1e\xc0\x82\x96\x21\x7d\x9d\x7d\xfb\x3b\xd6\x6b\xe5\xfc\xb4\x2a\x42\x55\xcd\x03\xd3\x0f\x07\x2b\x0a\xc4\x20\x8d\xb0\x1f\x8f\x81\x1f\x87\x83\x5a\xb1\x84\xfa\x03\x7b\x95\x7e\x56\x4e\x2d\x11\xa0\x74\x52\xbf\x8d\xfc\xa9\x7f\xe2\x41\x15\x65\xe5\xeb\x3a\xd1\x34\x8a\xb6\x52\x0c\xfb\x07\x8f\xcd\x89\x72\x7f\x0f\xb2\xb4\x32\x42\xb9\x08\x7e\x87\xfd\x0b\x7e\x38\xa4\xb7\xde\x22\x18\xc7\x23\xef\xa9\x6a\x38\x7b\x38\x54\x56\xdf\x99\x60\x34\x4b\x6f\x91\xeb\xe9\xcd\xbb\xe8\x03\x1d\xa0\xd3\xc3\x35\xe2\xe1\x8b\x4e\x4e\x07\xc9\xa0\xa2\xb4\xde\x0d\xde\xc6\xfc\xa0\x82\x32\xed\x09\xa6\xff\x07\xc6\xb5\xc0\xa5\xef\xc5\x56\xb6\x58\xd5\x32\x9d\xb7\x06\xd4\xaa\xb3\x0a\x72\x6e\xde\xd5\x96\xc6\xdd\xd4\xeb\x68\xc7\x58\x9e\x44\x85\x7c\xbe\xac\xd6\xae\x27\xc5\xc5\x91\xe5\xd4\xe6\xe0\xd6\x75\x81\x29\x41\x84\x02\x3c\xd6\xe9\x05\x71\xa0\x81\x25\x3c\x84\xbf\xd3\xcc\xa0\x96\x17\x8e\x2a\x40\x78\x86\x5e\x55\xd6\xec\x1a\xaa\x89\x4c\x4b\x56\x4a\x7b\x69\x7b\xaa\x4e\x95\x4f\x46\xda\x3b\x33\x63\xee\x88\xc2\x9f\xd9\x41\x56\xda\xab\xb9\xdf\x10\x12\x7f\x93\x7b\x42\x01\x0f\x73\x9f\x63\x01\x66\xb1\xf4\xb3\xd0\x55\xc0\x1a\x4b\xf8\x63\xc7\x36\x76\x08\x7d\x7b\x15\xe7\xc9\xdf\xf3\x00\xf7\xb7\x0d\x72\x25\x94\xc0\xf1\x9b\xee\x54\xf3\x2b\xee\xc3\x4c\x98\x01\xcc\x26\xb7\x68\x26\x9b\xdd\x8b\x9d\x88\xab\x01\x61\x4c\x62\x3f\xa8\x22\x47\xa2\xb8\xb3\x73\x26\x95\x11\x9a\xe3\x92\x55\x55\x84\x9a\x98\x4c\xba\x19\xee\xa6\xc0\x10\x86\xe9\xec\xbf\x92\xea\x88\xd8\x19\x95\x33\xb7\x31\x0f\x54\x03\x71\x76\xa5\xac\xf3\x2c\x6d\xc6\x93\x84\x6f\x54\x2e\xf9\x84\x51\x5d\x48\x83\x8c\x57\x09\xff\xee\xa4\x68\x4d\xba\x13\x87\xd8\x32\x2f\x59\x68\xf4\xc7\xc9\xdb\xf6\xd4\x49\xcd\xfe\xea\xa9\xc6\xe0\x7f\x6c\x64\x11\x5c\x1c\x2b\xc5\xe4\x19\x2c\x2f\xd8\x83\x64\x0f\xa0\x07\x39\x44\xe6\xf6\x00\x61\x2d\x20\x8d\x38\x67\xa8\xd4\x7b\xf1\x05\x05\x53\x79\xc7\x50\x9f\x33\xf9\x55\xcf\x80\xdf\x6b\xd2\x93\x2e\x5d\x6b\xef\xeb\x0b\xd6\x80\xcb\x5a\x74\x9f\xe3\x67\x8d\x67\x04\xe5\x38\x69\xac\xba\x2e\x72\xb6\x53\x28\x9a\x18\xa0\x9d\x68\xa3\x6a\xf0\xc7\xd6\x83\xe8\xe5\x39\x1c\x49\xb4\x56\xd9\x97\xd3\x50\x3f\x27\x54\x23\x63\x3a\xcc\x3f\x95\x34\xea\x1a\x34\x2f\x19\x5c\xac\xfb\x7e\x8d\x29\xac\xdb\x5e\x10\xe8\x1f\x3f\xdc\xde\x8d\xba\x50\x9c\x13\x30\x8f\x7b\xd3\x08\xa3\x0d\x7c\x16\x6b\xee\xac\x2a\xb5\x11\x39\x67\x9f\x7e\xf9\x3b\x43\x37\xa3\xab\x00\x9e\xc3\x9e\x38\x17\x18\x34\x47\x87\xa0\xc4\xf6\x56\xd0\x20\x62\x07\x11\x1a\xa3\xcb\x52\x11\x1b\xff\x57\xa0\xa0\xbb\x8b\x55\x5b\xf6\xb0\x51\x5e\xa2\xa8\x67\x41\x11\x2a\x58\x65\x23\x5b\x70\x63\xb2\x61\x14\x40\x49\x73\x66\x2b\xfd\x86\xf2\x21\x29\x75\xe5\x2e\xea\x91\xc7\x22\x34\x9d\xb8\xe6\x16\x44\xb3\x27\x13\x4b\x83\x66\xd8\x09\x9f\xa4\xf5\xc6\x9d\xb0\x11\x90\xc5\x13\xa6\x53\x7a\x43\x82\x80\xf1\x3b\xa1\x93\x3a\x14\x8c\xa9\x55\xf2\x32\x1c\xfc\xf5\xd7\x20\x00\x57\x32\xb5\x4d\x26\xa9\x96\xc5\xda\x6f\xd8\x62\xc1\x7e\xdf\x0f\xb4\xd0\xb8\x77\x25\xfc\xa3\x96\x82\xae\x7e\xa1\x37\x0b\x68\xd7\x48\xee\x10\x9b\xd0\x11\x5f\xf2\x56\x3e\x03\xf0\xbe\xb2\x45\xf3\xdc\xb6\x87\x10\xfc\x36\x24\x01\xfa\x0b\xec\x5d\x59\xe9\x02\x24\x21\x48\xd5\x90\x1e\x8d\xb7\xaf\x52\x5c\xf1\x60\xc6\x28\xd6\x17\xc1\x83\x49\xab\x39\xcd\x4d\x21\x07\x51\x62\xda\x64\xa1\x09\xa4\x91\x0e\x58\x3b\xf6\xf6\xaf\x84\xd2\xdd\xfe\xc7\x8d\xbd\x08\x57\xd5\x5b\x34\x0e\x0a\x8f\xb4\xd6\xd8\xbb\x8d\x45\x25\xe8\x63\xd2\xa2\x12\xde\x5f\x32\xce\xde\x30\x9c\x85\x0a\x07\xf3\x9d\xa4\xe9\xae\x87\x47\xab\xf0\xd8\xe6\x43\x12\x33\xe2\x5c\xb9\xf5\xa7\x57\xa8\x27\x2b\x6e\x3b\x2d\x47\xc8\x1d\x78\x8f\x3b\x91\x05\xc3\xeb\xb4\x2a\x85\xa5\x56\x3a\x4e\x22\xd2\x25\x45\xb6\x69\x05\xb4\x09\xd5\x25\x04\x11\xac\x95\xbf\x60\x67\xa8\x4f\x3b\x6a\x6b\x17\xec\x5f\xff\x6e\x1c\x06\x1d\x47\xd7\x7c\x50\x90\xb4\x75\x2e\x28\x40\xdb\x07\x14\x9c\x7c\x95\x20\x31\xdc\x24\x2d\x71\x93\x4a\x78\x3d\xd6\xf1\xc9\x10\x76\xd2\x78\x1f\x18\x1f\x37\x0b\xef\x6d\xc2\x47\xd3\x5e\x9f\x8a\xac\x36\x30\x2d\x2b\xb7\x49\xc0\x22\xc2\x83\xd0\x48\xee\x27\xfd\x08\x8d\x02\xd4\x70\xb8\x3e\x5d\x47\xad\xad\x61\xa3\xcb\x50\x5d\x45\x3b\xd8\x62\x61\xbc\x33\x01\xf6\x45\xa8\x34\xff\x94\xd6\xfc\xd8\xdc\xad\x92\x5e\xf5\x6c\x2e\x68\x8d\x39\xfd\xb3\xd4\x1f\xc2\x8d\x8d\x77\x3d\x21\x91\xc3\x00\x20\x44\x50\xd1\x04\x6a\x90\xe6\x58\x7c\x2a\xab\xc7\x29\xda\x66\xe8\x7b\xe3\xd1\x29\xbf\xa7\x06\x18\xca\x32\x0d\x63\xa1\x4e\x6b\xb9\x93\xba\x49\xc7\x81\x91\x4e\x7a\x22\x7c\x12\x1f\xc2\x94\xad\x56\x7b\xd2\x7e\xc1\x8a\x4a\xe3\xef\xf7\x1d\xd6\x31\x71\x7a\x96\xbd\x41\xf6\xf4\x6f\x1a\x70\x57\xd1\xf0\x67\xba\xab\x68\xca\x27\x27\x6d\xe4\x03\x88\x5e\xec\x87\xb0\xc6\x74\x45\x69\x45\x41\x13\x56\xe9\x3d\xea\x26\x1a\x7c\xb8\x5d\x91\x43\xe1\x93\xd5\x0e\x19\x4f\x83\xd6\x04\x5d\xa6\x11\xd6\x5e\xbc\xa0\xb5\x72\x54\x8b\x42\x5d\xc6\x5c\x98\x93\xd8\xa6\x92\xa6\xe7\x03\x14\xb4\x3e\x11\xa1\xc1\x66\x5c\xe8\x31\x44\xef\x93\x49\x97\x28\x27\x2c\xf8\xbf\x7a\x2e\x8d\x12\xbc\x01\xe9\x5b\x04\xf9\x16\x45\xc6\x24\xe9\x68\x72\xde\x92\x93\x8e\xf3\x2c\x3e\x3c\x43\xd6\xca\x64\x95\x43\x83\x8a\x2e\x74\x0e\x1c\xcf\x4c\x17\xe3\xcf\x23\x27\xa9\x59\x17\x16\x20\xe2\x6d\x25\xbb\x01\xf2\xe4\x63\xcc\x49\x24\xfa\x51\x45\x95\x42\x3a\x14\xfe\x5d\xfc\x5e\xd5\xd9\xd4\x89\x7f\x59\xff\xfc\x6a\x55\x1c\x16\xbb\x8b\xe6\xf8\x19\xc7\x86\x9f\x41\x06\x6e\x91\xf9\xa3\xaf\x2d\xbf\xcd\xf8\xf3\x6c\xe9\x6a\xc3\xcd\x0a\x39\xf3\x7a\xd7\xfb\x48\x03\x39\x76\x1f\x06\x9a\x8b\x66\xde\x97\xa1\x9d\xd1\x88\x80\x57\xe8\xbe\xf8\x4d\x03\xd9\x7f\x2a\x3c\x76\xa2\x50\x87\x71\x4d\xf5\x34\x81\xee\xd9\x7d\x85\x7b\xc1\xda\xd0\x31\xd0\x45\x84\x4f\xb2\xc8\xc4\x59\xeb\x14\xcd\x3f\xd9\xe6\x82\xf6\xd6\x5f\x08\x2f\xc2\x78\xee\x3a\x81\xe3\xcf\x49\xd4\xe1\xba\xef\x66\xe9\x53\x45\xf1\x6c\x54\xe2\xeb\x7e\x2e\xe0\x0a\x93\x63\x4a\x6f\x67\x09\xfa\x6a\x00\x98\x70\x2f\xfc\x01\x53\x11\xfc\x6b\xbf\x2e\x50\x3d\x6b\x6d\xe1\xcf\x99\x89\x6e\x81\x58\xec\xd5\x56\x66\x32\x80\x17\x86\x5f\x2b\x81\x19\x80\xa1\x0f\xe9\xe1\xfd\xcd\x3b\x47\x93\x31\x4d\x85\xaa\x00\x74\x32\x8c\x94\xaa\xe8\x44\x51\xec\x63\x2c\xa2\xc8\x82\xfd\xe5\xa7\x38\x45\xf7\xb0\xa4\x31\xab\x3f\x44\xaa\x7c\xd4\xbe\x63\xaf\x0e\xe9\xda\x6f\xd8\x01\xa6\x41\xd3\xce\xeb\xb6\x1a\x87\x86\xe6\xe3\xc8\x49\x7e\xfe\x66\xf8\xfe\xd4\x66\xe3\x82\x26\x2c\xd2\x77\x0f\x96\x24\xbd\xf4\x68\x66\xa9\xf9\x2c\x5e\x62\xc3\x37\xbe\x78\xe7\xfd\x5f\x00\x00\x00\xff\xff\x29\x3a\x44\x0c\x72\x19\x00\x00"),
-			uncompressedSize:  6514,
+			modTime:           mustUnmarshalTextTime("2026-10-17T12:00:00Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x3a\x69\x8f\x1b\x37\x96\xdf\xf5\x2b\x9e\x19\xc3\x2e\xad\xa5\x92\x63\x60\xbe\x74\x24\x05\x4e\xec\x09\x7a\x37\x63\x7b\xdd\xed\x2c\xb0\x59\x2f\xc0\xae\x7a\x92\x68\x53\x64\x85\x64\x49\xad\xd5\xe8\xbf\x2f\x1e\x8f\x3a\x74\xb8\x9d\x03\xf3\xa5\xbb\xc4\x7a\x7c\xf7\x49\xd6\x7e\x5f\xe2\x42\x28\x04\x76\x2b\x9c\x44\x76\x38\xdc\x1a\x5e\xa0\x85\x31\xf0\xaa\x2a\xb9\x5d\xed\xf7\xa8\xca\xc3\x61\x30\x68\x41\xff\xc1\x85\x62\xb4\x34\x7d\x34\x1e\xc3\x8d\xdb\x49\xa1\x96\xb0\xd0\x06\xdc\x0a\x41\xac\x2b\x6d\xdc\xf8\x93\xd5\x0a\xee\x6a\xe7\xb4\x82\x27\xb0\x46\x55\xc3\x78\x3c\x1f\x4c\xad\xdb\x49\x9c\x0f\x00\xbe\x71\xba\x1a\x1b\xb1\x5c\xb9\xf1\x9d\x53\x16\xf6\x03\x00\x80\x35\x37\x4b\xa1\xc6\x4e\x57\x57\xf0\xe2\x6f\xd5\xfd\x77\x03\x80\xc3\x00\x60\x32\x81\xb7\x8b\x85\x45\xd7\xd0\x29\x56\x58\x7c\xbe\xd3\xf7\x70\x87\x05\xaf\x2d\x82\x70\x4f\x2d\x28\xed\x80\x17\xae\xe6\x52\xee\x60\x83\xc6\x89\xc2\x3f\x72\x29\x96\x0a\x4b\xd8\x0a\xb7\x0a\xe8\x08\x87\xc3\x7b\x97\x0f\x00\x72\xe7\xa5\x1e\x5b\xe4\xa6\x58\xf5\x59\xb9\xd3\xce\xe9\xf5\x15\xbc\x78\xde\x72\x13\xe0\xc7\x0d\x0b\x61\xc3\x64\x02\xb7\x2b\x61\xa1\xd4\x68\xd5\x53\x07\x0b\x71\x1f\x34\x62\x6d\x8d\x57\x11\x24\xf1\x34\xf6\x1c\x5d\xc1\x5a\x94\xa5\xc4\xef\xfc\xdb\x4a\x5b\xe1\x84\x56\x57\x60\x50\x72\x27\x36\x71\x3d\x68\x23\x91\x9f\x4e\xa2\x0e\x83\xfe\x6f\x75\x35\x7e\x4f\x6a\x84\x7f\x34\x4a\x2e\xc5\x06\x0a\xc9\xad\x9d\xb1\x3b\xa7\xc6\x4b\xa3\xeb\x0a\xaa\x5a\xca\xa0\x70\x06\x46\x4b\x9c\x31\xbf\xce\x80\x1b\xc1\xc7\x92\xdf\xa1\x9c\xb1\x3c\xcf\x19\x88\x72\xc6\xfa\xd6\x61\x64\x31\x4f\xee\xda\x9b\x17\xfe\xfd\xe6\xed\x9b\x64\x5e\x22\x09\x30\x8d\xbf\x5a\xba\x40\xb4\x4b\x5c\xf0\x5a\x3a\x06\x6e\x57\xe1\x8c\x05\xa0\x40\xa2\xe3\x29\x6c\x00\x00\x50\x72\xc7\xc7\x4e\x2f\x97\xc4\x5c\xa1\xa5\xe4\x95\x45\x16\x97\xb9\x59\xa2\x9b\xb1\x6f\x3a\xbb\xc6\xe4\x56\x61\xab\x23\xf7\x4d\x28\x03\x77\xc1\xa6\x50\x0a\x83\x85\x93\x3b\x10\xca\x69\x78\x19\xbc\x9a\xcd\x3b\x72\x4c\x27\x81\xab\xf9\x20\x09\x19\x83\x40\x57\x64\x0d\xdb\x7a\x6f\x2b\x65\x5f\x9a\xf3\x32\x43\x69\x74\x55\xea\xad\x8a\x32\xb1\xbe\x80\xe9\x6d\x34\x00\xde\x57\x5c\x95\x58\xce\xd8\x82\x4b\x12\x3b\x8a\xb4\x11\xb8\x6d\x38\x21\xe7\x5f\xd7\xd2\x89\x4a\x22\x58\x94\x58\x38\x2c\xa3\xa4\xde\x46\x90\x78\x9f\xda\x8a\x37\xc6\x28\xb8\x41\xc7\xe6\xd3\x09\x2d\x7a\x31\x1a\x91\x01\xa6\xb5\x4c\x70\x0d\xc3\x5e\xb1\xd1\x4b\xfc\x73\xc0\x3d\x95\x62\x3e\xe5\xb0\x32\xb8\x98\xb1\x6f\x92\xa3\x90\x38\xe3\xc0\x8c\xd0\xaa\x61\x3c\xac\x4c\x4a\x0c\x0f\xc0\xa5\x6c\x38\xbd\xf5\x9b\xe0\x26\x6d\x9a\x4e\xf8\x7c\x3a\x91\xa2\x47\x86\xb0\xe3\xbd\xb7\xb6\xd3\xc1\x4d\x12\xee\x42\x57\x3b\x1f\x5b\x47\x3a\x00\xa7\xfd\x72\x21\x45\x75\xa7\xb9\x29\x81\xdb\xe0\x0d\xa4\x7a\x36\x7f\xed\xd1\x45\xba\x58\x9e\x25\xdb\x93\x8e\x2f\x97\x06\x97\xdc\xe1\x98\xec\xd0\x37\x0a\x11\x6a\xde\x97\x9e\x02\xe8\xc5\x39\xb6\xd8\xfc\x65\x82\x83\x5f\x04\x6e\x1f\xa6\xbb\x90\x7c\x8d\x4b\xc3\xab\xd5\x19\xc2\x1c\xfc\x6b\xf0\xef\x2f\x92\xfc\xbb\x87\xf9\x89\x60\x1e\xa6\x57\xe8\x75\xc5\xcd\x91\x94\x71\xd1\xa3\x77\x5b\x7d\x4c\x02\xac\x28\x11\xee\x76\xfe\x3f\x9b\xff\x18\xa1\x2f\x2a\xb7\xf1\x32\xb1\x11\x25\x1a\x76\x89\x25\xa1\x88\xb0\x44\xd7\x06\x81\x14\xd6\x05\x36\xa2\x99\x57\xdc\x01\x11\x5b\x0b\x6b\xa9\xfa\x90\x63\x5b\x36\xbf\x6e\xb6\xc6\x38\xe8\x32\x31\x9d\xd4\xb2\x8d\xf1\x1b\x72\x84\x73\x11\x7e\x2e\x79\xf6\x33\x66\xe4\xf9\x5f\x9e\x0a\x8a\x95\xd6\x36\x98\x43\x9b\x12\x0d\x08\x05\xdb\x95\x28\x56\x49\x2d\xa4\x11\x52\x15\x96\x91\x47\x08\x62\x7e\x39\x19\xf4\xd3\xc1\xe5\x84\x00\xbd\x5f\xfd\x52\xd2\x49\x12\xde\x9a\xfb\xbd\x58\x00\xfe\x06\xb9\xa7\xcf\xac\xe3\xc6\xb1\xc3\x21\xe1\xe5\x05\x55\x37\x16\x9b\x8b\x9e\x37\x46\x08\xeb\xc3\x3e\xb8\x72\x50\x16\xad\xcc\x22\xa6\x26\xc7\x10\xf6\x00\xe5\x1d\x91\x5e\x82\x13\x6b\x64\xf3\x1b\xff\x7c\x2b\xd6\xd8\x77\xc4\x73\xec\x95\xb5\xe1\x3e\x77\xfd\x15\x1c\x36\xc8\x2e\x30\xd9\xbc\x9f\xbf\x8a\x4f\x0f\x33\xa8\xf8\x1a\xff\x12\xe6\x3c\xa2\x0b\x8c\x19\xad\x9d\x0f\x24\xf0\x50\xf3\x37\xfc\xac\xee\xbe\x10\xc6\x2d\xeb\xf9\x2b\xb4\xc5\xef\x66\xd8\x7b\x75\xe4\xd7\x3f\xcf\x58\x89\xb6\xe8\x33\x2c\x14\xd0\x22\xaa\x92\x02\x3f\x6c\x99\xbf\x6a\x56\x2e\x29\x53\x69\xf7\xd7\x71\xc5\xcf\x30\xc5\x8f\x79\x7a\x79\x9e\xa5\x90\x86\xe8\x7f\x29\x36\xf3\x41\xfc\x17\x9a\xb9\x8a\x2f\x31\xe0\x0d\x8d\xdc\xea\xdb\x79\xca\x63\xab\x6f\x13\xd0\x4d\xe8\x52\xa9\xf1\xf4\x40\x0b\x6d\xd6\x89\xe1\x5e\x23\x9b\x82\x33\xfd\x5a\xa3\x5b\xe9\x72\xc6\x7e\x7a\x7d\xcb\x80\xfb\xc2\x9b\x76\xb0\xe3\xd4\x27\x54\x55\xbb\x71\x2f\xdf\xf9\xa5\x98\xee\xa8\x73\x6e\xb4\x44\x0c\x8c\x0b\xad\x9c\xd1\x92\x79\xe7\x99\xb1\xdf\x18\x6c\xb8\xac\x71\xc6\xf6\xfb\xfc\x3f\x6b\x34\xbb\xc3\x81\x01\xaf\x9d\x4e\x19\x7a\xc6\xf4\x62\xc1\xa2\x95\x2a\xc9\x0b\x5c\x69\x49\xda\x7d\x8a\xf9\x32\xf7\x68\xae\xd8\x0d\x9a\x0d\xc2\x84\x57\x62\xf2\x6f\x0c\x5e\xbe\x79\x05\x7e\xc5\xe4\xef\xd1\x56\x5a\x59\xcc\x6f\x1c\x77\xb5\xfd\x51\x97\x38\x9f\xfd\xed\xf9\x73\x0f\x93\x42\x6c\xfe\xe2\xf9\xf3\xb5\x7d\x1a\x69\x34\x7d\x89\x57\x5f\x27\x6b\x24\x97\x1f\x35\x1b\x41\x1b\xe0\x6a\x07\x5c\x29\xed\xc2\x4a\x56\xe8\xf5\x9d\x50\x08\x0e\xcd\xda\xfa\x41\x82\x68\x8d\xe0\xed\x7b\xe0\xaa\x84\x37\x6f\x6f\x87\xe7\x34\xb5\x12\x65\x89\x2a\xa9\x85\x9c\xa5\xab\x19\x0a\xef\xc3\xe1\xe1\x7d\xd1\x07\x9b\x8d\x6d\x88\x51\x2c\xec\xf7\x28\x2d\x1e\x0e\xdc\x16\xd1\x99\x13\xc6\x6e\xe2\xef\x98\x94\x9a\xfa\x36\x5d\x7f\x55\xe7\x6e\xeb\xbb\xb5\xa0\xba\xd1\x24\x80\x05\x87\x05\x4f\xae\x36\x9f\x4e\xc4\x3c\xba\xe6\x51\x39\xe9\x74\x9d\xd1\xe3\xc9\x61\xe6\x34\x52\x1a\xae\x96\x48\x49\xae\x36\x05\xbe\x36\xc6\x1e\x0e\xbd\xe9\x85\x4b\x34\x0e\xfc\xdf\xf1\x96\x1b\x25\xd4\x32\x39\xb5\x5f\x64\xf3\x1b\xbd\x6e\xba\x82\x42\xd7\xb2\xf4\x81\x7e\x87\x20\x35\x2f\xb1\xbc\x82\xfd\x3e\x3f\x1c\x22\xe5\xce\x30\xeb\xed\x17\x1c\xf3\xb5\x31\x5f\x20\x5b\x12\x8b\xe6\x88\xea\xb5\xda\x70\x29\x4a\x08\xc2\xc3\x6f\x84\xe6\x84\x94\x37\xc9\x00\xc0\x5b\x8b\x5c\x24\x90\x83\xcc\xa7\xa2\x10\xd7\x43\x0f\x31\xad\x9a\xf8\xc5\x7b\x37\x5e\xd7\xbe\x82\xbf\xd1\x49\xb0\x35\x77\xc5\x2a\x76\x79\x2d\xc1\x7c\x3a\xa9\xe6\x1e\x7f\x90\xaa\x23\x9d\xc3\x75\x25\xb9\x43\x60\x61\xce\x09\x7d\x2f\x83\x52\x14\x0e\xd8\xf5\x2b\x06\xec\x64\x8e\x02\xf6\x32\x36\xf0\xac\x33\x1c\xb1\x74\x34\xd0\xac\xf2\xce\x78\x45\xe1\x53\x71\xeb\x28\xeb\x09\x52\xbb\xd4\xdb\xab\xf6\x6c\xe0\x16\xef\xdd\x4b\x83\x9c\x44\x56\xe3\xbf\x4b\x6e\x57\x43\x58\x70\x29\xef\x78\xf1\xd9\x0f\x33\x3f\xea\x6a\xf7\xec\x1d\xb7\x0e\x41\x2f\x7a\x73\x1b\xa5\xb6\xaf\x12\x04\xef\x4f\x04\x49\x1c\x7f\xb0\x08\x85\x33\xf2\x59\x01\xda\x40\xa1\xd7\x6b\xae\xca\x67\x05\x38\x0d\xcd\x04\xd1\xa5\xd9\xe5\xbf\x6d\x82\xa8\xa3\x1a\xd7\xca\x4f\xdd\xa1\xb1\x6a\x1c\x37\x18\xd1\xdb\x30\xda\x39\xa3\xf3\x06\x78\x9c\xff\x22\xac\xb8\x93\x08\xf9\xf0\x70\x68\x2b\x51\x0a\xb9\xa3\x58\x4f\x07\x09\xac\x97\xc6\xc7\x9d\x65\x7a\xa2\x86\x70\x87\x96\x35\x38\x7c\x45\xf2\x72\x7b\xf8\x90\x50\x9c\x11\x6a\xd9\x24\x00\x4f\x2a\xd5\xb5\xfd\xbe\x36\xf2\x56\x7b\xa6\x21\xbf\xa9\xb8\xca\xaf\x5f\x05\x19\x68\xc3\x7e\x7f\xbc\x46\x85\x6b\xd0\xe2\x69\x55\xd2\x9b\x38\x4f\x3a\x03\xff\x36\xcc\x15\x14\xfa\xe3\x0e\x62\xfa\xdf\x63\xae\x51\x5c\x4e\xed\x46\xa3\xab\x88\xd3\x3a\xa3\xd5\x32\x65\xee\xfd\x3e\xbf\x7e\x15\x39\x0d\xd0\xd3\x49\x80\x38\xc6\xd7\xc4\xde\x57\xe1\x6a\xf8\xba\x88\x2e\xc4\xd5\x29\xcf\x5e\xac\x97\x4d\x91\xb0\xc7\x34\x1d\x27\x1f\x48\x6a\xf1\x3f\xfc\x5f\xaa\x96\x25\x2a\x8b\x65\xfc\x6d\x9d\x11\x55\xa7\x6d\x6f\xc9\x04\x4f\xcb\x16\x42\x3a\x34\x1d\x52\xa7\xc4\x87\x47\xd4\x5b\x36\x43\xec\x70\xe5\xce\x40\x10\x97\x66\x3e\x75\x2b\xd2\xc4\x7f\xe0\x8e\xb4\xe0\x56\xf3\xa9\x2b\xe7\xfb\xbd\x75\x06\xf2\x5f\xa8\xe8\xf8\xe5\x72\x3e\x9d\x38\x33\x3f\x43\x25\x68\xe8\xe1\xd5\xe9\xc4\xcb\x7b\x5e\xc1\x5d\xb0\x5e\xac\xc4\x8e\xa9\xff\xa6\xdd\x95\x9e\x02\xdc\xc0\xcb\xac\x0d\xe4\xef\x0c\x6e\x3e\xbc\xff\x19\xf2\x37\x78\xef\x3e\xbc\xff\x99\x40\x14\xdf\x1c\x1d\x7a\x50\xcf\x65\x58\xc2\x19\xea\x42\xdc\x79\x38\x74\xbc\xba\x32\xb8\x11\xba\xb6\x6c\xde\x09\xa8\x9c\x5c\xe8\x89\xe4\xc6\x7c\x07\xef\x22\x40\xd3\xee\x75\xe5\x4a\x98\x1b\x56\x3a\x98\x15\x35\x53\x27\x58\x09\x12\x9e\x18\x42\x7d\x06\x63\x90\x75\x3a\xf1\xf2\xa4\xe5\xc1\xd4\x16\x46\x54\xdd\x26\x6d\xf2\x89\x6f\x78\x58\xf5\x32\x4e\x26\xf0\x83\xf0\x5d\xa9\x3d\x7b\x64\x4b\x19\x34\x1f\x00\x64\x8b\x5a\xf9\x72\x90\x0d\xdb\xe3\xcd\x6b\x25\x9c\xe0\x52\xfc\x1f\x82\xd3\xc0\x37\x9a\x4a\xe0\x4a\x6f\x7d\xd3\xab\x60\x21\x8c\x75\x90\xa7\x93\xbb\x8c\x7a\x18\x64\x43\xa0\x94\x98\x7b\x1c\x8f\x33\xf6\xcd\x49\xbe\x1e\xb6\x3b\xf6\x61\x22\xbe\x02\x3f\xf8\x1e\x86\xdf\x35\xbb\xc4\xfa\xf7\xec\x4a\x0c\xff\xd7\x0a\x95\x17\xf1\x98\x28\x08\xeb\x39\x57\xb0\x45\xd8\x72\xe5\xc0\x69\x20\x76\x3b\x0a\x81\x46\x21\x09\x9d\xd5\x20\x1c\x38\xfe\x19\x2d\x08\x67\x43\xd7\xfa\x45\xc9\xb4\xca\x9e\x12\x9d\xfc\xce\x36\xfc\x3e\x1d\x41\x52\x2e\x34\xda\xfd\x1a\x39\xa3\x3e\x83\x52\x0e\xc3\xc4\xd5\x4b\x55\xc2\x46\x14\x38\xde\xa0\xb1\xbc\xb1\xaa\x76\x2b\x34\xf1\x8c\xf6\xea\x9c\x1e\x09\xb5\x14\xc5\xe7\x53\x53\x7f\x8d\xa9\x8e\x98\x69\x75\xfe\xa1\xd2\x0a\x62\x93\xef\x5b\xe9\x45\x57\xa7\xd4\xfa\x8d\x48\xe9\xef\xde\xde\xdc\x1e\x15\x60\x5f\xd0\xa0\xae\xc0\xe9\x84\x8c\x00\xd8\xc4\xbf\xb5\x93\xba\xa2\xa6\x8e\x01\xc5\x34\xf5\x53\x06\xe9\xb7\x87\x09\x83\x93\x86\x52\xd8\x4a\xf2\x50\xd9\x15\x6e\x03\xde\xfc\xa2\x17\x41\x1e\xc6\xa0\x2f\xa9\x82\x8e\xf5\x8d\x58\xd3\x49\x8b\x43\x5b\x11\x9f\x4e\x03\x2a\x5b\xc7\xa3\xb1\xda\xa2\xf1\x5d\x10\x96\x60\x35\x0d\x59\x14\x0f\x59\x25\x6b\x3b\x8a\x4d\x1b\x8d\x2c\x2d\xba\x74\x41\x40\x27\x31\xc0\xef\x74\xed\x3a\xc8\x87\x79\x04\xdc\x70\x13\x14\x32\xbb\xc0\x3a\x85\x37\x37\xc8\xd9\x30\xdf\x70\x99\x45\x53\x00\x88\x45\xf6\xc8\x6f\xfc\xe7\x3f\x3d\x82\xdc\x19\xb1\xce\x86\xb9\x44\xb5\x74\x2b\x98\xcd\xe0\x79\xd7\xd0\xbe\xa5\xcd\xd8\x3b\x89\xdc\x22\x84\xb6\x84\x43\xe8\x6f\xbd\x6d\x7c\x33\xf0\x88\x35\xf8\x01\x0c\xba\xda\xa8\xf4\xbb\xa9\x8c\xde\xf8\x8d\x49\xbc\xea\x47\x60\x70\x61\xd0\x7a\x95\x78\x23\xd5\x7d\xf7\x48\xd2\x3e\xce\x2b\x6d\x5d\x76\x6c\xeb\x91\x97\x60\xd8\x50\xce\x4b\xad\xb0\x67\x25\x90\xba\xf0\xf5\x2f\x0f\xee\x90\x0d\xe1\xd0\x81\x5f\x70\x21\x5b\xf8\xfb\x95\x19\xf9\x5b\x9f\x30\x35\x8e\x00\x8d\xd1\xe6\x76\x65\xf4\x56\x75\x75\xd2\x68\xc5\xbf\xbf\x02\x06\xcf\xe0\x7e\x65\x72\x13\xa7\x4e\x6a\x6c\x3b\xfa\x68\x08\x1e\x9a\x78\xc8\x42\x44\x9c\x4b\xb7\xee\xf4\x76\xe1\x62\xc6\x6d\x8e\x5c\x83\xca\x2d\x70\x05\xdc\x18\xbe\x4b\x61\x55\x71\x63\xb1\x3c\x09\x22\xa2\x85\xbc\x58\x35\x08\x9a\x80\x6a\x03\x82\x1c\x2c\xbd\x86\x19\x2c\x4e\x5d\x9f\x20\x22\xb7\x33\xf8\xf5\x63\x12\xf8\x71\xc6\x8e\x6e\xc0\xd8\x30\x27\x6a\xad\x08\x62\x04\xd8\x55\xa8\x58\x64\x8f\x33\xb7\x12\x76\x98\x57\x46\x57\x19\x8b\x1d\x2d\x1b\xf6\xd5\x4e\x14\x3f\x79\x8f\x0f\xc0\xdc\x39\x93\xb1\xa3\x46\xb7\xeb\x8a\x10\x19\xcc\xab\xda\xae\xb2\xc7\xb9\xd7\x07\x69\x23\xfb\x34\xec\x5a\xe8\xc8\x40\xc9\x87\xe3\xee\x68\xb5\x26\x87\x1d\xdd\x13\xc4\x2c\xda\xaa\x2d\x24\xc6\x5b\x4d\x84\x60\xe6\x33\xcd\x7f\xa3\xd1\x3f\xa6\x6b\x87\xac\x93\x3d\xd3\xdd\x45\x62\xa7\xbb\x97\xea\x83\xbf\xcc\x60\x6d\x4d\xc8\xb0\x6f\x00\x8b\x12\x66\x8d\xa1\x7a\x61\x6e\x51\x5e\x8a\xea\xe3\x10\x6d\x22\xf4\x8d\x76\x78\x05\x2f\x40\xd8\x90\x96\xa9\x0f\x25\xb2\x20\x71\x83\x32\x85\x63\x8f\x49\x8b\x8e\x1c\x3e\x0b\x3f\xfc\x80\x21\x16\x3b\xa2\x3e\x02\x55\x4b\x39\x82\x17\xad\xae\x43\xe0\x74\x38\x7b\x06\xac\x37\x64\x15\xba\x12\x58\xfa\x19\x2c\xa9\x2b\x67\xc3\x93\x32\xf2\x56\xf9\x13\x98\x9e\x5a\x43\xb8\x42\x56\x19\xb1\xe6\x46\xc8\x1d\x6c\xa9\xc0\xfb\xc1\x92\x04\xf2\xb7\xbf\x1b\x2e\x24\xf5\x98\x43\xd8\x62\x42\xd6\xcc\x9c\x4e\x43\xed\x2f\x0c\x7c\x5e\x76\x5c\x95\x84\x36\x65\xd2\xfc\xbc\x81\x3c\xd5\x0b\x16\xea\x01\x97\x48\xf3\xc3\x2e\x1b\x0e\x4e\x6a\x68\xe3\x05\x7f\xaa\xe6\x52\x2b\xc1\x92\x92\x1e\x72\x90\x87\x5c\xe4\xd8\x49\x5a\x37\x39\xcf\xc9\x49\xc5\xf9\x2a\x7f\xf8\x0a\x5c\x0b\x5d\xd4\x36\x1b\xe6\x41\x84\x56\x80\xc3\x99\xee\xe2\xf8\xe6\xf0\x24\x34\x63\x62\x81\x19\x38\x53\x63\xdb\x40\x9e\xdc\x53\x9e\x58\xa2\x6b\xd5\x9c\xba\x7d\x54\xee\x55\x38\x04\x6b\x79\x6a\xd1\x3f\x8a\x8f\x5f\xcc\x8a\xfd\x64\x37\x4a\xdb\xcf\x08\x46\x37\x6f\x4d\xbe\x59\xea\xf6\x2e\x73\x29\x36\xa8\xda\x8b\x46\x0f\x08\x99\x3f\x23\x6d\xaf\x27\xd9\x30\x16\x84\x93\xc2\x11\xd3\xa3\x5f\x4f\xa2\xf6\x88\x65\x54\x96\x1f\x4a\x39\x6d\xfe\xb8\x5e\xc0\x16\x9f\x6e\x3a\x17\x8e\xb8\x41\xb3\x8b\x4d\x0f\xb5\x65\xd4\x77\x22\x08\x0b\x5a\xc9\x1d\x68\x15\xbb\x33\xbd\x88\xbc\x0c\x47\x2d\xb6\x38\x43\xc4\x83\x73\x0e\x84\x0a\x24\xcd\xe9\xd4\xe4\xf9\x63\x2e\x2a\x71\x7c\x8d\x8e\x9a\xd9\x1d\x7c\xaa\xad\x83\xa5\x26\x68\xeb\x0c\xf7\x1f\x3e\x38\xdd\x22\x24\x8d\x79\x0d\xf9\x8b\xb1\x11\x6d\x89\x07\x99\x23\xdf\xf0\xdb\x93\x2b\x5b\x2a\x95\xed\xdd\x74\x7e\x29\xbb\x9e\x35\x6f\x7c\xfd\xe4\x09\xec\xf7\x4a\x3b\xc8\xce\x8d\x9d\xc3\xc3\xa1\x1b\x76\x5b\xa1\x4a\xbd\xcd\x9b\xb6\x85\x86\x3e\x98\xd1\xe1\xe1\x0f\xdc\xe2\x87\xf7\x3f\x37\x67\x38\xf0\xcc\x6b\xee\x6b\xfa\xae\x9f\x5e\xdf\xb6\xa2\x07\x4f\x80\xeb\x57\x16\xb6\x08\x9c\x8c\xa1\x1c\x1a\xf4\x1d\xaa\x50\xdd\x06\x53\x94\x47\xa5\x3d\xd4\x71\x1f\xca\xdd\x62\xee\x25\xef\x15\xf4\x32\x96\x5c\xff\xa6\x39\x33\x3a\x89\xdd\x3f\x20\x2f\x55\x8c\xef\x9b\x38\x9d\x51\xef\x45\xd4\x3e\x69\xa1\x52\x1c\xb6\x95\xba\x7f\xb1\xde\xcb\x06\x14\xf5\x47\xf7\xf7\x7f\x2c\xe6\x7b\xd1\xd2\x8d\xb9\xd3\x28\xee\x5c\xba\x9f\xf0\x72\x7c\xa7\xff\x57\x30\xd3\xe2\xec\x72\xf3\x40\x03\x9a\xee\xf5\x03\x83\xa3\x10\x2a\x29\x3a\xfc\x6d\xfe\xf8\x6e\x37\xa6\xff\x11\x54\x58\x3f\xc7\x05\x74\x78\xcf\xfd\xf7\x34\x67\xbe\x08\xc8\x07\x41\xd2\xde\xd7\x04\x97\xc5\xbc\x24\xe4\xa9\x63\xfe\xee\x8e\xf3\xeb\xfa\x4d\x51\x9e\xe9\x1b\x1f\x68\x3d\x87\x67\x7c\x7d\xd0\xf5\x78\xb1\xc8\x08\x6f\x4c\x0d\x8f\x66\xf0\xa2\x25\xd9\x9f\xb5\x82\xf6\x7a\x0a\x6d\x3f\x63\x89\x3a\xcc\xd9\x51\xc7\x9a\x02\xe0\x77\x87\x56\x3c\xb4\x9d\xc4\x78\xfa\xf5\xf9\x47\x5a\x9c\x94\x62\xb1\x68\xd6\xbe\xfd\x18\x9c\xe7\xa2\xeb\xd8\xe3\xaf\x26\x2e\x4f\x2e\xda\xb8\x1f\x76\x71\x46\xb7\xed\x90\xee\x8f\xc1\xda\xba\x76\x9c\xe2\x2d\x52\xa2\x76\xdc\xd0\x9d\x42\xc2\xc5\x97\x5c\x28\x58\x18\xbd\xf6\x3b\xc3\x39\x13\x61\xeb\x0c\x32\x81\x5c\x67\x8c\xf9\x8c\xbb\x51\xb8\x30\xeb\x17\x37\x4f\xcb\x92\x9a\x0e\x47\x59\xef\x58\x9d\xe1\xb2\x25\x37\xe8\xcf\x79\xb2\xc9\xff\xfe\xcf\xf7\x93\x11\x30\x36\xcc\x6d\x25\x85\xcb\xd8\x13\x36\xec\xa7\xc9\xcf\x9b\xa3\xa1\xe7\xf3\xe6\x8f\x74\x5f\x91\x4d\x47\x5c\x7e\xde\x24\x6a\xb3\xee\xdc\x13\xa4\xf8\xb5\xc4\x42\x97\xf8\xe1\xfd\x35\x7d\x7d\xa3\x15\x2a\x97\xf9\x8d\xbf\x3e\xff\x38\xfc\x08\x33\xb8\xf8\xfe\xdb\x8f\x74\x3c\xc0\xd8\x99\x84\x5d\xa2\x44\x87\x89\x02\xdd\xd2\x5a\x74\xac\x29\x11\x71\xfd\x33\xee\x88\x80\x57\xf0\xa5\x54\x1f\x14\x08\x33\x60\xdf\x93\x87\xf9\x28\xe3\xeb\x2c\x60\x48\xf9\xaa\x8d\xf0\xee\x47\x0b\x7f\x2c\x39\x06\x2f\xc8\xc2\x35\xeb\xe8\xdc\x14\xe9\xdf\x0c\x7b\xb9\xb2\x43\x3c\x5c\xb3\xfe\x39\xda\x01\xc7\x59\xe2\x11\xfd\x99\x4c\x3d\x9d\x84\x33\xda\xf9\x20\x1d\xe6\x0e\xfe\x7f\x00\x02\xed\xd1\xb2\x9c\x2b\x00\x00"),
+			uncompressedSize:  11164,
 		},
 	}
 
 	fs["/"].(*_vfsgen_dirInfo).entries = []os.FileInfo{
 		fs["/aggregate.html"].(os.FileInfo),
 		fs["/dashboard.html"].(os.FileInfo),
+		fs["/diff.html"].(os.FileInfo),
+		fs["/flamegraph.html"].(os.FileInfo),
+		fs["/incomplete.html"].(os.FileInfo),
 		fs["/layout.html"].(os.FileInfo),
 		fs["/root.html"].(os.FileInfo),
+		fs["/stats.html"].(os.FileInfo),
 		fs["/trace.html"].(os.FileInfo),
 		fs["/traces.html"].(os.FileInfo),
 	}
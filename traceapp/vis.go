@@ -27,6 +27,7 @@ type timelineItem struct {
 	ParentSpanID string                  `json:"parentSpanID"`
 	URL          string                  `json:"url"`
 	Visible      bool                    `json:"visible"`
+	Critical     bool                    `json:"critical"` // on the critical path
 }
 
 func (tl *timelineItem) Valid() bool {
@@ -39,10 +40,10 @@ func (tl *timelineItem) Valid() bool {
 }
 
 type timelineItemTimespan struct {
-	Label    string `json:"label"`
-	Start    int64  `json:"starting_time"` // msec since epoch
-	End      int64  `json:"ending_time"`   // msec since epoch
-	Duration int64  `json:"duration"`
+	Label    string  `json:"label"`
+	Start    float64 `json:"starting_time"` // msec since epoch
+	End      float64 `json:"ending_time"`   // msec since epoch
+	Duration int64   `json:"duration"`      // nsec
 }
 
 func (a *App) d3timeline(t *appdash.Trace) ([]timelineItem, error) {
@@ -52,11 +53,6 @@ func (a *App) d3timeline(t *appdash.Trace) ([]timelineItem, error) {
 func (a *App) d3timelineInner(t *appdash.Trace, depth int) ([]timelineItem, error) {
 	var items []timelineItem
 
-	var events []appdash.Event
-	if err := appdash.UnmarshalEvents(t.Span.Annotations, &events); err != nil {
-		return nil, err
-	}
-
 	var u *url.URL
 	if t.ID.Parent == 0 {
 		var err error
@@ -90,53 +86,50 @@ func (a *App) d3timelineInner(t *appdash.Trace, depth int) ([]timelineItem, erro
 	if depth <= 1 {
 		item.Visible = true
 	}
-	for _, e := range events {
-		if e, ok := e.(appdash.TimespanEvent); ok {
-			// Continue to next iteration
-			// if e.Start() or e.End() are empty time values.
-			if e.Start() == (time.Time{}) || e.End() == (time.Time{}) {
-				if a.Log != nil {
-					a.Log.Printf("Found a TimespanEvent: %+v with invalid/zero times.", e)
-				}
-				// Continuing so frontend does not break due to current event missing start/end time values.
-				continue
-			}
-			start := e.Start().UnixNano() / int64(time.Millisecond)
-			end := e.End().UnixNano() / int64(time.Millisecond)
-			ts := timelineItemTimespan{
-				Start: start,
-				End:   end,
-			}
-			if t.Span.ID.Parent == 0 {
-				ts.Label = e.Schema()
-				item.Times = append(item.Times, &ts)
-			} else {
-				if item.Times == nil {
-					item.Times = append(item.Times, &ts)
-				} else {
-					if item.Times[0].Start > start {
-						item.Times[0].Start = start
-					}
-					if item.Times[0].End < end {
-						item.Times[0].End = end
-					}
-				}
-			}
-		}
-	}
-	for _, ts := range item.Times {
-		msec := time.Duration(item.Times[0].End-item.Times[0].Start) * time.Millisecond
-		if msec > 0 {
-			ts.Label = fmt.Sprintf("%s (%s)", item.Label, msec)
-			ts.Duration = int64(msec)
-		}
-	}
-	if len(item.Times) == 0 {
+	start, end := t.StartTime(), t.EndTime()
+	if start.IsZero() || end.Before(start) {
 		// Items with a null times array will crash d3-timeline.js as it tries
 		// to iterate over it. This means the trace doesn't have a single
-		// TimespanEvent and is thus invalid.
+		// TimespanEvent (with valid times) and is thus invalid.
+		if !start.IsZero() && a.Log != nil {
+			a.Log.Printf("Found span %s with invalid times: %s to %s.", t.Span.ID, start, end)
+		}
 		return nil, nil
 	}
+	var label string
+	if d := t.Duration(); d > 0 {
+		label = fmt.Sprintf("%s (%s)", item.Label, d)
+	}
+	if t.Span.ID.Parent == 0 {
+		// The root span has a bar for each of its timespan events (e.g., for
+		// each side of a request that was traced by both the client and the
+		// server), labeled with the duration of the whole span.
+		var events []appdash.Event
+		if err := appdash.UnmarshalEvents(t.Span.Annotations, &events); err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			e, ok := e.(appdash.TimespanEvent)
+			if !ok || e.Start().IsZero() || e.End().IsZero() {
+				continue
+			}
+			item.Times = append(item.Times, &timelineItemTimespan{
+				Label:    label,
+				Start:    msecSinceEpoch(e.Start()),
+				End:      msecSinceEpoch(e.End()),
+				Duration: int64(e.End().Sub(e.Start())),
+			})
+		}
+	} else {
+		// Other spans have a single bar covering all of their timespan
+		// events.
+		item.Times = []*timelineItemTimespan{{
+			Label:    label,
+			Start:    msecSinceEpoch(start),
+			End:      msecSinceEpoch(end),
+			Duration: int64(t.Duration()),
+		}}
+	}
 	items = append(items, item)
 
 	for _, child := range t.Sub {
@@ -149,3 +142,9 @@ func (a *App) d3timelineInner(t *appdash.Trace, depth int) ([]timelineItem, erro
 
 	return items, nil
 }
+
+// msecSinceEpoch returns t in (fractional) milliseconds since the Unix epoch,
+// as used by d3-timeline.
+func msecSinceEpoch(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Millisecond)
+}
@@ -0,0 +1,408 @@
+package appdash
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+// walExt is the suffix added to a WALStore's snapshot file name to get the
+// name of its log file.
+const walExt = ".wal"
+
+// A WALStore is a MemoryStore that is persisted to disk incrementally, unlike
+// PersistEvery which rewrites the whole store every time.
+//
+// Every Collect, Delete and DeleteSpan call is appended to a write-ahead log
+// (in the file named by adding ".wal" to the snapshot file name) before it is
+// applied to the in-memory store. Snapshot writes the whole store to the
+// snapshot file, in the same format as MemoryStore.Write, and then removes
+// the records that it includes from the log. When a WALStore is opened, the
+// snapshot is read and the log records written after it are replayed, so
+// nothing that was collected before the process crashed is lost. (Log records are not synced
+// to disk one by one, so the most recent ones may be lost if the machine
+// loses power; snapshots are synced.)
+//
+// Like a MemoryStore, a WALStore collects the spans of traces in different
+// shards concurrently; only the log writes themselves are serialized. A
+// snapshot only blocks collection while the traces are copied, not while they
+// are encoded and written.
+//
+// The log uses the same record format as FileStore. Each payload is prefixed
+// with the record's big-endian uint64 sequence number, which is also stored
+// in the snapshot, so that records are never replayed twice.
+type WALStore struct {
+	*MemoryStore
+
+	file     string // snapshot file
+	readOnly bool   // opened with OpenWALStoreReadOnly
+
+	snapMu sync.Mutex // held while a snapshot is written
+
+	// logMu protects the log. It is acquired after the shard locks of the
+	// MemoryStore, so that the records of each shard's traces are logged in
+	// the order the changes are made.
+	logMu   sync.Mutex
+	log     *os.File
+	logSize int64
+	seq     uint64 // sequence number of the last record
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	DeleteStore
+	Queryer
+	Aggregator
+	Watcher
+	StatsStore
+	TraceLister
+} = (*WALStore)(nil)
+
+// NewWALStore opens the WALStore whose snapshot is stored in the given file,
+// reading the snapshot and replaying the log if they exist.
+func NewWALStore(file string) (*WALStore, error) {
+	return OpenWALStore(file, NewMemoryStore())
+}
+
+// OpenWALStore is like NewWALStore, but the snapshot is read and the log is
+// replayed into ms, which must be a new MemoryStore. This lets the
+// MaxTraceSpans and MaxTraceBytes limits of ms be set before the log is
+// replayed, so that a runaway trace in the log is truncated, too.
+func OpenWALStore(file string, ms *MemoryStore) (*WALStore, error) {
+	return openWALStore(file, ms, false)
+}
+
+// OpenWALStoreReadOnly opens the WALStore whose snapshot is stored in the
+// given file for reading only, e.g. to export its traces while a server is
+// using it. The snapshot and the log are read as they are when it is opened.
+// Unlike NewWALStore, it never modifies the files: a torn or corrupt record
+// at the end of the log (such as one that the server is still writing) is
+// ignored rather than truncated, and the log file is not created if it does
+// not exist. Collect, Delete, DeleteSpan and Snapshot return an error.
+func OpenWALStoreReadOnly(file string) (*WALStore, error) {
+	return openWALStore(file, NewMemoryStore(), true)
+}
+
+func openWALStore(file string, ms *MemoryStore, readOnly bool) (*WALStore, error) {
+	ws := &WALStore{
+		MemoryStore: ms,
+		file:        file,
+		readOnly:    readOnly,
+	}
+
+	// Open the log before reading the snapshot: if a server that is using
+	// the files (see OpenWALStoreReadOnly) writes a new snapshot in between,
+	// the records that it includes are skipped, and the records that follow
+	// it are still in the log that was opened.
+	var err error
+	if readOnly {
+		ws.log, err = os.Open(ws.logFile())
+		if os.IsNotExist(err) {
+			ws.log, err = nil, nil
+		}
+	} else {
+		ws.log, err = os.OpenFile(ws.logFile(), os.O_RDWR|os.O_CREATE, 0600)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(file)
+	if err != nil && !os.IsNotExist(err) {
+		ws.Close()
+		return nil, err
+	}
+	if f != nil {
+		_, ws.seq, err = ws.readFrom(f)
+		f.Close()
+		if err != nil {
+			ws.Close()
+			return nil, err
+		}
+	}
+
+	if ws.log == nil {
+		return ws, nil
+	}
+	if err := ws.replay(); err != nil {
+		ws.Close()
+		return nil, err
+	}
+	return ws, nil
+}
+
+// replay applies the log records that are not yet included in the snapshot.
+// If a torn or corrupt record is found, the log is truncated to the last good
+// record (unless the store is read-only, in which case the rest of the log is
+// ignored).
+func (ws *WALStore) replay() error {
+	fi, err := ws.log.Stat()
+	if err != nil {
+		return err
+	}
+	snapshotSeq := ws.seq
+	r := bufio.NewReader(io.NewSectionReader(ws.log, 0, fi.Size()))
+	for {
+		kind, payload, hdr, err := readRecord(r, fi.Size()-ws.logSize)
+		if err == io.EOF {
+			return nil
+		} else if err != nil || len(payload) < 8 {
+			if err == nil {
+				err = errCorruptRecord
+			}
+			if ws.readOnly {
+				return nil
+			}
+			log.Printf("WALStore: truncating %s at offset %d: %s", ws.log.Name(), ws.logSize, err)
+			return ws.log.Truncate(ws.logSize)
+		}
+		off := ws.logSize
+		ws.logSize += int64(hdr + len(payload))
+
+		seq := binary.BigEndian.Uint64(payload)
+		if seq <= snapshotSeq {
+			continue // already in the snapshot
+		}
+		if err := ws.apply(kind, payload[8:]); err != nil {
+			return fmt.Errorf("WALStore: %s at offset %d: %s", ws.log.Name(), off, err)
+		}
+		ws.seq = seq
+	}
+}
+
+// apply applies a log record to the in-memory store.
+func (ws *WALStore) apply(kind byte, payload []byte) error {
+	switch kind {
+	case recordCollect:
+		p := &wire.CollectPacket{}
+		if err := proto.Unmarshal(payload, p); err != nil {
+			return err
+		}
+		if p.Spanid == nil {
+			return errors.New("collect record has no span ID")
+		}
+		return ws.MemoryStore.Collect(spanIDFromWire(p.Spanid), annotationsFromWire(p.Annotation)...)
+	case recordDelete:
+		if len(payload)%8 != 0 {
+			return errors.New("invalid delete record")
+		}
+		ids := make([]ID, len(payload)/8)
+		for i := range ids {
+			ids[i] = ID(binary.BigEndian.Uint64(payload[i*8:]))
+		}
+		return ws.MemoryStore.Delete(ids...)
+	case recordDeleteSpan:
+		if len(payload) != 16 {
+			return errors.New("invalid delete span record")
+		}
+		id := SpanID{Trace: ID(binary.BigEndian.Uint64(payload)), Span: ID(binary.BigEndian.Uint64(payload[8:]))}
+		if err := ws.MemoryStore.DeleteSpan(id); err != ErrSpanNotFound {
+			return err
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown record kind %d", kind)
+	}
+}
+
+// logFile returns the name of the log file.
+func (ws *WALStore) logFile() string {
+	return ws.file + walExt
+}
+
+// append appends a record to the log. The first 8 bytes of payload are
+// reserved for the sequence number, which append fills in.
+func (ws *WALStore) append(kind byte, payload []byte) error {
+	if ws.readOnly {
+		return errReadOnly
+	}
+	ws.logMu.Lock()
+	defer ws.logMu.Unlock()
+	ws.seq++
+	binary.BigEndian.PutUint64(payload, ws.seq)
+	buf, _ := encodeRecord(kind, payload)
+	if _, err := ws.log.WriteAt(buf, ws.logSize); err != nil {
+		// Don't leave a partial record behind, as it would hide any records
+		// appended after it when the log is replayed.
+		ws.log.Truncate(ws.logSize)
+		ws.seq--
+		return err
+	}
+	ws.logSize += int64(len(buf))
+	return nil
+}
+
+// Collect implements the Collector interface by appending the span to the log
+// and collecting it in memory.
+func (ws *WALStore) Collect(id SpanID, as ...Annotation) error {
+	p, err := proto.Marshal(newCollectPacket(id, as))
+	if err != nil {
+		return err
+	}
+	payload := make([]byte, 8, 8+len(p))
+	payload = append(payload, p...)
+
+	return ws.MemoryStore.collectAfter(id, as, func() error {
+		return ws.append(recordCollect, payload)
+	})
+}
+
+// Delete implements the DeleteStore interface by appending the deletion to
+// the log and deleting the traces from memory. Each trace's deletion is logged
+// in a separate record, so that it is ordered with the other changes to the
+// trace's shard.
+func (ws *WALStore) Delete(traces ...ID) error {
+	for _, id := range traces {
+		payload := make([]byte, 16)
+		binary.BigEndian.PutUint64(payload[8:], uint64(id))
+		err := ws.MemoryStore.deleteAfter(id, func() error {
+			return ws.append(recordDelete, payload)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteSpan deletes the given span and its descendants (see
+// MemoryStore.DeleteSpan), appending the deletion to the log first.
+func (ws *WALStore) DeleteSpan(id SpanID) error {
+	payload := make([]byte, 24)
+	binary.BigEndian.PutUint64(payload[8:], uint64(id.Trace))
+	binary.BigEndian.PutUint64(payload[16:], uint64(id.Span))
+	return ws.MemoryStore.deleteSpanAfter(id, func() error {
+		return ws.append(recordDeleteSpan, payload)
+	})
+}
+
+// Snapshot writes the whole store to the snapshot file and removes the
+// records that it includes from the log. It does nothing if nothing has
+// changed since the last snapshot.
+//
+// Collect and Delete are only blocked while the traces are copied; they are
+// encoded and written to disk while spans continue to be collected.
+func (ws *WALStore) Snapshot() error {
+	if ws.readOnly {
+		return errReadOnly
+	}
+	ws.snapMu.Lock()
+	defer ws.snapMu.Unlock()
+
+	// Copy the traces while all of the shards are locked, so that the copy
+	// includes exactly the changes logged up to seq (at logOff in the log).
+	ws.MemoryStore.Lock()
+	ws.logMu.Lock()
+	seq, logOff := ws.seq, ws.logSize
+	ws.logMu.Unlock()
+	var traces map[ID]*Trace
+	if logOff > 0 {
+		traces = ws.MemoryStore.snapshotNoLock()
+	}
+	ws.MemoryStore.Unlock()
+	if logOff == 0 {
+		return nil
+	}
+
+	// Write to a temporary file in the same directory, so that it can be
+	// renamed over the old snapshot atomically. The file and the rename must
+	// be on disk before the log records are removed.
+	f, err := ioutil.TempFile(filepath.Dir(ws.file), filepath.Base(ws.file))
+	if err != nil {
+		return err
+	}
+	err = writeTraces(f, traces, seq)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), ws.file); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := syncDir(filepath.Dir(ws.file)); err != nil {
+		return err
+	}
+
+	// If we crash before the log is trimmed, the records in it are skipped
+	// on replay because the snapshot includes their sequence numbers.
+	return ws.trimLog(logOff)
+}
+
+// trimLog removes the records before offset off from the log, keeping those
+// that were appended after it. The remaining records are copied to a new log
+// file, which is renamed over the old one.
+func (ws *WALStore) trimLog(off int64) error {
+	ws.logMu.Lock()
+	defer ws.logMu.Unlock()
+
+	if off == ws.logSize {
+		// Nothing was appended since; no need for a new file.
+		if err := ws.log.Truncate(0); err != nil {
+			return err
+		}
+		ws.logSize = 0
+		return ws.log.Sync()
+	}
+
+	name := ws.logFile()
+	f, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, io.NewSectionReader(ws.log, off, ws.logSize-off))
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	ws.log.Close()
+	ws.log = f
+	ws.logSize -= off
+	return syncDir(filepath.Dir(name))
+}
+
+// SnapshotEvery calls Snapshot periodically. It only returns if Snapshot
+// returns an error.
+func (ws *WALStore) SnapshotEvery(interval time.Duration) error {
+	for {
+		time.Sleep(interval)
+		if err := ws.Snapshot(); err != nil {
+			return err
+		}
+	}
+}
+
+// Close closes the log file. It does not write a snapshot. The WALStore must
+// not be used after it is closed.
+func (ws *WALStore) Close() error {
+	ws.logMu.Lock()
+	defer ws.logMu.Unlock()
+	if ws.log == nil {
+		return nil // read-only, without a log file
+	}
+	return ws.log.Close()
+}
@@ -0,0 +1,336 @@
+package appdash
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestWALStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "store.gob")
+
+	ws, err := NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := storeT{t, ws}
+	s.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "k1"})
+	s.MustCollect(SpanID{2, 2, 0})
+	if err := ws.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+	s.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "k2"})
+	s.MustCollect(SpanID{1, 3, 1})
+	if err := ws.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.DeleteSpan(SpanID{1, 3, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash: the changes made after the snapshot are only in the
+	// log.
+	if err := ws.Close(); err != nil {
+		t.Fatal(err)
+	}
+	ws, err = NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s = storeT{t, ws}
+	want1 := &Trace{
+		Span: Span{ID: SpanID{1, 1, 0}, Annotations: Annotations{{Key: "k1"}, {Key: "k2"}}},
+		Sub:  []*Trace{}, // span 3 was deleted
+	}
+	if x := s.MustTrace(1); !reflect.DeepEqual(x, want1) {
+		t.Errorf("Trace(1): got %+v, want %+v", x, want1)
+	}
+	if _, err := ws.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2): got err %v, want ErrTraceNotFound", err)
+	}
+
+	// Simulate a crash between writing a snapshot and truncating the log. The
+	// records in the log must not be applied twice.
+	s.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "k3"})
+	log, err := ioutil.ReadFile(file + walExt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(file+walExt, log, 0600); err != nil {
+		t.Fatal(err)
+	}
+	ws, err = NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+	s = storeT{t, ws}
+	want1.Span.Annotations = append(want1.Span.Annotations, Annotation{Key: "k3"})
+	want1.Sub = nil // gob omits the empty slice from the snapshot
+	if x := s.MustTrace(1); !reflect.DeepEqual(x, want1) {
+		t.Errorf("Trace(1) after replaying old log: got %+v, want %+v", x, want1)
+	}
+}
+
+func TestOpenWALStore_limits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "store.gob")
+
+	ws, err := NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := storeT{t, ws}
+	s.MustCollect(SpanID{1, 1, 0})
+	for i := ID(2); i <= 5; i++ {
+		s.MustCollect(SpanID{1, i, 1})
+	}
+	if err := ws.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The limits apply to the spans replayed from the log.
+	ms := NewMemoryStore()
+	ms.MaxTraceSpans = 2
+	ws, err = OpenWALStore(file, ms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+	st, err := ws.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Spans != 2 {
+		t.Errorf("got %d spans, want 2", st.Spans)
+	}
+}
+
+func TestOpenWALStoreReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "store.gob")
+
+	// Without a snapshot or log, nothing is created.
+	ro, err := OpenWALStoreReadOnly(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ro.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(file + walExt); !os.IsNotExist(err) {
+		t.Errorf("got err %v from log file, want it not to exist", err)
+	}
+
+	ws, err := NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+	s := storeT{t, ws}
+	s.MustCollect(SpanID{1, 1, 0})
+	if err := ws.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+	s.MustCollect(SpanID{2, 2, 0})
+
+	// Simulate a record that is still being written by the store.
+	f, err := os.OpenFile(file+walExt, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte{50, 1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.Stat(file + walExt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ro, err = OpenWALStoreReadOnly(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ro.Close()
+	if n := ro.NumTraces(); n != 2 {
+		t.Errorf("got %d traces, want 2", n)
+	}
+	if err := ro.Collect(SpanID{3, 3, 0}); err != errReadOnly {
+		t.Errorf("Collect: got err %v, want errReadOnly", err)
+	}
+	if err := ro.Snapshot(); err != errReadOnly {
+		t.Errorf("Snapshot: got err %v, want errReadOnly", err)
+	}
+	after, err := os.Stat(file + walExt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Size() != before.Size() {
+		t.Errorf("log file size changed from %d to %d", before.Size(), after.Size())
+	}
+}
+
+func TestWALStore_empty(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "store.gob")
+
+	ws, err := NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeT{t, ws}.MustCollect(SpanID{1, 1, 0})
+	if err := ws.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A snapshot of an empty store must be usable after it is read back.
+	ws, err = NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+	storeT{t, ws}.MustCollect(SpanID{2, 2, 0})
+}
+
+func TestWALStore_concurrentSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "store.gob")
+
+	ws, err := NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Spans collected while snapshots are written must end up either in a
+	// snapshot or in the log, but not in both.
+	const n = 200
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := ID(1); i <= n; i++ {
+			if err := ws.Collect(SpanID{i, i, 0}, Annotation{Key: "k"}); err != nil {
+				t.Error(err)
+				return
+			}
+			if i%10 == 0 {
+				if err := ws.Delete(i - 5); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}
+	}()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		if err := ws.Snapshot(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ws.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ws, err = NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+	for i := ID(1); i <= n; i++ {
+		tr, err := ws.Trace(i)
+		if i%10 == 5 {
+			if err != ErrTraceNotFound {
+				t.Errorf("Trace(%v): got err %v, want ErrTraceNotFound", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Trace(%v): %s", i, err)
+		} else if len(tr.Annotations) != 1 {
+			t.Errorf("Trace(%v): got annotations %v, want 1", i, tr.Annotations)
+		}
+	}
+}
+
+func TestWALStore_trimLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "store.gob")
+
+	ws, err := NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := storeT{t, ws}
+	s.MustCollect(SpanID{1, 1, 0})
+	off := ws.logSize
+
+	// The records appended after off (e.g., while a snapshot was written)
+	// are kept, and the log can still be appended to.
+	s.MustCollect(SpanID{2, 2, 0})
+	if err := ws.trimLog(off); err != nil {
+		t.Fatal(err)
+	}
+	s.MustCollect(SpanID{3, 3, 0})
+	if err := ws.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ws, err = NewWALStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+	if _, err := ws.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("Trace(1): got err %v, want ErrTraceNotFound (trimmed from the log)", err)
+	}
+	for _, id := range []ID{2, 3} {
+		if _, err := ws.Trace(id); err != nil {
+			t.Errorf("Trace(%v): %s", id, err)
+		}
+	}
+}
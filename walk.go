@@ -0,0 +1,98 @@
+package appdash
+
+import "errors"
+
+// SkipSubtree is used as a return value from WalkFuncs to indicate that the
+// children of the span passed to the function are to be skipped. It is not
+// returned as an error by Walk.
+var SkipSubtree = errors.New("skip this subtree")
+
+// WalkFunc is the type of the function called by Walk for each span in a
+// trace. It is called twice for each span: once before visiting its children
+// (with post == false, i.e. in pre-order) and once after (with post == true,
+// i.e. in post-order). Depth is zero for the span Walk was called on.
+//
+// If the function returns SkipSubtree from the pre-order call, the span's
+// children and its post-order call are skipped. If it returns any other
+// non-nil error, Walk stops and returns that error.
+type WalkFunc func(t *Trace, depth int, post bool) error
+
+// Walk walks the trace tree rooted at t, calling fn for each span in
+// depth-first order. Children are visited in the order they appear in Sub.
+func (t *Trace) Walk(fn WalkFunc) error {
+	err := t.walk(fn, 0)
+	if err == SkipSubtree {
+		return nil
+	}
+	return err
+}
+
+func (t *Trace) walk(fn WalkFunc, depth int) error {
+	if err := fn(t, depth, false); err != nil {
+		return err
+	}
+	for _, sub := range t.Sub {
+		if err := sub.walk(fn, depth+1); err != nil && err != SkipSubtree {
+			return err
+		}
+	}
+	if err := fn(t, depth, true); err != nil && err != SkipSubtree {
+		return err
+	}
+	return nil
+}
+
+// Filter returns a copy of the trace that contains only the spans for which
+// pred returns true. When pred returns false for a span, the span is pruned
+// along with all of its descendants (pred is not called for them). If pred
+// returns false for t itself, nil is returned.
+//
+// The returned trace shares no Trace values or Annotations slices with t, so
+// it may be modified freely; the annotation values themselves are shared.
+func (t *Trace) Filter(pred func(t *Trace) bool) *Trace {
+	if !pred(t) {
+		return nil
+	}
+	c := &Trace{Span: t.Span}
+	c.Annotations = append(Annotations(nil), t.Annotations...)
+	for _, sub := range t.Sub {
+		if s := sub.Filter(pred); s != nil {
+			c.Sub = append(c.Sub, s)
+		}
+	}
+	return c
+}
+
+// Map returns a copy of the trace in which the annotations of each span are
+// replaced by the result of calling fn with the original span. It can be used
+// to rename, redact or drop annotations without modifying t.
+func (t *Trace) Map(fn func(s Span) Annotations) *Trace {
+	c := &Trace{Span: Span{ID: t.ID, Annotations: fn(t.Span)}}
+	if len(t.Sub) > 0 {
+		c.Sub = make([]*Trace, len(t.Sub))
+		for i, sub := range t.Sub {
+			c.Sub[i] = sub.Map(fn)
+		}
+	}
+	return c
+}
+
+// A FlatSpan is a span along with its depth in the trace it came from, as
+// returned by Trace.Flatten.
+type FlatSpan struct {
+	Span
+	Depth int // zero for the root of the flattened trace
+}
+
+// Flatten returns the spans in the trace in depth-first pre-order, i.e. each
+// span is followed by its descendants.
+func (t *Trace) Flatten() []FlatSpan {
+	var spans []FlatSpan
+	t.Walk(func(t *Trace, depth int, post bool) error {
+		if !post {
+			spans = append(spans, FlatSpan{Span: t.Span, Depth: depth})
+		}
+		return nil
+	})
+	return spans
+}
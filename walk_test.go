@@ -0,0 +1,143 @@
+package appdash
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// walkTestTrace returns the trace:
+//
+//	a
+//	  b
+//	    c
+//	  d
+func walkTestTrace() *Trace {
+	span := func(id, parent ID, name string) Span {
+		return Span{
+			ID:          SpanID{Trace: 1, Span: id, Parent: parent},
+			Annotations: Annotations{{Key: "Name", Value: []byte(name)}},
+		}
+	}
+	return &Trace{
+		Span: span(1, 0, "a"),
+		Sub: []*Trace{
+			{Span: span(2, 1, "b"), Sub: []*Trace{{Span: span(3, 2, "c")}}},
+			{Span: span(4, 1, "d")},
+		},
+	}
+}
+
+func TestTrace_Walk(t *testing.T) {
+	tr := walkTestTrace()
+
+	var visits []string
+	err := tr.Walk(func(t *Trace, depth int, post bool) error {
+		order := "pre"
+		if post {
+			order = "post"
+		}
+		visits = append(visits, strings.Repeat(" ", depth)+order+" "+t.Span.Name())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"pre a", " pre b", "  pre c", "  post c", " post b", " pre d", " post d", "post a"}
+	if !reflect.DeepEqual(visits, want) {
+		t.Errorf("got visits %q, want %q", visits, want)
+	}
+
+	// Skip the subtree of b.
+	visits = nil
+	err = tr.Walk(func(t *Trace, depth int, post bool) error {
+		if !post {
+			visits = append(visits, t.Span.Name())
+			if t.Span.Name() == "b" {
+				return SkipSubtree
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "d"}; !reflect.DeepEqual(visits, want) {
+		t.Errorf("got visits %q with SkipSubtree, want %q", visits, want)
+	}
+
+	// Stop at c.
+	stop := errors.New("stop")
+	visits = nil
+	err = tr.Walk(func(t *Trace, depth int, post bool) error {
+		visits = append(visits, t.Span.Name())
+		if t.Span.Name() == "c" {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("got error %v, want %v", err, stop)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(visits, want) {
+		t.Errorf("got visits %q with error, want %q", visits, want)
+	}
+}
+
+func TestTrace_Filter(t *testing.T) {
+	tr := walkTestTrace()
+	f := tr.Filter(func(t *Trace) bool { return t.Span.Name() != "b" })
+	var names []string
+	for _, s := range f.Flatten() {
+		names = append(names, s.Name())
+	}
+	if want := []string{"a", "d"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got spans %q, want %q", names, want)
+	}
+
+	// The copy must not share annotations with the original.
+	f.Annotations[0].Value = []byte("x")
+	if got := tr.Span.Name(); got != "a" {
+		t.Errorf("original trace was modified: got name %q, want %q", got, "a")
+	}
+
+	if f := tr.Filter(func(t *Trace) bool { return false }); f != nil {
+		t.Errorf("got %v, want nil when the root is filtered out", f)
+	}
+}
+
+func TestTrace_Map(t *testing.T) {
+	tr := walkTestTrace()
+	m := tr.Map(func(s Span) Annotations {
+		return Annotations{{Key: "Name", Value: []byte(strings.ToUpper(s.Name()))}}
+	})
+	var got, orig []string
+	for _, s := range m.Flatten() {
+		got = append(got, s.Name())
+	}
+	for _, s := range tr.Flatten() {
+		orig = append(orig, s.Name())
+	}
+	if want := []string{"A", "B", "C", "D"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got spans %q, want %q", got, want)
+	}
+	if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(orig, want) {
+		t.Errorf("original trace was modified: got spans %q, want %q", orig, want)
+	}
+}
+
+func TestTrace_Flatten(t *testing.T) {
+	type flat struct {
+		Span  ID
+		Depth int
+	}
+	var got []flat
+	for _, s := range walkTestTrace().Flatten() {
+		got = append(got, flat{s.ID.Span, s.Depth})
+	}
+	want := []flat{{1, 0}, {2, 1}, {3, 2}, {4, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
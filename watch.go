@@ -0,0 +1,203 @@
+package appdash
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// watchDebounce is how long a watch waits for further updates after a
+	// trace is updated before sending it, so that a trace whose spans are
+	// collected in quick succession is only sent once.
+	watchDebounce = 250 * time.Millisecond
+
+	// watchMaxDelay is the longest a watch delays sending updated traces,
+	// so that they are still sent while the store is continuously updated.
+	watchMaxDelay = time.Second
+)
+
+// A Watcher is a store that can notify callers of traces as they are
+// collected.
+type Watcher interface {
+	// Watch returns a channel on which traces are sent as they are created
+	// or updated, until ctx is done (at which point the channel is closed).
+	// Updates are debounced: updated traces are sent once no trace has been
+	// updated for 250ms (or at most a second after the first update), so a
+	// trace whose spans are collected in quick succession is sent once after
+	// the last of them.
+	//
+	// Only traces matching the filters in opts (TraceIDs, Timespan and
+	// Query) are sent; the sorting and pagination options are ignored. If
+	// opts.Query is not a valid query, the channel is closed immediately, so
+	// callers should validate it using ParseQuery first.
+	Watch(ctx context.Context, opts TracesOpts) <-chan *Trace
+}
+
+// watchStore calls s.Watch if s is a Watcher. Otherwise, it returns a channel
+// that is closed when ctx is done, as no traces will ever be sent on it.
+func watchStore(ctx context.Context, s Store, opts TracesOpts) <-chan *Trace {
+	if w, ok := s.(Watcher); ok {
+		return w.Watch(ctx, opts)
+	}
+	ch := make(chan *Trace)
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch
+}
+
+// traceWatchers implements Watch for a store. The store must call notify
+// whenever a trace is updated. The zero value is ready to use.
+type traceWatchers struct {
+	mu      sync.Mutex
+	watches map[*traceWatch]struct{}
+}
+
+// traceWatch is a single call to Watch.
+type traceWatch struct {
+	q       *tracesQuery
+	load    func(ID) (*Trace, error)
+	ch      chan *Trace
+	updated chan struct{} // signaled (without blocking) when dirty becomes non-empty
+
+	mu    sync.Mutex
+	dirty map[ID]struct{} // traces updated since they were last sent
+}
+
+// watch implements Watcher.Watch. The traces to send are retrieved using
+// load, which is called without holding any of tw's locks.
+func (tw *traceWatchers) watch(ctx context.Context, opts TracesOpts, load func(ID) (*Trace, error)) <-chan *Trace {
+	ch := make(chan *Trace)
+	q, err := newTracesQuery(opts)
+	if err != nil {
+		close(ch)
+		return ch
+	}
+	w := &traceWatch{
+		q:       q,
+		load:    load,
+		ch:      ch,
+		updated: make(chan struct{}, 1),
+		dirty:   map[ID]struct{}{},
+	}
+
+	tw.mu.Lock()
+	if tw.watches == nil {
+		tw.watches = map[*traceWatch]struct{}{}
+	}
+	tw.watches[w] = struct{}{}
+	tw.mu.Unlock()
+
+	go func() {
+		defer func() {
+			tw.mu.Lock()
+			delete(tw.watches, w)
+			tw.mu.Unlock()
+			close(ch)
+		}()
+		for {
+			select {
+			case <-w.updated:
+			case <-ctx.Done():
+				return
+			}
+			if !w.debounce(ctx) {
+				return
+			}
+			if !w.send(ctx) {
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// notify marks the trace as updated in all watches.
+func (tw *traceWatchers) notify(id ID) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	for w := range tw.watches {
+		w.mu.Lock()
+		w.dirty[id] = struct{}{}
+		w.mu.Unlock()
+		select {
+		case w.updated <- struct{}{}:
+		default: // already signaled
+		}
+	}
+}
+
+// debounce waits until no trace has been updated for watchDebounce, or until
+// watchMaxDelay has passed. It returns false if ctx is done.
+func (w *traceWatch) debounce(ctx context.Context) bool {
+	quiet := time.NewTimer(watchDebounce)
+	defer quiet.Stop()
+	maxDelay := time.NewTimer(watchMaxDelay)
+	defer maxDelay.Stop()
+	for {
+		select {
+		case <-w.updated:
+			if !quiet.Stop() {
+				<-quiet.C
+			}
+			quiet.Reset(watchDebounce)
+		case <-quiet.C:
+			return true
+		case <-maxDelay.C:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// send sends the updated traces that match the watch's filters. It returns
+// false if ctx is done.
+func (w *traceWatch) send(ctx context.Context) bool {
+	w.mu.Lock()
+	dirty := w.dirty
+	w.dirty = map[ID]struct{}{}
+	w.mu.Unlock()
+
+	for id := range dirty {
+		t, err := w.load(id)
+		if err == ErrTraceNotFound {
+			continue // deleted since it was updated
+		} else if err != nil {
+			log.Printf("Watch: trace %v: %s", id, err)
+			continue
+		}
+		ok, err := w.match(t)
+		if err != nil {
+			log.Printf("Watch: trace %v: %s", id, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		select {
+		case w.ch <- t:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// match reports whether t matches the watch's filters.
+func (w *traceWatch) match(t *Trace) (bool, error) {
+	qt, err := newQueriedTrace(t)
+	if err != nil {
+		return false, err
+	}
+	if !w.q.include(qt) {
+		return false, nil
+	}
+	if w.q.query == nil {
+		return true, nil
+	}
+	return w.q.query.Match(t)
+}
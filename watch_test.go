@@ -0,0 +1,90 @@
+package appdash
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// receiveTrace returns the next trace sent on ch, or fails the test if none
+// is sent within a second.
+func receiveTrace(t *testing.T, ch <-chan *Trace) *Trace {
+	select {
+	case tr, ok := <-ch:
+		if !ok {
+			t.Fatal("watch channel closed")
+		}
+		return tr
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for trace")
+	}
+	return nil
+}
+
+func TestMemoryStore_Watch(t *testing.T) {
+	ms := NewMemoryStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := ms.Watch(ctx, TracesOpts{Query: "name:watched"})
+
+	// Updates in quick succession are sent once.
+	s := storeT{t, ms}
+	s.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "Name", Value: []byte("watched")})
+	s.MustCollect(SpanID{1, 2, 1})
+	s.MustCollect(SpanID{2, 3, 0}, Annotation{Key: "Name", Value: []byte("other")})
+	tr := receiveTrace(t, ch)
+	if tr.Span.ID.Trace != 1 || len(tr.Sub) != 1 {
+		t.Errorf("got trace %v, want trace 1 with 1 child", tr)
+	}
+	select {
+	case tr := <-ch:
+		t.Fatalf("got unexpected trace %v", tr)
+	case <-time.After(2 * watchDebounce):
+	}
+
+	// Later updates are sent again.
+	s.MustCollect(SpanID{1, 4, 1})
+	if tr := receiveTrace(t, ch); len(tr.Sub) != 2 {
+		t.Errorf("got trace %v, want trace 1 with 2 children", tr)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("got trace after cancel, want channel closed")
+		}
+	case <-time.After(time.Second):
+		t.Error("timed out waiting for channel to be closed")
+	}
+}
+
+func TestMemoryStore_Watch_debounce(t *testing.T) {
+	ms := NewMemoryStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := ms.Watch(ctx, TracesOpts{})
+
+	// Each update postpones sending the trace, so it is sent once after the
+	// last update even though the updates span more than watchDebounce.
+	s := storeT{t, ms}
+	s.MustCollect(SpanID{1, 1, 0})
+	for i := 0; i < 3; i++ {
+		time.Sleep(watchDebounce / 2)
+		s.MustCollect(SpanID{1, ID(i + 2), 1})
+	}
+	if tr := receiveTrace(t, ch); len(tr.Sub) != 3 {
+		t.Errorf("got trace %v, want trace 1 with 3 children", tr)
+	}
+}
+
+func TestLimitStore_Watch(t *testing.T) {
+	ls := &LimitStore{DeleteStore: NewMemoryStore(), Max: 10}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := ls.Watch(ctx, TracesOpts{})
+	storeT{t, ls}.MustCollect(SpanID{1, 1, 0})
+	if tr := receiveTrace(t, ch); tr.Span.ID.Trace != 1 {
+		t.Errorf("got trace %v, want trace 1", tr)
+	}
+}
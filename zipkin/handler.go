@@ -0,0 +1,87 @@
+package zipkin
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// SpansPath is the URL path of the Zipkin v2 span ingestion endpoint.
+const SpansPath = "/api/v2/spans"
+
+// maxRequestSize is the maximum size of a request body (after decompression)
+// that a Handler accepts.
+const maxRequestSize = 16 << 20
+
+// Handler is an http.Handler that accepts POST requests with a JSON array of
+// Zipkin v2 spans (optionally gzip-encoded), as sent by Zipkin reporters to
+// /api/v2/spans, and collects the spans into Collector.
+//
+// As with a Zipkin server, a 202 Accepted response is sent if the spans were
+// collected, and a 400 Bad Request response if they are invalid. Requests
+// larger than 16 MB (after decompression) are rejected with a 413 Request
+// Entity Too Large response.
+type Handler struct {
+	Collector appdash.Collector
+
+	// Log is the logger that collection errors are logged to, or nil to use
+	// the default logger.
+	Log *log.Logger
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	defer r.Body.Close()
+
+	var body io.ReadCloser = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+	body = http.MaxBytesReader(w, body, maxRequestSize)
+
+	var spans []Span
+	if err := json.NewDecoder(body).Decode(&spans); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "zipkin: request too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "zipkin: invalid spans: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	cs, err := convert(spans)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := collect(h.Collector, cs); err != nil {
+		h.logf("zipkin: collecting %d spans failed: %s", len(cs), err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) logf(format string, args ...interface{}) {
+	if h.Log != nil {
+		h.Log.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
@@ -0,0 +1,297 @@
+// Package zipkin converts spans in the Zipkin v2 JSON format into appdash
+// spans, so that services that are instrumented with a Zipkin tracer can
+// report their spans to an appdash server.
+//
+// The Handler accepts the same requests as the POST /api/v2/spans endpoint of
+// a Zipkin server, so a Zipkin reporter only needs to be pointed at an
+// appdash server (see traceapp) to use it. See the export package for the
+// conversion of appdash traces into Zipkin spans.
+//
+// # Conversion
+//
+// Each Zipkin span is collected as an appdash span with the following events
+// and annotations:
+//
+//	name                      SpanNameEvent
+//	timestamp, duration       Timespan, or for CLIENT and SERVER spans an
+//	                          httptrace.ClientEvent or httptrace.ServerEvent
+//	                          (with the request and response taken from the
+//	                          http.* tags)
+//	annotations               log events
+//	tags                      annotations with the same keys and values
+//	localEndpoint.serviceName the "service.name" annotation
+//	remoteEndpoint            the "peer.service" and "peer.address" annotations
+//
+// A client and a server that share a span ID (as Zipkin's B3 propagation does
+// by default) record their events on the same appdash span, just like the
+// httptrace package does.
+//
+// # IDs
+//
+// Zipkin trace IDs are 64 or 128 bits long, but appdash IDs are 64 bits, so
+// only the lower 64 bits of a 128-bit trace ID (its last 16 hex characters)
+// are used as the appdash trace ID. This is consistent with how Zipkin itself
+// matches 128-bit trace IDs with their 64-bit form, so spans that are reported
+// with either form of the same trace ID are collected into the same trace. The
+// full ID of a 128-bit trace is kept in the "zipkin.traceId" annotation.
+package zipkin
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+)
+
+// The annotation keys that endpoints and 128-bit trace IDs are recorded with.
+const (
+	ServiceNameKey = "service.name"
+	PeerServiceKey = "peer.service"
+	PeerAddressKey = "peer.address"
+	TraceIDKey     = "zipkin.traceId"
+)
+
+// Span kinds.
+const (
+	Client   = "CLIENT"
+	Server   = "SERVER"
+	Producer = "PRODUCER"
+	Consumer = "CONSUMER"
+)
+
+// A Span is a span in the Zipkin v2 JSON format.
+type Span struct {
+	TraceID        string            `json:"traceId"`
+	ID             string            `json:"id"`
+	ParentID       string            `json:"parentId,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	Kind           string            `json:"kind,omitempty"`
+	Timestamp      int64             `json:"timestamp,omitempty"` // microseconds since the epoch
+	Duration       int64             `json:"duration,omitempty"`  // microseconds
+	LocalEndpoint  *Endpoint         `json:"localEndpoint,omitempty"`
+	RemoteEndpoint *Endpoint         `json:"remoteEndpoint,omitempty"`
+	Annotations    []Annotation      `json:"annotations,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Debug          bool              `json:"debug,omitempty"`
+	Shared         bool              `json:"shared,omitempty"`
+}
+
+// An Endpoint is the network context of a service that records or is called
+// by a span.
+type Endpoint struct {
+	ServiceName string `json:"serviceName,omitempty"`
+	IPv4        string `json:"ipv4,omitempty"`
+	IPv6        string `json:"ipv6,omitempty"`
+	Port        int    `json:"port,omitempty"`
+}
+
+// addr returns the host:port address of the endpoint, or "" if it has no IP
+// address.
+func (e *Endpoint) addr() string {
+	ip := e.IPv4
+	if ip == "" {
+		ip = e.IPv6
+	}
+	if ip == "" {
+		return ""
+	}
+	if e.Port == 0 {
+		return ip
+	}
+	return net.JoinHostPort(ip, strconv.Itoa(e.Port))
+}
+
+// An Annotation is a timestamped event in a span.
+type Annotation struct {
+	Timestamp int64  `json:"timestamp"` // microseconds since the epoch
+	Value     string `json:"value"`
+}
+
+// SpanID returns the appdash span ID of the span. An error is returned if
+// the trace or span ID is missing or is not a valid hex ID.
+func (s *Span) SpanID() (appdash.SpanID, error) {
+	_, trace, err := ParseID(s.TraceID)
+	if err != nil {
+		return appdash.SpanID{}, fmt.Errorf("zipkin: invalid traceId: %s", err)
+	}
+	hi, span, err := ParseID(s.ID)
+	if err != nil || hi != 0 {
+		return appdash.SpanID{}, fmt.Errorf("zipkin: invalid span id %q", s.ID)
+	}
+	var parent appdash.ID
+	if s.ParentID != "" {
+		hi, parent, err = ParseID(s.ParentID)
+		if err != nil || hi != 0 {
+			return appdash.SpanID{}, fmt.Errorf("zipkin: invalid parentId %q", s.ParentID)
+		}
+	}
+	return appdash.SpanID{Trace: trace, Span: span, Parent: parent}, nil
+}
+
+// ParseID parses a 64-bit or 128-bit Zipkin ID, given as up to 32 hex
+// characters, and returns its upper and lower 64 bits. IDs that are shorter
+// than 16 or 32 characters are treated as if they were left-padded with
+// zeros. An error is returned if the ID is empty, invalid or zero.
+func ParseID(s string) (hi, lo appdash.ID, err error) {
+	if s == "" || len(s) > 32 {
+		return 0, 0, fmt.Errorf("invalid ID %q", s)
+	}
+	if len(s) > 16 {
+		hi, err = appdash.ParseID(s[:len(s)-16])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid ID %q", s)
+		}
+		s = s[len(s)-16:]
+	}
+	lo, err = appdash.ParseID(s)
+	if err != nil || (hi == 0 && lo == 0) {
+		return 0, 0, fmt.Errorf("invalid ID %q", s)
+	}
+	return hi, lo, nil
+}
+
+// AppdashAnnotations returns the appdash annotations of the span, as
+// described in the package documentation.
+func (s *Span) AppdashAnnotations() (appdash.Annotations, error) {
+	var events []appdash.Event
+	if s.Name != "" {
+		events = append(events, appdash.SpanName(s.Name))
+	}
+	if s.Timestamp != 0 {
+		start := microsToTime(s.Timestamp)
+		end := start.Add(time.Duration(s.Duration) * time.Microsecond)
+		switch s.Kind {
+		case Client:
+			events = append(events, httptrace.ClientEvent{
+				Request:    s.requestInfo(),
+				Response:   s.responseInfo(),
+				ClientSend: start,
+				ClientRecv: end,
+			})
+		case Server:
+			events = append(events, httptrace.ServerEvent{
+				Request:    s.requestInfo(),
+				Response:   s.responseInfo(),
+				Route:      s.Tags["http.route"],
+				ServerRecv: start,
+				ServerSend: end,
+			})
+		default:
+			events = append(events, appdash.Timespan{S: start, E: end})
+		}
+	}
+	for _, a := range s.Annotations {
+		events = append(events, appdash.LogWithTimestamp(a.Value, microsToTime(a.Timestamp)))
+	}
+
+	var anns appdash.Annotations
+	for _, e := range events {
+		ea, err := appdash.MarshalEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		anns = append(anns, ea...)
+	}
+
+	keys := make([]string, 0, len(s.Tags))
+	for k := range s.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		anns = append(anns, appdash.Annotation{Key: k, Value: []byte(s.Tags[k])})
+	}
+
+	if e := s.LocalEndpoint; e != nil && e.ServiceName != "" {
+		anns = append(anns, appdash.Annotation{Key: ServiceNameKey, Value: []byte(e.ServiceName)})
+	}
+	if e := s.RemoteEndpoint; e != nil {
+		if e.ServiceName != "" {
+			anns = append(anns, appdash.Annotation{Key: PeerServiceKey, Value: []byte(e.ServiceName)})
+		}
+		if addr := e.addr(); addr != "" {
+			anns = append(anns, appdash.Annotation{Key: PeerAddressKey, Value: []byte(addr)})
+		}
+	}
+	if hi, lo, err := ParseID(s.TraceID); err == nil && hi != 0 {
+		anns = append(anns, appdash.Annotation{Key: TraceIDKey, Value: []byte(hi.String() + lo.String())})
+	}
+	return anns, nil
+}
+
+// requestInfo returns the HTTP request of a CLIENT or SERVER span, from its
+// http.* tags and remote endpoint.
+func (s *Span) requestInfo() httptrace.RequestInfo {
+	r := httptrace.RequestInfo{
+		Method: s.Tags["http.method"],
+		URI:    s.Tags["http.path"],
+		Host:   s.Tags["http.host"],
+	}
+	if u, err := url.Parse(s.Tags["http.url"]); err == nil && s.Tags["http.url"] != "" {
+		r.URI = u.RequestURI()
+		if r.Host == "" {
+			r.Host = u.Host
+		}
+	}
+	if s.RemoteEndpoint != nil {
+		r.RemoteAddr = s.RemoteEndpoint.addr()
+	}
+	return r
+}
+
+// responseInfo returns the HTTP response of a CLIENT or SERVER span, from its
+// http.status_code tag.
+func (s *Span) responseInfo() httptrace.ResponseInfo {
+	code, _ := strconv.Atoi(s.Tags["http.status_code"])
+	return httptrace.ResponseInfo{StatusCode: code}
+}
+
+func microsToTime(us int64) time.Time {
+	return time.Unix(0, us*int64(time.Microsecond))
+}
+
+// Collect converts the spans and collects them into c. If any span is invalid,
+// an error is returned and none of the spans are collected.
+func Collect(c appdash.Collector, spans ...Span) error {
+	cs, err := convert(spans)
+	if err != nil {
+		return err
+	}
+	return collect(c, cs)
+}
+
+// A convertedSpan is the appdash span ID and annotations of a Zipkin span.
+type convertedSpan struct {
+	id   appdash.SpanID
+	anns appdash.Annotations
+}
+
+func convert(spans []Span) ([]convertedSpan, error) {
+	cs := make([]convertedSpan, len(spans))
+	for i := range spans {
+		id, err := spans[i].SpanID()
+		if err != nil {
+			return nil, err
+		}
+		anns, err := spans[i].AppdashAnnotations()
+		if err != nil {
+			return nil, err
+		}
+		cs[i] = convertedSpan{id, anns}
+	}
+	return cs, nil
+}
+
+func collect(c appdash.Collector, cs []convertedSpan) error {
+	for _, s := range cs {
+		if err := c.Collect(s.id, s.anns...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
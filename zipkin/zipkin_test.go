@@ -0,0 +1,253 @@
+package zipkin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+)
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		id     string
+		hi, lo appdash.ID
+		err    bool
+	}{
+		{id: "0000000000000001", lo: 1},
+		{id: "1", lo: 1},
+		{id: "463ac35c9f6413ad", lo: 0x463ac35c9f6413ad},
+		{id: "463ac35c9f6413ad48485a3953bb6124", hi: 0x463ac35c9f6413ad, lo: 0x48485a3953bb6124},
+		{id: "0000000000000000463ac35c9f6413ad", lo: 0x463ac35c9f6413ad},
+		{id: "1463ac35c9f6413ad", hi: 1, lo: 0x463ac35c9f6413ad},
+		{id: "", err: true},
+		{id: "0000000000000000", err: true},
+		{id: "xyz", err: true},
+		{id: "463ac35c9f6413ad48485a3953bb61240", err: true},
+	}
+	for _, test := range tests {
+		hi, lo, err := ParseID(test.id)
+		if (err != nil) != test.err {
+			t.Errorf("%q: got error %v, want error %v", test.id, err, test.err)
+			continue
+		}
+		if hi != test.hi || lo != test.lo {
+			t.Errorf("%q: got %v %v, want %v %v", test.id, hi, lo, test.hi, test.lo)
+		}
+	}
+}
+
+func TestSpan_SpanID(t *testing.T) {
+	// The 128-bit and 64-bit forms of a trace ID must be converted to the
+	// same appdash trace ID.
+	a := Span{TraceID: "463ac35c9f6413ad48485a3953bb6124", ID: "a2fb4a1d1a96d312"}
+	b := Span{TraceID: "48485a3953bb6124", ID: "b", ParentID: "a2fb4a1d1a96d312"}
+	ida, err := a.SpanID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	idb, err := b.SpanID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (appdash.SpanID{Trace: 0x48485a3953bb6124, Span: 0xa2fb4a1d1a96d312}); ida != want {
+		t.Errorf("got span ID %v, want %v", ida, want)
+	}
+	if want := (appdash.SpanID{Trace: 0x48485a3953bb6124, Span: 0xb, Parent: 0xa2fb4a1d1a96d312}); idb != want {
+		t.Errorf("got span ID %v, want %v", idb, want)
+	}
+
+	for _, s := range []Span{
+		{TraceID: "", ID: "1"},
+		{TraceID: "1", ID: ""},
+		{TraceID: "1", ID: "463ac35c9f6413ad48485a3953bb6124"},
+		{TraceID: "1", ID: "2", ParentID: "x"},
+	} {
+		if id, err := s.SpanID(); err == nil {
+			t.Errorf("%+v: got span ID %v, want error", s, id)
+		}
+	}
+}
+
+func TestSpan_Annotations(t *testing.T) {
+	start := time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC)
+	s := Span{
+		TraceID:   "463ac35c9f6413ad48485a3953bb6124",
+		ID:        "1",
+		Name:      "get /users",
+		Kind:      Server,
+		Timestamp: start.UnixNano() / 1000,
+		Duration:  1500,
+		LocalEndpoint: &Endpoint{
+			ServiceName: "frontend",
+			IPv4:        "10.0.0.1",
+		},
+		RemoteEndpoint: &Endpoint{IPv4: "10.0.0.2", Port: 5000},
+		Annotations:    []Annotation{{Timestamp: start.UnixNano()/1000 + 100, Value: "cache miss"}},
+		Tags: map[string]string{
+			"http.method":      "GET",
+			"http.path":        "/users",
+			"http.status_code": "500",
+			"error":            "boom",
+		},
+	}
+	anns, err := s.AppdashAnnotations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		name   appdash.SpanNameEvent
+		server httptrace.ServerEvent
+	)
+	if err := appdash.UnmarshalEvent(anns, &name); err != nil {
+		t.Fatal(err)
+	}
+	if name.Name != "get /users" {
+		t.Errorf("got name %q, want %q", name.Name, "get /users")
+	}
+	if err := appdash.UnmarshalEvent(anns, &server); err != nil {
+		t.Fatal(err)
+	}
+	wantServer := httptrace.ServerEvent{
+		Request: httptrace.RequestInfo{
+			Method:     "GET",
+			URI:        "/users",
+			RemoteAddr: "10.0.0.2:5000",
+		},
+		Response:   httptrace.ResponseInfo{StatusCode: 500},
+		ServerRecv: start,
+		ServerSend: start.Add(1500 * time.Microsecond),
+	}
+	server.Request.Headers, server.Response.Headers = nil, nil
+	server.ServerRecv, server.ServerSend = server.ServerRecv.UTC(), server.ServerSend.UTC()
+	if !reflect.DeepEqual(server, wantServer) {
+		t.Errorf("got server event %+v, want %+v", server, wantServer)
+	}
+
+	m := anns.StringMap()
+	for k, v := range map[string]string{
+		"error":              "boom",
+		"http.method":        "GET",
+		ServiceNameKey:       "frontend",
+		PeerAddressKey:       "10.0.0.2:5000",
+		TraceIDKey:           "463ac35c9f6413ad48485a3953bb6124",
+		"Msg":                "cache miss",
+		"_schema:log":        "",
+		"_schema:name":       "",
+		"_schema:HTTPServer": "",
+	} {
+		if got, ok := m[k]; !ok || got != v {
+			t.Errorf("got annotation %s=%q (present %v), want %q", k, got, ok, v)
+		}
+	}
+
+	// Spans without a kind get a Timespan.
+	s.Kind = ""
+	anns, err = s.AppdashAnnotations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ts appdash.Timespan
+	if err := appdash.UnmarshalEvent(anns, &ts); err != nil {
+		t.Fatal(err)
+	}
+	if !ts.S.Equal(start) || ts.E.Sub(ts.S) != 1500*time.Microsecond {
+		t.Errorf("got timespan %v-%v, want %v + 1.5ms", ts.S, ts.E, start)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	srv := httptest.NewServer(&Handler{Collector: ms})
+	defer srv.Close()
+
+	post := func(body string) int {
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	body := `[
+		{"traceId": "463ac35c9f6413ad48485a3953bb6124", "id": "1", "name": "root", "timestamp": 1464782400000000, "duration": 2000},
+		{"traceId": "48485a3953bb6124", "parentId": "1", "id": "2", "name": "child", "kind": "CLIENT", "timestamp": 1464782400000500, "duration": 1000}
+	]`
+	if code := post(body); code != http.StatusAccepted {
+		t.Fatalf("got status %d, want %d", code, http.StatusAccepted)
+	}
+	tr, err := ms.Trace(0x48485a3953bb6124)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Span.Name() != "root" || len(tr.Sub) != 1 || tr.Sub[0].Span.Name() != "child" {
+		t.Errorf("got trace %v, want root with one child", tr)
+	}
+
+	for _, body := range []string{
+		`{`,
+		`[{"traceId": "1", "id": "zz"}]`,
+	} {
+		if code := post(body); code != http.StatusBadRequest {
+			t.Errorf("%s: got status %d, want %d", body, code, http.StatusBadRequest)
+		}
+	}
+	// Invalid spans must not be partially collected.
+	post(`[{"traceId": "3", "id": "1"}, {"traceId": "3", "id": ""}]`)
+	if _, err := ms.Trace(3); err != appdash.ErrTraceNotFound {
+		t.Errorf("got error %v for a partially invalid request, want %v", err, appdash.ErrTraceNotFound)
+	}
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("got status %d for GET, want %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+
+	// Gzip-encoded requests are accepted too.
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte(`[{"traceId": "4", "id": "1", "name": "gzipped"}]`))
+	gz.Close()
+	req, _ := http.NewRequest("POST", srv.URL, &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("got status %d for gzipped request, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+	if tr, err := ms.Trace(4); err != nil || tr.Span.Name() != "gzipped" {
+		t.Errorf("got trace %v (error %v), want gzipped span", tr, err)
+	}
+
+	// Requests that are too large after decompression are rejected, rather
+	// than truncated.
+	buf.Reset()
+	gz = gzip.NewWriter(&buf)
+	gz.Write([]byte("[" + strings.Repeat(" ", maxRequestSize) + "]"))
+	gz.Close()
+	req, _ = http.NewRequest("POST", srv.URL, &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("got status %d for a too large request, want %d", resp.StatusCode, http.StatusRequestEntityTooLarge)
+	}
+}